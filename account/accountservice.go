@@ -2,6 +2,7 @@ package account
 
 import (
 	"sync"
+	"time"
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/Cofresi/aergo-lib/log"
@@ -18,7 +19,7 @@ type AccountService struct {
 	*component.BaseComponent
 	cfg         *cfg.Config
 	sdb         *state.ChainStateDB
-	ks          *key.Store
+	ks          key.Signer
 	accountLock sync.RWMutex
 	accounts    []*types.Account
 	testConfig  bool
@@ -36,7 +37,15 @@ func NewAccountService(cfg *cfg.Config, sdb *state.ChainStateDB) *AccountService
 }
 
 func (as *AccountService) BeforeStart() {
-	as.ks = key.NewStore(as.cfg.DataDir, as.cfg.Account.UnlockTimeout)
+	if as.cfg.Account.Signer != "" {
+		ks, err := key.NewRemoteSigner(as.cfg.Account.Signer)
+		if err != nil {
+			as.Logger.Fatal().Err(err).Str("signer", as.cfg.Account.Signer).Msg("could not use the external signer")
+		}
+		as.ks = ks
+	} else {
+		as.ks = key.NewStore(as.cfg.DataDir, as.cfg.Account.UnlockTimeout)
+	}
 
 	as.accounts = []*types.Account{}
 	addresses, err := as.ks.GetAddresses()
@@ -63,11 +72,19 @@ func (as *AccountService) Statistics() *map[string]interface{} {
 	}
 }
 func (as *AccountService) resolveName(namedAddress []byte) ([]byte, error) {
+	rsp, err := as.RequestToFuture(message.ChainSvc, &message.GetBestBlock{}, time.Second*2).Result()
+	if err != nil {
+		return nil, err
+	}
+	best := rsp.(message.GetBestBlockRsp)
+	if best.Err != nil {
+		return nil, best.Err
+	}
 	scs, err := as.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
 	if err != nil {
 		return nil, err
 	}
-	return name.GetAddress(scs, namedAddress), nil
+	return name.GetAddress(scs, namedAddress, best.Block.BlockNo()), nil
 }
 
 func (as *AccountService) Receive(context actor.Context) {
@@ -0,0 +1,274 @@
+package key
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// The external signer protocol is a JSON request and response over HTTP,
+// served either on a TCP address or on a Unix socket. A request is a POST to
+// the path of the method, and the response carries either the result or an
+// error message:
+//
+//	addresses {}                                               -> ["address", ...]
+//	create    {"password"}                                     -> "address"
+//	import    {"key", "password", "newPassword"}               -> "address"
+//	export    {"address", "password"}                          -> "key"
+//	unlock    {"address", "password"}                          -> "address"
+//	lock      {"address", "password"}                          -> "address"
+//	sign      {"address", "password", "hash", "unlocked"}      -> "signature"
+//
+// Addresses are base58 encoded, and keys, hashes and signatures are base64
+// encoded. sign signs with the unlocked key of the address if "unlocked" is
+// set, or else with the key protected by "password".
+
+const remoteSignerTimeout = 10 * time.Second
+
+type signerRequest struct {
+	Address     string `json:"address,omitempty"`
+	Password    string `json:"password,omitempty"`
+	NewPassword string `json:"newPassword,omitempty"`
+	Key         []byte `json:"key,omitempty"`
+	Hash        []byte `json:"hash,omitempty"`
+	Unlocked    bool   `json:"unlocked,omitempty"`
+}
+
+type signerResponse struct {
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// errors which keep their identity across the protocol
+var signerErrors = []error{
+	types.ErrShouldUnlockAccount,
+	types.ErrWrongAddressOrPassWord,
+}
+
+// RemoteSigner is a Signer which forwards the requests to an external signer
+// process, so that the keys never live in this process.
+type RemoteSigner struct {
+	base   string
+	client *http.Client
+}
+
+// NewRemoteSigner returns a Signer of the external signer at signerURL, which
+// is either http://host:port or unix:///path/to/socket.
+func NewRemoteSigner(signerURL string) (*RemoteSigner, error) {
+	u, err := url.Parse(signerURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return &RemoteSigner{
+			base:   strings.TrimSuffix(signerURL, "/"),
+			client: &http.Client{Timeout: remoteSignerTimeout},
+		}, nil
+	case "unix":
+		if u.Path == "" {
+			return nil, fmt.Errorf("no socket path in %s", signerURL)
+		}
+		var d net.Dialer
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return d.DialContext(ctx, "unix", u.Path)
+			},
+		}
+		return &RemoteSigner{
+			base:   "http://unix",
+			client: &http.Client{Timeout: remoteSignerTimeout, Transport: transport},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported signer url %s", signerURL)
+	}
+}
+
+func (rs *RemoteSigner) call(method string, req *signerRequest, result interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpRsp, err := rs.client.Post(rs.base+"/"+method, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer httpRsp.Body.Close()
+	var rsp signerResponse
+	if err = json.NewDecoder(httpRsp.Body).Decode(&rsp); err != nil {
+		return fmt.Errorf("invalid signer response: %s", httpRsp.Status)
+	}
+	if rsp.Error != "" {
+		for _, e := range signerErrors {
+			if rsp.Error == e.Error() {
+				return e
+			}
+		}
+		return errors.New(rsp.Error)
+	}
+	return json.Unmarshal(rsp.Result, result)
+}
+
+func (rs *RemoteSigner) callAddress(method string, req *signerRequest) (Address, error) {
+	var encoded string
+	if err := rs.call(method, req, &encoded); err != nil {
+		return nil, err
+	}
+	return types.DecodeAddress(encoded)
+}
+
+func (rs *RemoteSigner) GetAddresses() ([]Address, error) {
+	var encoded []string
+	if err := rs.call("addresses", &signerRequest{}, &encoded); err != nil {
+		return nil, err
+	}
+	addrs := make([]Address, len(encoded))
+	for i, e := range encoded {
+		addr, err := types.DecodeAddress(e)
+		if err != nil {
+			return nil, err
+		}
+		addrs[i] = addr
+	}
+	return addrs, nil
+}
+
+// SaveAddress does nothing, since the external signer lists the keys it
+// creates or imports by itself.
+func (rs *RemoteSigner) SaveAddress(addr Address) error {
+	return nil
+}
+
+func (rs *RemoteSigner) CreateKey(pass string) (Address, error) {
+	return rs.callAddress("create", &signerRequest{Password: pass})
+}
+
+func (rs *RemoteSigner) ImportKey(imported []byte, oldpass string, newpass string) (Address, error) {
+	return rs.callAddress("import", &signerRequest{Key: imported, Password: oldpass, NewPassword: newpass})
+}
+
+func (rs *RemoteSigner) ExportKey(addr Address, pass string) ([]byte, error) {
+	var exported []byte
+	err := rs.call("export", &signerRequest{Address: types.EncodeAddress(addr), Password: pass}, &exported)
+	return exported, err
+}
+
+func (rs *RemoteSigner) Unlock(addr Address, pass string) (Address, error) {
+	return rs.callAddress("unlock", &signerRequest{Address: types.EncodeAddress(addr), Password: pass})
+}
+
+func (rs *RemoteSigner) Lock(addr Address, pass string) (Address, error) {
+	return rs.callAddress("lock", &signerRequest{Address: types.EncodeAddress(addr), Password: pass})
+}
+
+func (rs *RemoteSigner) Sign(addr Address, pass string, hash []byte) ([]byte, error) {
+	var sign []byte
+	err := rs.call("sign", &signerRequest{Address: types.EncodeAddress(addr), Password: pass, Hash: hash}, &sign)
+	return sign, err
+}
+
+func (rs *RemoteSigner) SignTx(tx *types.Tx, requester []byte) error {
+	addr := tx.Body.Account
+	if requester != nil {
+		addr = requester
+	}
+	req := &signerRequest{Address: types.EncodeAddress(addr), Hash: CalculateHashWithoutSign(tx.Body), Unlocked: true}
+	var sign []byte
+	if err := rs.call("sign", req, &sign); err != nil {
+		return err
+	}
+	tx.Body.Sign = sign
+	tx.Hash = tx.CalculateTxHash()
+	return nil
+}
+
+func (rs *RemoteSigner) VerifyTx(tx *types.Tx) error {
+	return VerifyTx(tx)
+}
+
+func (rs *RemoteSigner) CloseStore() {
+	if t, ok := rs.client.Transport.(*http.Transport); ok {
+		t.CloseIdleConnections()
+	}
+}
+
+// NewSignerHandler returns a handler which serves the external signer
+// protocol with the keys of ks. A stand-in signer process can serve it to
+// keep the keys out of the node.
+func NewSignerHandler(ks *Store) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req signerRequest
+		var result interface{}
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err == nil {
+			result, err = serveSigner(ks, strings.TrimPrefix(r.URL.Path, "/"), &req)
+		}
+		var rsp signerResponse
+		if err == nil {
+			rsp.Result, err = json.Marshal(result)
+		}
+		if err != nil {
+			rsp.Error = err.Error()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&rsp)
+	})
+}
+
+func serveSigner(ks *Store, method string, req *signerRequest) (interface{}, error) {
+	var addr Address
+	if req.Address != "" {
+		var err error
+		if addr, err = types.DecodeAddress(req.Address); err != nil {
+			return nil, err
+		}
+	}
+	encoded := func(addr Address, err error) (interface{}, error) {
+		if err != nil {
+			return nil, err
+		}
+		return types.EncodeAddress(addr), nil
+	}
+	switch method {
+	case "addresses":
+		addrs, err := ks.GetAddresses()
+		if err != nil {
+			return nil, err
+		}
+		encodedAddrs := make([]string, len(addrs))
+		for i, a := range addrs {
+			encodedAddrs[i] = types.EncodeAddress(a)
+		}
+		return encodedAddrs, nil
+	case "create":
+		addr, err := ks.CreateKey(req.Password)
+		if err == nil {
+			err = ks.SaveAddress(addr)
+		}
+		return encoded(addr, err)
+	case "import":
+		return encoded(ks.ImportKey(req.Key, req.Password, req.NewPassword))
+	case "export":
+		return ks.ExportKey(addr, req.Password)
+	case "unlock":
+		return encoded(ks.Unlock(addr, req.Password))
+	case "lock":
+		return encoded(ks.Lock(addr, req.Password))
+	case "sign":
+		if req.Unlocked {
+			return ks.signUnlocked(addr, req.Hash)
+		}
+		return ks.Sign(addr, req.Password, req.Hash)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method)
+	}
+}
@@ -0,0 +1,82 @@
+package key
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testRemoteSigner(t *testing.T, rs *RemoteSigner) {
+	addr, err := rs.CreateKey("pass")
+	assert.NoError(t, err)
+	assert.Len(t, addr, types.AddressLength)
+	addrs, err := rs.GetAddresses()
+	assert.NoError(t, err)
+	assert.Contains(t, addrs, addr)
+
+	tx := &types.Tx{Body: &types.TxBody{Nonce: 1, Account: addr, Recipient: addr, Amount: []byte{0x01}}}
+	assert.Equal(t, types.ErrShouldUnlockAccount, rs.SignTx(tx, nil))
+	_, err = rs.Unlock(addr, "wrong")
+	assert.Equal(t, types.ErrWrongAddressOrPassWord, err)
+
+	unlocked, err := rs.Unlock(addr, "pass")
+	assert.NoError(t, err)
+	assert.Equal(t, addr, unlocked)
+	assert.NoError(t, rs.SignTx(tx, nil))
+	assert.NoError(t, rs.VerifyTx(tx))
+	_, err = rs.Lock(addr, "pass")
+	assert.NoError(t, err)
+	assert.Equal(t, types.ErrShouldUnlockAccount, rs.SignTx(tx, nil))
+
+	sign, err := rs.Sign(addr, "pass", CalculateHashWithoutSign(tx.Body))
+	assert.NoError(t, err)
+	tx.Body.Sign = sign
+	assert.NoError(t, VerifyTx(tx))
+
+	exported, err := rs.ExportKey(addr, "pass")
+	assert.NoError(t, err)
+	_, err = rs.ImportKey(exported, "pass", "new")
+	assert.EqualError(t, err, "already exist")
+}
+
+func TestRemoteSignerHTTP(t *testing.T) {
+	initTest()
+	defer deinitTest()
+
+	server := httptest.NewServer(NewSignerHandler(ks))
+	defer server.Close()
+
+	rs, err := NewRemoteSigner(server.URL)
+	assert.NoError(t, err)
+	defer rs.CloseStore()
+	testRemoteSigner(t, rs)
+}
+
+func TestRemoteSignerUnix(t *testing.T) {
+	initTest()
+	defer deinitTest()
+
+	sock := filepath.Join(testDir, "signer.sock")
+	l, err := net.Listen("unix", sock)
+	assert.NoError(t, err)
+	server := &http.Server{Handler: NewSignerHandler(ks)}
+	go server.Serve(l)
+	defer func() {
+		server.Close()
+		os.Remove(sock)
+	}()
+
+	rs, err := NewRemoteSigner("unix://" + sock)
+	assert.NoError(t, err)
+	defer rs.CloseStore()
+	testRemoteSigner(t, rs)
+
+	_, err = NewRemoteSigner("ftp://localhost")
+	assert.Error(t, err)
+}
@@ -1,10 +1,12 @@
 package key
 
 import (
+	"bytes"
 	"encoding/binary"
 
 	"github.com/aergoio/aergo/types"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/protobuf/proto"
 	sha256 "github.com/minio/sha256-simd"
 )
 
@@ -39,11 +41,27 @@ func (ks *Store) SignTx(tx *types.Tx, requester []byte) error {
 	if requester != nil {
 		addr = requester
 	}
+	sign, err := ks.signUnlocked(addr, CalculateHashWithoutSign(tx.Body))
+	if err != nil {
+		return err
+	}
+	tx.Body.Sign = sign
+	tx.Hash = tx.CalculateTxHash()
+	return nil
+}
+
+func (ks *Store) signUnlocked(addr Address, hash []byte) ([]byte, error) {
+	ks.unlockedLock.Lock()
 	keyPair, exist := ks.unlocked[types.EncodeAddress(addr)]
+	ks.unlockedLock.Unlock()
 	if !exist {
-		return types.ErrShouldUnlockAccount
+		return nil, types.ErrShouldUnlockAccount
 	}
-	return SignTx(tx, keyPair.key)
+	sign, err := keyPair.key.Sign(hash)
+	if err != nil {
+		return nil, err
+	}
+	return sign.Serialize(), nil
 }
 
 //VerifyTx return result to varify sign
@@ -51,9 +69,21 @@ func VerifyTx(tx *types.Tx) error {
 	return VerifyTxWithAddress(tx, tx.Body.Account)
 }
 
+// VerifyTxOfBlock verifies the signature of tx, which is sent from address in
+// the block of blockNo. A multisig account sends txs from the V2 hardfork.
+func VerifyTxOfBlock(tx *types.Tx, address []byte, blockNo types.BlockNo) error {
+	if types.IsMultisigAddress(address) && !types.IsV2Fork(blockNo) {
+		return types.ErrInvalidMultisig
+	}
+	return VerifyTxWithAddress(tx, address)
+}
+
 func VerifyTxWithAddress(tx *types.Tx, address []byte) error {
 	txBody := tx.Body
 	hash := CalculateHashWithoutSign(txBody)
+	if types.IsMultisigAddress(address) {
+		return verifyMultisig(hash, txBody.Sign, address)
+	}
 	sign, err := btcec.ParseSignature(txBody.Sign, btcec.S256())
 	if err != nil {
 		return err
@@ -68,6 +98,88 @@ func VerifyTxWithAddress(tx *types.Tx, address []byte) error {
 	return nil
 }
 
+// verifyMultisig checks that sign holds at least the threshold number of
+// valid signatures from the keys of the multisig account address.
+func verifyMultisig(hash, sign, address []byte) error {
+	msSign, err := types.DecodeMultisigSign(sign)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(msSign.Multisig.Address(), address) {
+		return types.ErrSignNotMatch
+	}
+	var signed uint32
+	for i, s := range msSign.Signs {
+		if len(s) == 0 {
+			continue
+		}
+		sign, err := btcec.ParseSignature(s, btcec.S256())
+		if err != nil {
+			return err
+		}
+		pubkey, err := btcec.ParsePubKey(msSign.Multisig.Keys[i], btcec.S256())
+		if err != nil {
+			return err
+		}
+		if !sign.Verify(hash, pubkey) {
+			return types.ErrSignNotMatch
+		}
+		signed++
+	}
+	if signed < msSign.Multisig.Threshold {
+		return types.ErrMultisigNotEnoughSign
+	}
+	return nil
+}
+
+// SignMultisigTx adds the signature of key to tx, which is sent from the
+// multisig account of ms. ms may be nil if tx already has signatures.
+func SignMultisigTx(tx *types.Tx, ms *types.Multisig, key *aergokey) error {
+	hash := CalculateHashWithoutSign(tx.Body)
+	sign, err := key.Sign(hash)
+	if err != nil {
+		return err
+	}
+	return AddMultisigSign(tx, ms, GenerateAddress(key.PubKey().ToECDSA()), sign.Serialize())
+}
+
+// AddMultisigSign sets sign as the signature of signer in tx, which is sent
+// from the multisig account of ms. ms may be nil if tx already has signatures.
+func AddMultisigSign(tx *types.Tx, ms *types.Multisig, signer, sign []byte) error {
+	var msSign *types.MultisigSign
+	if len(tx.Body.Sign) > 0 {
+		var err error
+		if msSign, err = types.DecodeMultisigSign(tx.Body.Sign); err != nil {
+			return err
+		}
+		if ms != nil && !proto.Equal(ms, msSign.Multisig) {
+			return types.ErrInvalidMultisig
+		}
+	} else {
+		if ms == nil {
+			return types.ErrInvalidMultisig
+		}
+		if err := ms.Validate(); err != nil {
+			return err
+		}
+		msSign = types.NewMultisigSign(ms)
+	}
+	// a name account is resolved to its owner when verified
+	if len(tx.Body.Account) == types.AddressLength && !bytes.Equal(msSign.Multisig.Address(), tx.Body.Account) {
+		return types.ErrTxInvalidAccount
+	}
+	if err := msSign.SetSign(signer, sign); err != nil {
+		return err
+	}
+	encoded, err := msSign.Encode()
+	if err != nil {
+		return err
+	}
+	tx.Body.Sign = encoded
+	tx.Hash = tx.CalculateTxHash()
+	return nil
+}
+
 //VerifyTx return result to varify sign
 func (ks *Store) VerifyTx(tx *types.Tx) error {
 	return VerifyTx(tx)
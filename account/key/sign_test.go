@@ -0,0 +1,55 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultisigTx(t *testing.T) {
+	var privKeys []*btcec.PrivateKey
+	var keys [][]byte
+	for i := 0; i < 3; i++ {
+		k, err := btcec.NewPrivateKey(btcec.S256())
+		assert.NoError(t, err, "could not create private key")
+		privKeys = append(privKeys, k)
+		keys = append(keys, GenerateAddress(&k.PublicKey))
+	}
+	ms, err := types.NewMultisig(2, keys)
+	assert.NoError(t, err)
+
+	tx := &types.Tx{Body: &types.TxBody{
+		Nonce:     1,
+		Account:   ms.Address(),
+		Recipient: keys[0],
+		Amount:    []byte{0x10},
+	}}
+	assert.NoError(t, SignMultisigTx(tx, ms, privKeys[2]))
+	assert.Equal(t, types.ErrMultisigNotEnoughSign, VerifyTx(tx))
+
+	// later signers need only the tx
+	assert.NoError(t, SignMultisigTx(tx, nil, privKeys[0]))
+	assert.NoError(t, VerifyTx(tx))
+	assert.Equal(t, tx.CalculateTxHash(), tx.Hash)
+
+	// a multisig account sends txs from the V2 hardfork
+	types.InitHardfork(&types.Hardfork{V2: 10})
+	defer types.InitHardfork(&types.Hardfork{})
+	assert.Equal(t, types.ErrInvalidMultisig, VerifyTxOfBlock(tx, ms.Address(), 9))
+	assert.NoError(t, VerifyTxOfBlock(tx, ms.Address(), 10))
+
+	// a key out of the set cannot sign
+	other, _ := btcec.NewPrivateKey(btcec.S256())
+	assert.Error(t, SignMultisigTx(tx, nil, other))
+
+	// the keys must match the account
+	ms2, _ := types.NewMultisig(1, keys[:1])
+	assert.Error(t, SignMultisigTx(tx, ms2, privKeys[0]))
+	assert.Equal(t, types.ErrSignNotMatch, VerifyTxWithAddress(tx, ms2.Address()))
+
+	// a modified body invalidates the signatures
+	tx.Body.Nonce = 2
+	assert.Equal(t, types.ErrSignNotMatch, VerifyTx(tx))
+}
@@ -0,0 +1,33 @@
+package key
+
+import "github.com/aergoio/aergo/types"
+
+// Signer holds the keys of the accounts and signs with them. The local Store
+// keeps the keys in the process, and the RemoteSigner leaves them in an
+// external signer.
+type Signer interface {
+	// GetAddresses returns the addresses of the accounts.
+	GetAddresses() ([]Address, error)
+	// SaveAddress adds addr to the account list.
+	SaveAddress(addr Address) error
+	// CreateKey creates a key protected by pass and returns its address.
+	CreateKey(pass string) (Address, error)
+	// ImportKey imports an exported key, protecting it by newpass.
+	ImportKey(imported []byte, oldpass string, newpass string) (Address, error)
+	// ExportKey returns the key of addr encrypted by pass.
+	ExportKey(addr Address, pass string) ([]byte, error)
+	// Unlock allows SignTx to sign with the key of addr.
+	Unlock(addr Address, pass string) (Address, error)
+	// Lock disallows SignTx to sign with the key of addr.
+	Lock(addr Address, pass string) (Address, error)
+	// Sign signs hash with the key of addr.
+	Sign(addr Address, pass string, hash []byte) ([]byte, error)
+	// SignTx signs tx with the unlocked key of requester, or of the tx
+	// account if requester is nil.
+	SignTx(tx *types.Tx, requester []byte) error
+	// VerifyTx verifies the signature of tx.
+	VerifyTx(tx *types.Tx) error
+	CloseStore()
+}
+
+var _ Signer = (*Store)(nil)
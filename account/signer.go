@@ -7,10 +7,10 @@ import (
 )
 
 type Signer struct {
-	keystore *key.Store
+	keystore key.Signer
 }
 
-func NewSigner(s *key.Store) *Signer {
+func NewSigner(s key.Signer) *Signer {
 	return &Signer{keystore: s}
 }
 
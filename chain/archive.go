@@ -0,0 +1,164 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// maxArchiveRecordSize is the limit of the size of a block with its receipts
+// in a chain archive.
+const maxArchiveRecordSize = 64 << 20
+
+var (
+	ErrArchiveGenesisMismatch = errors.New("genesis block of archive does not match")
+	ErrArchiveRecordTooLarge  = errors.New("too large record in archive")
+	ErrArchiveInvalidReceipts = errors.New("receipts of archive do not match the block")
+)
+
+// ExportBlocks writes the blocks of the main chain from the block number from
+// to the one to, with their receipts, to w. Each block is written as a
+// types.BlockArchive message prefixed by its length in uvarint, which is the
+// format read by ImportBlocks. It returns the number of the exported blocks.
+func (core *Core) ExportBlocks(w io.Writer, from, to types.BlockNo) (int, error) {
+	if best := core.cdb.getBestBlockNo(); to > best {
+		to = best
+	}
+
+	bw := bufio.NewWriter(w)
+	n := 0
+	for no := from; no <= to; no++ {
+		block, err := core.cdb.GetBlockByNo(no)
+		if err != nil {
+			return n, err
+		}
+		record := &types.BlockArchive{Block: block}
+		if no != 0 {
+			receipts, err := core.cdb.getReceipts(block.BlockHash(), no)
+			if err != nil {
+				// the receipts of a block without txs are not stored
+				if len(block.GetBody().GetTxs()) != 0 {
+					return n, fmt.Errorf("block %d: %s", no, err)
+				}
+				receipts = &types.Receipts{}
+			}
+			if record.Receipts, err = receipts.MarshalBinary(); err != nil {
+				return n, fmt.Errorf("block %d: %s", no, err)
+			}
+		}
+		if err := writeArchiveRecord(bw, record); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, bw.Flush()
+}
+
+// ImportBlocks reads the blocks written by ExportBlocks from r and adds them
+// to the chain in order, through the same verification and execution as the
+// blocks received from the peers. The receipts of each block are checked
+// against its header before the block is executed, so that a corrupted
+// archive is rejected early. The blocks already in the chain are skipped.
+//
+// It must be called before the chain service is registered to a hub, since
+// the imported blocks are not notified to the other services. It returns the
+// number of the blocks read from the archive.
+func (cs *ChainService) ImportBlocks(r io.Reader) (int, error) {
+	br := bufio.NewReader(r)
+	n := 0
+	for {
+		record, err := readArchiveRecord(br)
+		if err == io.EOF {
+			return n, nil
+		} else if err != nil {
+			return n, err
+		}
+		block := record.GetBlock()
+		if block == nil {
+			return n, fmt.Errorf("record %d: no block in archive", n)
+		}
+		n++
+
+		if block.BlockNo() == 0 {
+			if genesis := cs.cdb.GetGenesisInfo(); genesis == nil || !bytes.Equal(genesis.Block().BlockHash(), block.BlockHash()) {
+				return n, ErrArchiveGenesisMismatch
+			}
+			continue
+		}
+
+		receipts, err := archiveReceipts(record)
+		if err != nil || !bytes.Equal(receipts.MerkleRoot(), block.GetHeader().GetReceiptsRootHash()) {
+			return n, fmt.Errorf("block %d: %s", block.BlockNo(), ErrArchiveInvalidReceipts)
+		}
+
+		if err := cs.addBlock(block, nil, types.PeerID("")); err != nil {
+			return n, fmt.Errorf("block %d: %s", block.BlockNo(), err)
+		}
+	}
+}
+
+// archiveReceipts decodes the receipts of a record, with the bloom filter of
+// the block.
+func archiveReceipts(record *types.BlockArchive) (receipts *types.Receipts, err error) {
+	raw := record.GetReceipts()
+	if len(raw) == 0 {
+		return nil, ErrArchiveInvalidReceipts
+	}
+	// the binary decoding does not check the bounds of a malformed record
+	defer func() {
+		if r := recover(); r != nil {
+			receipts, err = nil, ErrArchiveInvalidReceipts
+		}
+	}()
+	receipts = &types.Receipts{}
+	if err := receipts.UnmarshalBinary(raw); err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
+
+func writeArchiveRecord(w io.Writer, record *types.BlockArchive) error {
+	raw, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+	var l [binary.MaxVarintLen64]byte
+	if _, err := w.Write(l[:binary.PutUvarint(l[:], uint64(len(raw)))]); err != nil {
+		return err
+	}
+	_, err = w.Write(raw)
+	return err
+}
+
+func readArchiveRecord(r *bufio.Reader) (*types.BlockArchive, error) {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if size > maxArchiveRecordSize {
+		return nil, ErrArchiveRecordTooLarge
+	}
+	raw := make([]byte, size)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	record := &types.BlockArchive{}
+	if err := proto.Unmarshal(raw, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
@@ -0,0 +1,131 @@
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/willf/bloom"
+)
+
+func TestArchiveRecord(t *testing.T) {
+	_, stubChain := testAddBlockNoTest(3)
+
+	var buf bytes.Buffer
+	for no := uint64(0); no <= 3; no++ {
+		record := &types.BlockArchive{
+			Block:    stubChain.GetBlockByNo(no),
+			Receipts: []byte{byte(no)},
+		}
+		assert.NoError(t, writeArchiveRecord(&buf, record))
+	}
+
+	r := bufio.NewReader(bytes.NewReader(buf.Bytes()))
+	for no := uint64(0); no <= 3; no++ {
+		record, err := readArchiveRecord(r)
+		assert.NoError(t, err)
+		assert.Equal(t, stubChain.GetBlockByNo(no).BlockHash(), record.GetBlock().BlockHash())
+		assert.Equal(t, []byte{byte(no)}, record.GetReceipts())
+	}
+	_, err := readArchiveRecord(r)
+	assert.Equal(t, io.EOF, err)
+
+	// a truncated record is an error, not the end of archive
+	r = bufio.NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	for no := uint64(0); no < 3; no++ {
+		_, err = readArchiveRecord(r)
+		assert.NoError(t, err)
+	}
+	_, err = readArchiveRecord(r)
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestArchiveExportImport(t *testing.T) {
+	src, _ := testAddBlock(t, 5)
+
+	var buf bytes.Buffer
+	n, err := src.ExportBlocks(&buf, 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, n)
+
+	dst := makeBlockChain()
+	n, err = dst.ImportBlocks(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, 6, n)
+	best, err := dst.GetBestBlock()
+	assert.NoError(t, err)
+	assert.Equal(t, src.cdb.getBestBlockNo(), best.BlockNo())
+	expected, _ := src.GetBestBlock()
+	assert.Equal(t, expected.BlockHash(), best.BlockHash())
+
+	// the blocks already in the chain are skipped
+	n, err = dst.ImportBlocks(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, 6, n)
+}
+
+func TestArchiveReceiptsWithEvents(t *testing.T) {
+	cs, mainChain := testAddBlock(t, 5)
+
+	contract := types.AddressPadding([]byte("event_test"))
+	r := types.NewReceipt(contract, "SUCCESS", "")
+	r.TxHash = make([]byte, 32)
+	r.Events = []*types.Event{
+		{ContractAddress: contract, EventName: "transfer", JsonArgs: `["alice", 1]`},
+		{ContractAddress: contract, EventName: "mint", JsonArgs: `["bob"]`, EventIdx: 1},
+	}
+	bf := bloom.New(types.BloomBitBits, types.BloomHashKNum)
+	for _, e := range r.Events {
+		bf.Add(e.ContractAddress)
+		bf.Add([]byte(e.EventName))
+	}
+	bloomBytes, _ := bf.GobEncode()
+	r.Bloom = bloomBytes[24:]
+	receipts := &types.Receipts{}
+	receipts.Set([]*types.Receipt{r})
+	assert.NoError(t, receipts.MergeBloom(bf))
+
+	block := mainChain.GetBlockByNo(3)
+	cs.cdb.writeReceipts(block.BlockHash(), block.BlockNo(), receipts)
+
+	var buf bytes.Buffer
+	n, err := cs.ExportBlocks(&buf, 2, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	br := bufio.NewReader(bytes.NewReader(buf.Bytes()))
+	for no := uint64(2); no <= 4; no++ {
+		record, err := readArchiveRecord(br)
+		assert.NoError(t, err)
+		imported, err := archiveReceipts(record)
+		assert.NoError(t, err)
+		if no != 3 {
+			// a block without txs has no stored receipts
+			assert.Empty(t, imported.Get())
+			assert.Equal(t, record.GetBlock().GetHeader().GetReceiptsRootHash(), imported.MerkleRoot())
+			continue
+		}
+		assert.Equal(t, receipts.MerkleRoot(), imported.MerkleRoot())
+		assert.Equal(t, receipts.BloomBytes(), imported.BloomBytes())
+		if assert.Len(t, imported.Get(), 1) {
+			assert.Len(t, imported.Get()[0].Events, 2)
+			assert.Equal(t, "mint", imported.Get()[0].Events[1].EventName)
+		}
+	}
+
+	// the receipts of the archive must match the header of the block
+	buf.Reset()
+	_, err = cs.ExportBlocks(&buf, 0, 5)
+	assert.NoError(t, err)
+	n, err = makeBlockChain().ImportBlocks(bytes.NewReader(buf.Bytes()))
+	assert.Equal(t, 4, n)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), ErrArchiveInvalidReceipts.Error())
+	}
+
+	_, err = archiveReceipts(&types.BlockArchive{Receipts: []byte{1, 0}})
+	assert.Equal(t, ErrArchiveInvalidReceipts, err)
+}
@@ -88,7 +88,7 @@ func (bv *BlockValidator) ValidateBody(block *types.Block) error {
 		return nil
 	}
 
-	bv.signVerifier.RequestVerifyTxs(&types.TxList{Txs: txs})
+	bv.signVerifier.RequestVerifyTxs(&types.TxList{Txs: txs}, block.BlockNo())
 	bv.isNeedWait = true
 
 	return nil
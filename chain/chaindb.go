@@ -76,6 +76,9 @@ type ChainDB struct {
 	bestBlock atomic.Value // *types.Block
 	//	blocks []*types.Block
 	store db.DB
+
+	accountTxIndex bool
+	resolveName    func(root []byte, blockNo types.BlockNo, name []byte) []byte
 }
 
 func NewChainDB() *ChainDB {
@@ -104,6 +107,7 @@ func (cdb *ChainDB) Init(dbType string, dataDir string) error {
 	if err := cdb.loadChainData(); err != nil {
 		return err
 	}
+	cdb.initEventIndexHeight()
 
 	// recover from reorg marker
 	if err := cdb.recover(); err != nil {
@@ -395,6 +399,44 @@ func (cdb *ChainDB) connectToChain(dbtx db.Transaction, block *types.Block, skip
 	return
 }
 
+// addSnapshotBlock stores block, which is before the pivot of snapshot sync,
+// to the main chain without changing the best block. The block is not
+// executed, so it has no receipts.
+func (cdb *ChainDB) addSnapshotBlock(block *types.Block) error {
+	dbTx := cdb.store.NewTx()
+	defer dbTx.Discard()
+
+	if err := cdb.addBlock(dbTx, block); err != nil {
+		return err
+	}
+	dbTx.Set(types.BlockNoToBytes(block.BlockNo()), block.BlockHash())
+	if err := cdb.addTxsOfBlock(&dbTx, block.GetBody().GetTxs(), block.BlockHash()); err != nil {
+		return err
+	}
+
+	dbTx.Commit()
+
+	return nil
+}
+
+// connectSnapshot connects block, whose state was imported by snapshot sync,
+// to the chain as the best block. The blocks before it are stored by
+// addSnapshotBlock.
+func (cdb *ChainDB) connectSnapshot(block *types.Block) error {
+	dbTx := cdb.store.NewTx()
+	defer dbTx.Discard()
+
+	cdb.connectToChain(dbTx, block, false)
+	if err := cdb.addTxsOfBlock(&dbTx, block.GetBody().GetTxs(), block.BlockHash()); err != nil {
+		return err
+	}
+	cdb.addAccountTxs(&dbTx, block)
+
+	dbTx.Commit()
+
+	return nil
+}
+
 func (cdb *ChainDB) swapChainMapping(newBlocks []*types.Block) error {
 	oldNo := cdb.getBestBlockNo()
 	newNo := newBlocks[0].GetHeader().GetBlockNo()
@@ -537,6 +579,7 @@ func (cdb *ChainDB) dropBlock(dropNo types.BlockNo) error {
 	for _, tx := range dropBlock.GetBody().GetTxs() {
 		cdb.deleteTx(&dbTx, tx)
 	}
+	cdb.deleteAccountTxs(&dbTx, dropBlock.BlockHash())
 
 	// remove receipt
 	cdb.deleteReceipts(&dbTx, dropBlock.BlockHash(), dropBlock.BlockNo())
@@ -707,11 +750,15 @@ func (cdb *ChainDB) writeReceipts(blockHash []byte, blockNo types.BlockNo, recei
 	gob.Encode(receipts)
 
 	dbTx.Set(receiptsKey(blockHash, blockNo), val.Bytes())
+	updateEventIndex(dbTx, blockHash, blockNo, receipts, false)
 
 	dbTx.Commit()
 }
 
 func (cdb *ChainDB) deleteReceipts(dbTx *db.Transaction, blockHash []byte, blockNo types.BlockNo) {
+	if receipts, err := cdb.getReceipts(blockHash, blockNo); err == nil {
+		updateEventIndex(*dbTx, blockHash, blockNo, receipts, true)
+	}
 	(*dbTx).Delete(receiptsKey(blockHash, blockNo))
 }
 
@@ -0,0 +1,172 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/Cofresi/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/types"
+)
+
+var (
+	ErrNoAccountTxIndex = errors.New("account tx index is disabled")
+
+	accountTxPrefix     = []byte("a_idx.")
+	accountTxUndoPrefix = []byte("a_undo.")
+)
+
+const (
+	accountTxKeyLen      = 32 + 8 + 4
+	defaultAccountTxSize = 100
+	maxAccountTxSize     = 1000
+)
+
+// EnableAccountTxIndex turns on the index of transactions by sender and
+// recipient. resolveName returns the address of a name in the state of root,
+// which is the state root of the block of blockNo including the transaction.
+// Only blocks connected while the index is enabled are indexed.
+func (cdb *ChainDB) EnableAccountTxIndex(resolveName func(root []byte, blockNo types.BlockNo, name []byte) []byte) {
+	cdb.accountTxIndex = true
+	cdb.resolveName = resolveName
+}
+
+func accountTxKey(account []byte, blockNo types.BlockNo, idx int) []byte {
+	var key bytes.Buffer
+	key.Write(accountTxPrefix)
+	key.Write(common.Hasher(account))
+	l := make([]byte, 8)
+	binary.BigEndian.PutUint64(l, blockNo)
+	key.Write(l)
+	binary.BigEndian.PutUint32(l[:4], uint32(idx))
+	key.Write(l[:4])
+	return key.Bytes()
+}
+
+func accountTxUndoKey(blockHash []byte) []byte {
+	return append(append([]byte{}, accountTxUndoPrefix...), blockHash...)
+}
+
+// accountsOfTx returns the accounts that tx is indexed under: the sender, the
+// recipient and the addresses of them if they are names. Names are resolved
+// in the state of root, the one of the block of blockNo.
+func (cdb *ChainDB) accountsOfTx(tx *types.Tx, root []byte, blockNo types.BlockNo) [][]byte {
+	var accounts [][]byte
+	add := func(account []byte) {
+		if len(account) == 0 {
+			return
+		}
+		for _, a := range accounts {
+			if bytes.Equal(a, account) {
+				return
+			}
+		}
+		accounts = append(accounts, account)
+	}
+	for _, account := range [][]byte{tx.GetBody().GetAccount(), tx.GetBody().GetRecipient()} {
+		add(account)
+		if types.IsNameAddress(account) && cdb.resolveName != nil {
+			add(cdb.resolveName(root, blockNo, account))
+		}
+	}
+	return accounts
+}
+
+// addAccountTxs indexes the transactions of block. The keys written are
+// recorded with the block hash so that deleteAccountTxs removes exactly them,
+// whatever the names resolve to later.
+func (cdb *ChainDB) addAccountTxs(dbTx *db.Transaction, block *types.Block) {
+	if !cdb.accountTxIndex {
+		return
+	}
+	var undo bytes.Buffer
+	for i, tx := range block.GetBody().GetTxs() {
+		for _, account := range cdb.accountsOfTx(tx, block.GetHeader().GetBlocksRootHash(), block.BlockNo()) {
+			key := accountTxKey(account, block.BlockNo(), i)
+			(*dbTx).Set(key, tx.GetHash())
+			undo.Write(key)
+		}
+	}
+	if undo.Len() > 0 {
+		(*dbTx).Set(accountTxUndoKey(block.BlockHash()), undo.Bytes())
+	}
+}
+
+// deleteAccountTxs removes the index entries of the block of blockHash. It
+// is done even if the index is disabled now, since the entries may have been
+// written before.
+func (cdb *ChainDB) deleteAccountTxs(dbTx *db.Transaction, blockHash []byte) {
+	undoKey := accountTxUndoKey(blockHash)
+	keys := cdb.store.Get(undoKey)
+	keyLen := len(accountTxPrefix) + accountTxKeyLen
+	for i := 0; i+keyLen <= len(keys); i += keyLen {
+		(*dbTx).Delete(keys[i : i+keyLen])
+	}
+	if len(keys) > 0 {
+		(*dbTx).Delete(undoKey)
+	}
+}
+
+// getAccountTxs returns a page of the transactions sent from or to account.
+// Transactions are ordered by block number and index, newest first unless asc
+// is set.
+func (cdb *ChainDB) getAccountTxs(account []byte, offset, size uint32, asc bool) ([]*types.AccountTx, error) {
+	if !cdb.accountTxIndex {
+		return nil, ErrNoAccountTxIndex
+	}
+	if size == 0 {
+		size = defaultAccountTxSize
+	} else if size > maxAccountTxSize {
+		size = maxAccountTxSize
+	}
+
+	var prefix bytes.Buffer
+	prefix.Write(accountTxPrefix)
+	prefix.Write(common.Hasher(account))
+	lower := prefix.Bytes()
+	upper := append(append([]byte{}, lower...), bytes.Repeat([]byte{0xff}, accountTxKeyLen-32)...)
+
+	var it db.Iterator
+	if asc {
+		it = cdb.store.Iterator(lower, upper)
+	} else {
+		it = cdb.store.Iterator(upper, lower)
+	}
+
+	txs := make([]*types.AccountTx, 0, size)
+	var skipped uint32
+	for ; it.Valid() && uint32(len(txs)) < size; it.Next() {
+		key := it.Key()
+		if len(key) != len(accountTxPrefix)+accountTxKeyLen {
+			continue
+		}
+		pos := len(lower)
+		blockNo := binary.BigEndian.Uint64(key[pos:])
+		idx := int32(binary.BigEndian.Uint32(key[pos+8:]))
+		txHash := append([]byte{}, it.Value()...)
+
+		// skip entries left by blocks which are not in the main chain anymore
+		blockHash, err := cdb.getHashByNo(blockNo)
+		if err != nil {
+			continue
+		}
+		txIdx := &types.TxIdx{}
+		if err := cdb.loadData(txHash, txIdx); err != nil ||
+			!bytes.Equal(txIdx.GetBlockHash(), blockHash) || txIdx.GetIdx() != idx {
+			continue
+		}
+
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		txs = append(txs, &types.AccountTx{
+			TxHash:    txHash,
+			BlockNo:   blockNo,
+			BlockHash: blockHash,
+			TxIdx:     idx,
+		})
+	}
+	return txs, nil
+}
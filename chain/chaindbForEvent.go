@@ -0,0 +1,169 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/Cofresi/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/types"
+)
+
+var (
+	eventIndexPrefix    = []byte("e_idx.")
+	eventIndexHeightKey = []byte("e_idx_height")
+)
+
+const (
+	eventTopicLen    = 32
+	eventIndexKeyLen = 8 + 32 + 4 + 4
+)
+
+// eventIndexEntry locates an event stored in the receipts of a block.
+type eventIndexEntry struct {
+	blockNo   types.BlockNo
+	blockHash []byte
+	txIdx     int32
+	eventIdx  int32
+}
+
+// eventTopic returns the index topic of events emitted by contract. The name
+// and the first argument are optional and narrow the topic when given.
+func eventTopic(contract []byte, name string, arg []byte) []byte {
+	if len(name) == 0 {
+		return common.Hasher(contract)
+	}
+	l := make([]byte, 4)
+	binary.BigEndian.PutUint32(l, uint32(len(name)))
+	if arg == nil {
+		return common.Hasher(contract, l, []byte(name))
+	}
+	return common.Hasher(contract, l, []byte(name), arg)
+}
+
+// eventTopics returns all the index topics that ev is written under.
+func eventTopics(ev *types.Event) [][]byte {
+	topics := [][]byte{
+		eventTopic(ev.ContractAddress, "", nil),
+		eventTopic(ev.ContractAddress, ev.EventName, nil),
+	}
+	if arg := ev.FirstArg(); arg != nil {
+		topics = append(topics, eventTopic(ev.ContractAddress, ev.EventName, arg))
+	}
+	return topics
+}
+
+// filterTopic returns the narrowest index topic matching filter.
+func filterTopic(filter *types.FilterInfo, argFilter []types.ArgFilter) []byte {
+	if len(filter.EventName) == 0 {
+		return eventTopic(filter.ContractAddress, "", nil)
+	}
+	return eventTopic(filter.ContractAddress, filter.EventName, types.FirstArgFilter(argFilter))
+}
+
+// eventIndexKey returns the key of an index entry. Entries of a topic are
+// ordered by block number, then by the position of the event in the block.
+func eventIndexKey(topic []byte, e *eventIndexEntry) []byte {
+	var key bytes.Buffer
+	key.Write(eventIndexPrefix)
+	key.Write(topic)
+	l := make([]byte, 8)
+	binary.BigEndian.PutUint64(l, e.blockNo)
+	key.Write(l)
+	key.Write(e.blockHash)
+	binary.BigEndian.PutUint32(l[:4], uint32(e.txIdx))
+	key.Write(l[:4])
+	binary.BigEndian.PutUint32(l[:4], uint32(e.eventIdx))
+	key.Write(l[:4])
+	return key.Bytes()
+}
+
+// eventIndexBound returns the smallest possible key of topic at blockNo.
+func eventIndexBound(topic []byte, blockNo types.BlockNo) []byte {
+	var key bytes.Buffer
+	key.Write(eventIndexPrefix)
+	key.Write(topic)
+	l := make([]byte, 8)
+	binary.BigEndian.PutUint64(l, blockNo)
+	key.Write(l)
+	return key.Bytes()
+}
+
+func parseEventIndexKey(key []byte) *eventIndexEntry {
+	pos := len(eventIndexPrefix) + eventTopicLen
+	if len(key) != pos+eventIndexKeyLen {
+		return nil
+	}
+	e := &eventIndexEntry{}
+	e.blockNo = binary.BigEndian.Uint64(key[pos:])
+	pos += 8
+	e.blockHash = make([]byte, 32)
+	copy(e.blockHash, key[pos:pos+32])
+	pos += 32
+	e.txIdx = int32(binary.BigEndian.Uint32(key[pos:]))
+	pos += 4
+	e.eventIdx = int32(binary.BigEndian.Uint32(key[pos:]))
+	return e
+}
+
+// updateEventIndex sets or deletes the index entries of every event in
+// receipts.
+func updateEventIndex(dbTx db.Transaction, blockHash []byte, blockNo types.BlockNo,
+	receipts *types.Receipts, del bool) {
+	for txIdx, r := range receipts.Get() {
+		for evIdx, ev := range r.Events {
+			e := &eventIndexEntry{
+				blockNo:   blockNo,
+				blockHash: blockHash,
+				txIdx:     int32(txIdx),
+				eventIdx:  int32(evIdx),
+			}
+			for _, topic := range eventTopics(ev) {
+				if del {
+					dbTx.Delete(eventIndexKey(topic, e))
+				} else {
+					dbTx.Set(eventIndexKey(topic, e), []byte{})
+				}
+			}
+		}
+	}
+}
+
+// initEventIndexHeight records the first block covered by the event index.
+// Blocks written before the index existed are not indexed.
+func (cdb *ChainDB) initEventIndexHeight() {
+	if cdb.store.Exist(eventIndexHeightKey) {
+		return
+	}
+	cdb.store.Set(eventIndexHeightKey, types.BlockNoToBytes(cdb.getBestBlockNo()+1))
+}
+
+// getEventIndexHeight returns the first block covered by the event index.
+func (cdb *ChainDB) getEventIndexHeight() types.BlockNo {
+	data := cdb.store.Get(eventIndexHeightKey)
+	if len(data) == 0 {
+		return 0
+	}
+	return types.BlockNoFromBytes(data)
+}
+
+// iterEventIndex calls fn for each index entry of topic between from and to
+// (inclusive) until fn returns false.
+func (cdb *ChainDB) iterEventIndex(topic []byte, from, to types.BlockNo, desc bool,
+	fn func(e *eventIndexEntry) bool) {
+	var it db.Iterator
+	if desc {
+		it = cdb.store.Iterator(eventIndexBound(topic, to+1), eventIndexBound(topic, from))
+	} else {
+		it = cdb.store.Iterator(eventIndexBound(topic, from), eventIndexBound(topic, to+1))
+	}
+	for ; it.Valid(); it.Next() {
+		e := parseEventIndexKey(it.Key())
+		if e == nil || e.blockNo < from || e.blockNo > to {
+			continue
+		}
+		if !fn(e) {
+			return
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/contract/name"
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/internal/common"
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/message"
@@ -131,6 +132,55 @@ func (cs *ChainService) getReceipt(txHash []byte) (*types.Receipt, error) {
 	return r, nil
 }
 
+// getReceiptProof returns the receipt of txHash with its merkle proof against
+// the receipts root hash of the block. The receipt is kept as stored, so that
+// its hash matches the merkle leaf. If txHash is empty, the proof of the
+// bloom filter of the block of blockHash is returned instead.
+func (cs *ChainService) getReceiptProof(txHash []byte, blockHash []byte) (*types.ReceiptProof, error) {
+	idx := -1
+	if len(txHash) > 0 {
+		_, txIdx, err := cs.cdb.getTx(txHash)
+		if err != nil {
+			return nil, err
+		}
+		blockHash, idx = txIdx.BlockHash, int(txIdx.Idx)
+	}
+
+	block, err := cs.cdb.getBlock(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	blockNo := block.GetHeader().GetBlockNo()
+	blockInMainChain, err := cs.cdb.GetBlockByNo(blockNo)
+	if err != nil || !bytes.Equal(block.BlockHash(), blockInMainChain.BlockHash()) {
+		return nil, errors.New("block is not in the main chain")
+	}
+
+	receipts, err := cs.cdb.getReceipts(block.BlockHash(), blockNo)
+	if err != nil {
+		return nil, err
+	}
+	proof := &types.ReceiptProof{
+		BlockHash: block.BlockHash(),
+		BlockNo:   blockNo,
+	}
+	if idx < 0 {
+		if proof.Bloom = receipts.BloomBytes(); proof.Bloom == nil {
+			return nil, errors.New("block has no bloom filter")
+		}
+		idx = len(receipts.Get())
+	} else {
+		if idx >= len(receipts.Get()) {
+			return nil, fmt.Errorf("cannot find a receipt: invalid index (%d)", idx)
+		}
+		proof.Receipt = receipts.Get()[idx]
+	}
+	proof.Index = uint32(idx)
+	proof.AuditPath = receipts.MerkleProof(idx)
+
+	return proof, nil
+}
+
 func (cs *ChainService) getEvents(events *[]*types.Event, blkNo types.BlockNo, filter *types.FilterInfo,
 	argFilter []types.ArgFilter) uint64 {
 	blkHash, err := cs.cdb.getHashByNo(blkNo)
@@ -186,26 +236,117 @@ func (cs *ChainService) listEvents(filter *types.FilterInfo) ([]*types.Event, er
 	if err != nil {
 		return nil, err
 	}
+	// blocks below the index height were written before the event index
+	// existed, so they are still scanned block by block
+	indexFrom := cs.cdb.getEventIndexHeight()
 	events := []*types.Event{}
 	var totalSize uint64
-	if filter.Desc {
-		for i := to; i >= from && i != 0; i-- {
-			totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter)
-			if totalSize > MaxEventSize {
-				return nil, errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
+	scan := func() error {
+		if from >= indexFrom {
+			return nil
+		}
+		scanTo := to
+		if scanTo >= indexFrom {
+			scanTo = indexFrom - 1
+		}
+		if filter.Desc {
+			for i := scanTo; i >= from && i != 0; i-- {
+				totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter)
+				if totalSize > MaxEventSize {
+					return errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
+				}
+			}
+		} else {
+			for i := from; i <= scanTo; i++ {
+				totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter)
+				if totalSize > MaxEventSize {
+					return errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
+				}
 			}
 		}
+		return nil
+	}
+	lookup := func() error {
+		if to < indexFrom {
+			return nil
+		}
+		lookupFrom := from
+		if lookupFrom < indexFrom {
+			lookupFrom = indexFrom
+		}
+		totalSize += cs.getIndexedEvents(&events, lookupFrom, to, filter, argFilter, MaxEventSize-totalSize)
+		if totalSize > MaxEventSize {
+			return errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
+		}
+		return nil
+	}
+	if filter.Desc {
+		err = lookup()
+		if err == nil {
+			err = scan()
+		}
 	} else {
-		for i := from; i <= to; i++ {
-			totalSize += cs.getEvents(&events, types.BlockNo(i), filter, argFilter)
-			if totalSize > MaxEventSize {
-				return nil, errors.New(fmt.Sprintf("too large size of event (%v)", totalSize))
-			}
+		err = scan()
+		if err == nil {
+			err = lookup()
 		}
 	}
+	if err != nil {
+		return nil, err
+	}
 	return events, nil
 }
 
+// getIndexedEvents appends the events matching filter in the blocks between
+// from and to using the event index of the chain DB. It stops as soon as the
+// total size of the appended events exceeds limit.
+func (cs *ChainService) getIndexedEvents(events *[]*types.Event, from, to types.BlockNo,
+	filter *types.FilterInfo, argFilter []types.ArgFilter, limit uint64) uint64 {
+	var (
+		totalSize uint64
+		receipts  *types.Receipts
+		blkHash   []byte
+		pending   []*types.Event
+	)
+	// events of a block are kept in ascending order even in descending mode
+	flush := func() {
+		for i := len(pending) - 1; i >= 0; i-- {
+			*events = append(*events, pending[i])
+		}
+		pending = pending[:0]
+	}
+	cs.cdb.iterEventIndex(filterTopic(filter, argFilter), from, to, filter.Desc, func(e *eventIndexEntry) bool {
+		if !bytes.Equal(blkHash, e.blockHash) {
+			flush()
+			blkHash = e.blockHash
+			receipts = nil
+			if mainHash, err := cs.cdb.getHashByNo(e.blockNo); err != nil || !bytes.Equal(mainHash, e.blockHash) {
+				return true
+			}
+			receipts, _ = cs.cdb.getReceipts(e.blockHash, e.blockNo)
+		}
+		rs := receipts.Get()
+		if int(e.txIdx) >= len(rs) || int(e.eventIdx) >= len(rs[e.txIdx].Events) {
+			return true
+		}
+		r := rs[e.txIdx]
+		ev := r.Events[e.eventIdx]
+		if !ev.Filter(filter, argFilter) {
+			return true
+		}
+		ev.SetMemoryInfo(r, e.blockHash, e.blockNo, e.txIdx)
+		if filter.Desc {
+			pending = append(pending, ev)
+		} else {
+			*events = append(*events, ev)
+		}
+		totalSize += uint64(proto.Size(ev))
+		return totalSize <= limit
+	})
+	flush()
+	return totalSize
+}
+
 type chainProcessor struct {
 	*ChainService
 	block       *types.Block // starting block
@@ -356,6 +497,7 @@ func (cp *chainProcessor) connectToChain(block *types.Block) (types.BlockNo, err
 	if err := cp.cdb.addTxsOfBlock(&dbTx, block.GetBody().GetTxs(), block.BlockHash()); err != nil {
 		return 0, err
 	}
+	cp.cdb.addAccountTxs(&dbTx, block)
 
 	dbTx.Commit()
 
@@ -489,6 +631,8 @@ func (cs *ChainService) addBlockInternal(newBlock *types.Block, usedBstate *stat
 
 	logger.Info().Uint64("best", cs.cdb.getBestBlockNo()).Msg("Block added successfully")
 
+	cs.pruneState()
+
 	return nil, true
 }
 
@@ -558,7 +702,9 @@ type blockExecutor struct {
 	execTx           TxExecFn
 	txs              []*types.Tx
 	validatePost     ValidatePostFn
+	recordSlots      func() error
 	coinbaseAcccount []byte
+	blockNo          types.BlockNo
 	commitOnly       bool
 	verifyOnly       bool
 	validateSignWait ValidateSignWaitFn
@@ -567,6 +713,7 @@ type blockExecutor struct {
 func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.Block, verifyOnly bool) (*blockExecutor, error) {
 	var exec TxExecFn
 	var validateSignWait ValidateSignWaitFn
+	var recordSlots func() error
 
 	commitOnly := false
 
@@ -586,6 +733,26 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 		validateSignWait = func() error {
 			return cs.validator.WaitVerifyDone()
 		}
+
+		// the slots are recorded from the V2 hardfork
+		if sr, ok := cs.ChainConsensus.(consensus.SlotReporter); ok && types.IsV2Fork(block.BlockNo()) {
+			prev, err := cs.cdb.GetBlock(block.GetHeader().GetPrevBlockHash())
+			if err != nil {
+				return nil, err
+			}
+			producer, err := block.BPID()
+			if err != nil {
+				return nil, err
+			}
+			bps, err := sr.BPs(block.BlockNo())
+			if err != nil {
+				return nil, err
+			}
+			missed := sr.MissedBPs(bps, prev.GetHeader().GetTimestamp(), block.GetHeader().GetTimestamp())
+			recordSlots = func() error {
+				return RecordSlots(bState, bps, producer, missed, block.BlockNo())
+			}
+		}
 	} else {
 		logger.Debug().Uint64("block no", block.BlockNo()).Msg("received block from block factory")
 		// In this case (bState != nil), the transactions has already been
@@ -599,12 +766,14 @@ func newBlockExecutor(cs *ChainService, bState *state.BlockState, block *types.B
 		execTx:           exec,
 		txs:              block.GetBody().GetTxs(),
 		coinbaseAcccount: block.GetHeader().GetCoinbaseAccount(),
+		blockNo:          block.BlockNo(),
 		validatePost: func() error {
 			return cs.validator.ValidatePost(bState.GetRoot(), bState.Receipts(), block)
 		},
 		commitOnly:       commitOnly,
 		verifyOnly:       verifyOnly,
 		validateSignWait: validateSignWait,
+		recordSlots:      recordSlots,
 	}, nil
 }
 
@@ -634,6 +803,11 @@ func (e *blockExecutor) execute() error {
 	// Receipt must be committed unconditionally.
 	if !e.commitOnly {
 		defer contract.CloseDatabase()
+		if e.recordSlots != nil {
+			if err := e.recordSlots(); err != nil {
+				return err
+			}
+		}
 		var preLoadTx *types.Tx
 		nCand := len(e.txs)
 		for i, tx := range e.txs {
@@ -656,11 +830,11 @@ func (e *blockExecutor) execute() error {
 		}
 
 		//TODO check result of verifing txs
-		if err := SendRewardCoinbase(e.BlockState, e.coinbaseAcccount); err != nil {
+		if err := SendRewardCoinbase(e.BlockState, e.coinbaseAcccount, e.blockNo); err != nil {
 			return err
 		}
 
-		if err := contract.SaveRecoveryPoint(e.BlockState); err != nil {
+		if err := contract.SaveRecoveryPoint(e.BlockState, e.blockNo); err != nil {
 			return err
 		}
 
@@ -816,6 +990,11 @@ func (cs *ChainService) notifyEvents(block *types.Block, bstate *state.BlockStat
 
 	logger.Debug().Uint64("no", blkNo).Msg("add event from executed block")
 
+	// no service to notify while importing an archive
+	if cs.Hub() == nil {
+		return
+	}
+
 	cs.RequestTo(message.MemPoolSvc, &message.MemPoolDel{
 		Block: block,
 	})
@@ -846,6 +1025,34 @@ func adjustRv(ret string) string {
 	return ret
 }
 
+// setMultisig records the keys of a multisig sender in its account state,
+// which is done by its first tx from the V2 hardfork.
+func setMultisig(sender *state.V, txBody *types.TxBody, blockNo types.BlockNo) {
+	if !types.IsV2Fork(blockNo) || !types.IsMultisigAddress(sender.ID()) || sender.State().GetMultisig() != nil {
+		return
+	}
+	if msSign, err := types.DecodeMultisigSign(txBody.GetSign()); err == nil {
+		sender.State().Multisig = msSign.Multisig
+	}
+}
+
+// checkFeeDelegation checks that the contract receiver agrees to pay the fee
+// of tx from sender, and that it can afford the fee.
+func checkFeeDelegation(bs *state.BlockState, cdb contract.ChainAccessor, tx types.Transaction,
+	sender, receiver *state.V) error {
+	if len(receiver.State().GetCodeHash()) == 0 {
+		return types.ErrNotAllowedFeeDelegation
+	}
+	if receiver.Balance().Cmp(tx.GetMaxFee()) < 0 {
+		return types.ErrInsufficientBalance
+	}
+	contractState, err := bs.OpenContractState(receiver.AccountID(), receiver.State())
+	if err != nil {
+		return err
+	}
+	return contract.CheckFeeDelegation(receiver.ID(), bs, cdb, contractState, tx.GetBody().GetPayload(), sender.ID())
+}
+
 func executeTx(ccc consensus.ChainConsensusCluster, cdb contract.ChainAccessor, bs *state.BlockState, tx types.Transaction, blockNo uint64, ts int64, prevBlockHash []byte, preLoadService int, chainIDHash []byte) error {
 
 	txBody := tx.GetBody()
@@ -854,18 +1061,30 @@ func executeTx(ccc consensus.ChainConsensusCluster, cdb contract.ChainAccessor,
 	if tx.HasVerifedAccount() {
 		account = tx.GetVerifedAccount()
 		tx.RemoveVerifedAccount()
-		resolvedAccount := name.Resolve(bs, txBody.GetAccount())
+		resolvedAccount := name.Resolve(bs, txBody.GetAccount(), blockNo)
 		if !bytes.Equal(account, resolvedAccount) {
 			return types.ErrSignNotMatch
 		}
 	} else {
-		account = name.Resolve(bs, txBody.GetAccount())
+		account = name.Resolve(bs, txBody.GetAccount(), blockNo)
 	}
 
 	err := tx.Validate(chainIDHash, IsPublic())
 	if err != nil {
 		return err
 	}
+	// a public chain accepts contract upgrades from the V2 hardfork
+	if txBody.Type == types.TxType_REDEPLOY && IsPublic() && !types.IsV2Fork(blockNo) {
+		return types.ErrTxInvalidType
+	}
+	// a contract pays the fee of a tx from the V2 hardfork
+	if txBody.Type == types.TxType_FEEDELEGATION && !types.IsV2Fork(blockNo) {
+		return types.ErrTxInvalidType
+	}
+	// a multisig account sends txs from the V2 hardfork
+	if types.IsMultisigAddress(account) && !types.IsV2Fork(blockNo) {
+		return types.ErrInvalidMultisig
+	}
 
 	sender, err := bs.GetAccountStateV(account)
 	if err != nil {
@@ -877,7 +1096,15 @@ func executeTx(ccc consensus.ChainConsensusCluster, cdb contract.ChainAccessor,
 		return err
 	}
 
-	recipient := name.Resolve(bs, txBody.Recipient)
+	if err := validateGasPrice(bs, txBody, blockNo); err != nil {
+		return err
+	}
+
+	recipient := name.Resolve(bs, txBody.Recipient, blockNo)
+	// an expired name must not turn a transfer into a contract creation
+	if len(txBody.Recipient) != 0 && len(recipient) == 0 && types.IsV2Fork(blockNo) {
+		return types.ErrTxInvalidRecipient
+	}
 	var receiver *state.V
 	status := "SUCCESS"
 	if len(recipient) > 0 {
@@ -894,13 +1121,25 @@ func executeTx(ccc consensus.ChainConsensusCluster, cdb contract.ChainAccessor,
 		return err
 	}
 
+	var prevCodeHash []byte
+	if status == "RECREATED" && types.IsV2Fork(blockNo) {
+		prevCodeHash = receiver.State().GetCodeHash()
+	}
+
 	var txFee *big.Int
+	var gasUsed uint64
 	var rv string
 	var events []*types.Event
 	switch txBody.Type {
 	case types.TxType_NORMAL, types.TxType_REDEPLOY:
-		rv, events, txFee, err = contract.Execute(bs, cdb, tx.GetTx(), blockNo, ts, prevBlockHash, sender, receiver, preLoadService)
+		rv, events, txFee, gasUsed, err = contract.Execute(bs, cdb, tx.GetTx(), blockNo, ts, prevBlockHash, sender, receiver, preLoadService)
 		sender.SubBalance(txFee)
+	case types.TxType_FEEDELEGATION:
+		if err = checkFeeDelegation(bs, cdb, tx, sender, receiver); err != nil {
+			return err
+		}
+		rv, events, txFee, gasUsed, err = contract.Execute(bs, cdb, tx.GetTx(), blockNo, ts, prevBlockHash, sender, receiver, preLoadService)
+		receiver.SubBalance(txFee)
 	case types.TxType_GOVERNANCE:
 		txFee = new(big.Int).SetUint64(0)
 		events, err = executeGovernanceTx(ccc, bs, txBody, sender, receiver, blockNo)
@@ -914,8 +1153,17 @@ func executeTx(ccc consensus.ChainConsensusCluster, cdb contract.ChainAccessor,
 			return err
 		}
 		sender.Reset()
-		sender.SubBalance(txFee)
+		if txBody.Type == types.TxType_FEEDELEGATION {
+			receiver.Reset()
+			receiver.SubBalance(txFee)
+			if rErr := receiver.PutState(); rErr != nil {
+				return rErr
+			}
+		} else {
+			sender.SubBalance(txFee)
+		}
 		sender.SetNonce(txBody.Nonce)
+		setMultisig(sender, txBody, blockNo)
 		sErr := sender.PutState()
 		if sErr != nil {
 			return sErr
@@ -924,6 +1172,7 @@ func executeTx(ccc consensus.ChainConsensusCluster, cdb contract.ChainAccessor,
 		rv = err.Error()
 	} else {
 		sender.SetNonce(txBody.Nonce)
+		setMultisig(sender, txBody, blockNo)
 		err = sender.PutState()
 		if err != nil {
 			return err
@@ -936,18 +1185,110 @@ func executeTx(ccc consensus.ChainConsensusCluster, cdb contract.ChainAccessor,
 		}
 		rv = adjustRv(rv)
 	}
+	if status != "RECREATED" {
+		prevCodeHash = nil
+	}
 	bs.BpReward = new(big.Int).Add(new(big.Int).SetBytes(bs.BpReward), txFee).Bytes()
 
 	receipt := types.NewReceipt(receiver.ID(), status, rv)
 	receipt.FeeUsed = txFee.Bytes()
+	receipt.GasUsed = gasUsed
+	receipt.FeeDelegation = txBody.Type == types.TxType_FEEDELEGATION
+	receipt.PrevCodeHash = prevCodeHash
 	receipt.TxHash = tx.GetHash()
 	receipt.Events = events
 
 	return bs.AddReceipt(receipt)
 }
 
-func SendRewardCoinbase(bState *state.BlockState, coinbaseAccount []byte) error {
+// simulateTx executes tx on top of the best block without committing the
+// result. It returns the receipt which tx would produce and the accounts and
+// contract storage keys which tx would change. The chain id hash and the hash
+// of tx are filled in if they are omitted, so that an unsigned tx can be
+// simulated as well. tx is executed with the timestamp of the best block.
+func (cs *ChainService) simulateTx(tx *types.Tx) (*types.SimulateResult, error) {
+	if tx.GetBody() == nil {
+		return nil, types.ErrTxFormatInvalid
+	}
+
+	// The SQL databases of contracts are shared with the block execution
+	// and the block factory. Hold the chain lock so that the simulation
+	// never runs in the middle of a block, where no database has an open
+	// transaction.
+	select {
+	case InAddBlock <- struct{}{}:
+	}
+	defer func() {
+		<-InAddBlock
+	}()
+
+	bestBlock, err := cs.cdb.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	chainID := bestBlock.GetHeader().GetChainID()
+
+	tx = proto.Clone(tx).(*types.Tx)
+	if len(tx.Body.ChainIdHash) == 0 {
+		tx.Body.ChainIdHash = common.Hasher(chainID)
+	}
+	if len(tx.Hash) == 0 {
+		tx.Hash = tx.CalculateTxHash()
+	}
+
+	root := bestBlock.GetHeader().GetBlocksRootHash()
+	bState := state.NewBlockState(cs.sdb.OpenNewStateDB(root))
+	// The changes to the SQL databases of contracts are left in the open
+	// transactions, which are rolled back by closing the databases.
+	defer contract.CloseDatabase()
+
+	err = executeTx(cs.ChainConsensus, cs.cdb, bState, types.NewTransaction(tx), bestBlock.BlockNo()+1,
+		bestBlock.GetHeader().GetTimestamp(), bestBlock.BlockHash(), contract.ChainService, common.Hasher(chainID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.SimulateResult{}
+	if receipts := bState.Receipts().Get(); len(receipts) > 0 {
+		result.Receipt = receipts[len(receipts)-1]
+	}
+
+	prevState := cs.sdb.OpenNewStateDB(root)
+	for _, id := range bState.ChangedAccounts() {
+		before, err := prevState.GetAccountState(id)
+		if err != nil {
+			return nil, err
+		}
+		after, err := bState.GetAccountState(id)
+		if err != nil {
+			return nil, err
+		}
+		storageKeys := bState.ChangedStorageKeys(id)
+		if len(storageKeys) == 0 && proto.Equal(before, after) {
+			continue
+		}
+		result.Changes = append(result.Changes, &types.AccountChange{
+			Account:     id[:],
+			Before:      before,
+			After:       after,
+			StorageKeys: storageKeys,
+		})
+	}
+	return result, nil
+}
+
+// SendRewardCoinbase sends the fees of the block of blockNo and the share of
+// the BP from the coins minted in the block to the coinbase account. The
+// coins are minted from the V2 hardfork.
+func SendRewardCoinbase(bState *state.BlockState, coinbaseAccount []byte, blockNo types.BlockNo) error {
 	bpReward := new(big.Int).SetBytes(bState.BpReward)
+	if Genesis != nil && Genesis.Issuance != nil && types.IsV2Fork(blockNo) {
+		minted, err := mintReward(bState, Genesis.Issuance, blockNo)
+		if err != nil {
+			return err
+		}
+		bpReward.Add(bpReward, minted)
+	}
 	if bpReward.Cmp(new(big.Int).SetUint64(0)) <= 0 || coinbaseAccount == nil {
 		logger.Debug().Str("reward", new(big.Int).SetBytes(bState.BpReward).String()).Msg("coinbase is skipped")
 		return nil
@@ -973,6 +1314,38 @@ func SendRewardCoinbase(bState *state.BlockState, coinbaseAccount []byte) error
 	return nil
 }
 
+// RecordSlots records in the system account that producer, one of the BPs in
+// bps elected for the block of blockNo, produced the block, and that the BPs
+// in missed did not produce a block in their slots before it.
+func RecordSlots(bState *state.BlockState, bps []types.PeerID, producer types.PeerID, missed []types.PeerID,
+	blockNo types.BlockNo) error {
+	scs, err := bState.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	if err := system.RecordSlots(scs, bps, producer, missed, blockNo); err != nil {
+		return err
+	}
+	return bState.StageContractState(scs)
+}
+
+// mintReward mints the coins of the block of blockNo, and returns the share of
+// the BP. The share of the voters is kept in the system account until claimed.
+func mintReward(bState *state.BlockState, issuance *types.Issuance, blockNo types.BlockNo) (*big.Int, error) {
+	scs, err := bState.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	bpReward, err := system.MintReward(scs, issuance, blockNo)
+	if err != nil {
+		return nil, err
+	}
+	if err := bState.StageContractState(scs); err != nil {
+		return nil, err
+	}
+	return bpReward, nil
+}
+
 // find an orphan block which is the child of the added block
 func (cs *ChainService) resolveOrphan(block *types.Block) (*types.Block, error) {
 	hash := block.BlockHash()
@@ -30,6 +30,7 @@ func initTest(t *testing.T, testmode bool) {
 	sdb.Init(string(db.LevelImpl), tmpdir, nil, testmode)
 	genesis := types.GetTestGenesis()
 	chainID = genesis.Block().GetHeader().ChainID
+	types.InitHardfork(genesis.HardforkConfig())
 
 	err := sdb.SetGenesis(genesis, nil)
 	if err != nil {
@@ -40,6 +41,7 @@ func initTest(t *testing.T, testmode bool) {
 }
 
 func deinitTest() {
+	types.InitHardfork(&types.Hardfork{})
 	sdb.Close()
 	os.RemoveAll("test")
 }
@@ -125,3 +127,21 @@ func TestBasicExecuteTx(t *testing.T) {
 	assert.NoError(t, err, "execute governance type")
 
 }
+
+func TestFeeDelegationBeforeV2(t *testing.T) {
+	initTest(t, true)
+	defer deinitTest()
+	bs := state.NewBlockState(sdb.GetStateDB())
+
+	tx := &types.Tx{Body: &types.TxBody{}}
+
+	tx.Body.ChainIdHash = chainID
+	tx.Body.Account = makeTestAddress(t)
+	tx.Body.Recipient = makeTestAddress(t)
+	tx.Body.Nonce = 1
+	tx.Body.Type = types.TxType_FEEDELEGATION
+	tx.Body.Payload = []byte(`{"Name":"inc"}`)
+	signTestAddress(t, tx)
+	err := executeTx(nil, nil, bs, types.NewTransaction(tx), 0, 0, nil, contract.ChainService, chainID)
+	assert.EqualError(t, err, types.ErrTxInvalidType.Error(), "execute fee delegation before V2")
+}
@@ -163,6 +163,75 @@ func (core *Core) Close() {
 	contract.CloseDatabase()
 }
 
+// getStateRootAt returns the state root of the block specified by blockHash
+// or blockNo. blockHash takes precedence over blockNo, which is used only if
+// hasBlockNo is set so that the genesis block can be selected. It returns nil
+// if neither is given, which means the current best state.
+func (core *Core) getStateRootAt(blockNo types.BlockNo, hasBlockNo bool, blockHash []byte) ([]byte, error) {
+	var (
+		block *types.Block
+		err   error
+	)
+	switch {
+	case len(blockHash) != 0:
+		block, err = core.cdb.GetBlock(blockHash)
+	case hasBlockNo:
+		block, err = core.cdb.GetBlockByNo(blockNo)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return block.GetHeader().GetBlocksRootHash(), nil
+}
+
+// getAddressNameResolvedAt returns the address of account, which is resolved
+// from the state of sdb as of the block specified by blockHash or blockNo if it
+// is a name. If neither is given, the best block is used.
+func (core *Core) getAddressNameResolvedAt(sdb *state.StateDB, account []byte, blockNo types.BlockNo, hasBlockNo bool, blockHash []byte) ([]byte, error) {
+	switch {
+	case len(blockHash) != 0:
+		block, err := core.cdb.GetBlock(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		blockNo = block.BlockNo()
+	case !hasBlockNo:
+		blockNo = core.cdb.getBestBlockNo()
+	}
+	return getAddressNameResolved(sdb, account, blockNo)
+}
+
+// getStateDBAt returns the state DB as of the block specified by blockHash or
+// blockNo. If neither is given, the state DB of the best block is returned.
+func (core *Core) getStateDBAt(blockNo types.BlockNo, hasBlockNo bool, blockHash []byte) (*state.StateDB, error) {
+	root, err := core.getStateRootAt(blockNo, hasBlockNo, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return core.sdb.GetStateDB(), nil
+	}
+	return core.sdb.OpenNewStateDB(root), nil
+}
+
+// getStateAndRootAt resolves the state root to be queried. An explicitly
+// given root takes precedence over the block selector. The returned state DB
+// is opened at the resolved root and is used for name resolution.
+func (core *Core) getStateAndRootAt(root []byte, blockNo types.BlockNo, hasBlockNo bool, blockHash []byte) ([]byte, *state.StateDB, error) {
+	if len(root) == 0 {
+		var err error
+		if root, err = core.getStateRootAt(blockNo, hasBlockNo, blockHash); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(root) == 0 {
+		return nil, core.sdb.GetStateDB(), nil
+	}
+	return root, core.sdb.OpenNewStateDB(root), nil
+}
+
 // InitGenesisBlock initialize chain database and generate specified genesis block if necessary
 func (core *Core) InitGenesisBlock(gb *types.Genesis, useTestnet bool) error {
 	_, err := core.initGenesis(gb, useTestnet, false)
@@ -178,10 +247,12 @@ type IChainHandler interface {
 	getBlockByNo(blockNo types.BlockNo) (*types.Block, error)
 	getTx(txHash []byte) (*types.Tx, *types.TxIdx, error)
 	getReceipt(txHash []byte) (*types.Receipt, error)
+	getReceiptProof(txHash []byte, blockHash []byte) (*types.ReceiptProof, error)
 	getAccountVote(id []string, addr []byte) (*types.AccountVoteInfo, error)
 	getVotes(id string, n uint32) (*types.VoteList, error)
 	getStaking(addr []byte) (*types.Staking, error)
-	getNameInfo(name string, blockNo types.BlockNo) (*types.NameInfo, error)
+	getNameInfo(name string, blockNo types.BlockNo, reverse bool) (*types.NameInfo, error)
+	simulateTx(tx *types.Tx) (*types.SimulateResult, error)
 	getEnterpriseConf(key string) (*types.EnterpriseConfig, error)
 	addBlock(newBlock *types.Block, usedBstate *state.BlockState, peerID types.PeerID) error
 	getAnchorsNew() (ChainAnchor, types.BlockNo, error)
@@ -189,6 +260,8 @@ type IChainHandler interface {
 	setSkipMempool(val bool)
 	listEvents(filter *types.FilterInfo) ([]*types.Event, error)
 	verifyBlock(block *types.Block) error
+	addSnapshotBlocks(blocks []*types.Block) error
+	importSnapshot(block *types.Block) error
 }
 
 // ChainService manage connectivity of blocks
@@ -211,6 +284,9 @@ type ChainService struct {
 
 	recovered  atomic.Value
 	debuggable bool
+
+	// prunedNo is the lowest block whose state was kept by the last pruning
+	prunedNo types.BlockNo
 }
 
 // NewChainService creates an instance of ChainService.
@@ -228,6 +304,20 @@ func NewChainService(cfg *cfg.Config) *ChainService {
 		logger.Fatal().Err(err).Msg("failed to initialize DB")
 		panic(err)
 	}
+	switch cfg.Blockchain.StateMode {
+	case "", stateModeArchive:
+	case stateModePrune:
+		cs.sdb.EnablePruning()
+	default:
+		logger.Fatal().Str("statemode", cfg.Blockchain.StateMode).Msg("invalid state mode")
+		panic("invalid config: blockchain")
+	}
+	if cfg.Blockchain.AccountTxIndex {
+		cs.cdb.EnableAccountTxIndex(func(root []byte, blockNo types.BlockNo, name []byte) []byte {
+			address, _ := getAddressNameResolved(cs.sdb.OpenNewStateDB(root), name, blockNo)
+			return address
+		})
+	}
 
 	if err = Init(cfg.Blockchain.MaxBlockSize,
 		cfg.Blockchain.CoinbaseAccount,
@@ -370,6 +460,10 @@ func (cs *ChainService) BeforeStop() {
 }
 
 func (cs *ChainService) notifyBlock(block *types.Block, isByBP bool) {
+	// no service to notify while importing an archive
+	if cs.Hub() == nil {
+		return
+	}
 	cs.BaseComponent.RequestTo(message.P2PSvc,
 		&message.NotifyNewBlock{
 			Produced: isByBP,
@@ -408,7 +502,10 @@ func (cs *ChainService) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
 	case *message.AddBlock,
 		*message.GetAnchors, //TODO move to ChainWorker (need chain lock)
-		*message.GetAncestor:
+		*message.GetAncestor,
+		*message.SimulateTx, // shares contract DBs with block execution
+		*message.AddSnapshotBlocks,
+		*message.ImportSnapshot:
 		cs.chainManager.Request(msg, context.Sender())
 
 		//pass to chainWorker
@@ -418,6 +515,8 @@ func (cs *ChainService) Receive(context actor.Context) {
 		*message.GetStateAndProof,
 		*message.GetTx,
 		*message.GetReceipt,
+		*message.GetReceiptProof,
+		*message.GetAccountTxs,
 		*message.GetABI,
 		*message.GetQuery,
 		*message.GetStateQuery,
@@ -426,7 +525,10 @@ func (cs *ChainService) Receive(context actor.Context) {
 		*message.GetStaking,
 		*message.GetNameInfo,
 		*message.GetEnterpriseConf,
-		*message.ListEvents:
+		*message.ListEvents,
+		*message.GetStateRange,
+		*message.GetStateData,
+		*message.GetContractDB:
 		cs.chainWorker.Request(msg, context.Sender())
 
 		//handle directly
@@ -498,6 +600,16 @@ func (cs *ChainService) getAccountVote(ids []string, addr []byte) (*types.Accoun
 
 	var voteInfo types.AccountVoteInfo
 
+	direct, delegated, proxy, err := system.GetVotingPower(scs, addr)
+	if err != nil {
+		return nil, err
+	}
+	voteInfo.DirectPower = direct.String()
+	voteInfo.DelegatedPower = delegated.String()
+	if proxy != nil {
+		voteInfo.Proxy = types.EncodeAddress(proxy)
+	}
+
 	for _, id := range ids {
 		vote, err := system.GetVote(scs, addr, []byte(id))
 		if err != nil {
@@ -537,25 +649,29 @@ func (cs *ChainService) getStaking(addr []byte) (*types.Staking, error) {
 	if err != nil {
 		return nil, err
 	}
-	staking, err := system.GetStaking(scs, name.GetAddress(namescs, addr))
+	staking, err := system.GetStaking(scs, name.GetAddress(namescs, addr, cs.cdb.getBestBlockNo()))
 	if err != nil {
 		return nil, err
 	}
 	return staking, nil
 }
 
-func (cs *ChainService) getNameInfo(qname string, blockNo types.BlockNo) (*types.NameInfo, error) {
-	var stateDB *state.StateDB
-	if blockNo != 0 {
-		block, err := cs.cdb.GetBlockByNo(blockNo)
+func (cs *ChainService) getNameInfo(qname string, blockNo types.BlockNo, reverse bool) (*types.NameInfo, error) {
+	stateDB, err := cs.getStateDBAt(blockNo, blockNo != 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	if blockNo == 0 {
+		blockNo = cs.cdb.getBestBlockNo()
+	}
+	if reverse {
+		address, err := types.DecodeAddress(qname)
 		if err != nil {
 			return nil, err
 		}
-		stateDB = cs.sdb.OpenNewStateDB(block.GetHeader().GetBlocksRootHash())
-	} else {
-		stateDB = cs.sdb.GetStateDB()
+		return name.GetPrimaryNameInfo(stateDB, address, blockNo)
 	}
-	return name.GetNameInfo(stateDB, qname)
+	return name.GetNameInfo(stateDB, qname, blockNo)
 }
 
 func (cs *ChainService) getEnterpriseConf(key string) (*types.EnterpriseConfig, error) {
@@ -571,6 +687,12 @@ func (cs *ChainService) getSystemValue(key types.SystemValue) (*big.Int, error)
 	switch key {
 	case types.StakingTotal:
 		return system.GetStakingTotal(stateDB)
+	case types.StakingMin:
+		scs, err := stateDB.GetSystemAccountState()
+		if err != nil {
+			return nil, err
+		}
+		return system.GetMinimumStaking(scs, cs.cdb.getBestBlockNo()+1), nil
 	}
 	return nil, fmt.Errorf("unsupported system value : %s", key)
 }
@@ -653,6 +775,25 @@ func (cm *ChainManager) Receive(context actor.Context) {
 			Ancestor: ancestor,
 			Err:      err,
 		})
+	case *message.SimulateTx:
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		result, err := cm.simulateTx(msg.Tx)
+		context.Respond(message.SimulateTxRsp{
+			Result: result,
+			Err:    err,
+		})
+	case *message.AddSnapshotBlocks:
+		err := cm.addSnapshotBlocks(msg.Blocks)
+		context.Respond(message.AddSnapshotBlocksRsp{
+			Err: err,
+		})
+	case *message.ImportSnapshot:
+		err := cm.importSnapshot(msg.Block)
+		context.Respond(message.ImportSnapshotRsp{
+			Err: err,
+		})
 	case *actor.Started, *actor.Stopping, *actor.Stopped, *component.CompStatReq: // donothing
 	default:
 		debug := fmt.Sprintf("[%s] Missed message. (%v) %s", cm.name, reflect.TypeOf(msg), msg)
@@ -660,14 +801,16 @@ func (cm *ChainManager) Receive(context actor.Context) {
 	}
 }
 
-func getAddressNameResolved(sdb *state.ChainStateDB, account []byte) ([]byte, error) {
-	if len(account) == types.NameLength {
-		scs, err := sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
+// getAddressNameResolved returns the address of account, which is resolved as
+// of the block of blockNo if it is a name.
+func getAddressNameResolved(sdb *state.StateDB, account []byte, blockNo types.BlockNo) ([]byte, error) {
+	if types.IsNameAddress(account) {
+		scs, err := sdb.OpenContractStateAccount(types.ToAccountID([]byte(types.AergoName)))
 		if err != nil {
 			logger.Error().Str("hash", enc.ToString(account)).Err(err).Msg("failed to get state for account")
 			return nil, err
 		}
-		return name.GetAddress(scs, account), nil
+		return name.GetAddress(scs, account, blockNo), nil
 	}
 	return account, nil
 }
@@ -694,7 +837,16 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Err:   err,
 		})
 	case *message.GetState:
-		address, err := getAddressNameResolved(cw.sdb, msg.Account)
+		stateDB, err := cw.getStateDBAt(msg.BlockNo, msg.HasBlockNo, msg.BlockHash)
+		if err != nil {
+			context.Respond(message.GetStateRsp{
+				Account: msg.Account,
+				State:   nil,
+				Err:     err,
+			})
+			return
+		}
+		address, err := cw.getAddressNameResolvedAt(stateDB, msg.Account, msg.BlockNo, msg.HasBlockNo, msg.BlockHash)
 		if err != nil {
 			context.Respond(message.GetStateRsp{
 				Account: msg.Account,
@@ -704,7 +856,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			return
 		}
 		id := types.ToAccountID(address)
-		accState, err := cw.sdb.GetStateDB().GetAccountState(id)
+		accState, err := stateDB.GetAccountState(id)
 		if err != nil {
 			logger.Error().Str("hash", enc.ToString(address)).Err(err).Msg("failed to get state for account")
 		}
@@ -714,7 +866,15 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Err:     err,
 		})
 	case *message.GetStateAndProof:
-		address, err := getAddressNameResolved(cw.sdb, msg.Account)
+		root, stateDB, err := cw.getStateAndRootAt(msg.Root, msg.BlockNo, msg.HasBlockNo, msg.BlockHash)
+		if err != nil {
+			context.Respond(message.GetStateAndProofRsp{
+				StateProof: nil,
+				Err:        err,
+			})
+			break
+		}
+		address, err := cw.getAddressNameResolvedAt(stateDB, msg.Account, msg.BlockNo, msg.HasBlockNo, msg.BlockHash)
 		if err != nil {
 			context.Respond(message.GetStateAndProofRsp{
 				StateProof: nil,
@@ -723,7 +883,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			break
 		}
 		id := types.ToAccountID(address)
-		stateProof, err := cw.sdb.GetStateDB().GetAccountAndProof(id[:], msg.Root, msg.Compressed)
+		stateProof, err := cw.sdb.GetStateDB().GetAccountAndProof(id[:], root, msg.Compressed)
 		if err != nil {
 			logger.Error().Str("hash", enc.ToString(address)).Err(err).Msg("failed to get state for account")
 		}
@@ -745,8 +905,45 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Receipt: receipt,
 			Err:     err,
 		})
+	case *message.GetReceiptProof:
+		proof, err := cw.getReceiptProof(msg.TxHash, msg.BlockHash)
+		context.Respond(message.GetReceiptProofRsp{
+			Proof: proof,
+			Err:   err,
+		})
+	case *message.GetStateRange:
+		keys, values, data, more, err := cw.sdb.GetStateRange(msg.Root, msg.Start, int(msg.Limit))
+		context.Respond(message.GetStateRangeRsp{
+			Keys:    keys,
+			Values:  values,
+			Data:    data,
+			HasNext: more,
+			Err:     err,
+		})
+	case *message.GetStateData:
+		context.Respond(message.GetStateDataRsp{
+			Data: cw.sdb.GetStateData(msg.Hashes),
+		})
+	case *message.GetContractDB:
+		data, total, err := getContractDB(msg)
+		context.Respond(message.GetContractDBRsp{
+			Data:  data,
+			Total: total,
+			Err:   err,
+		})
+	case *message.GetAccountTxs:
+		account, err := getAddressNameResolved(cw.sdb.GetStateDB(), msg.Account, cw.cdb.getBestBlockNo())
+		if err != nil {
+			context.Respond(message.GetAccountTxsRsp{Err: err})
+			break
+		}
+		txs, err := cw.cdb.getAccountTxs(account, msg.Offset, msg.Size, msg.Asc)
+		context.Respond(message.GetAccountTxsRsp{
+			Txs: txs,
+			Err: err,
+		})
 	case *message.GetABI:
-		address, err := getAddressNameResolved(cw.sdb, msg.Contract)
+		address, err := getAddressNameResolved(cw.sdb.GetStateDB(), msg.Contract, cw.cdb.getBestBlockNo())
 		if err != nil {
 			context.Respond(message.GetABIRsp{
 				ABI: nil,
@@ -770,17 +967,26 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 	case *message.GetQuery:
 		runtime.LockOSThread()
 		defer runtime.UnlockOSThread()
-		address, err := getAddressNameResolved(cw.sdb, msg.Contract)
+		root, err := cw.getStateRootAt(msg.BlockNo, msg.HasBlockNo, msg.BlockHash)
 		if err != nil {
 			context.Respond(message.GetQueryRsp{Result: nil, Err: err})
 			break
 		}
-		ctrState, err := cw.sdb.GetStateDB().OpenContractStateAccount(types.ToAccountID(address))
+		if root == nil {
+			root = cw.sdb.GetRoot()
+		}
+		stateDB := cw.sdb.OpenNewStateDB(root)
+		address, err := cw.getAddressNameResolvedAt(stateDB, msg.Contract, msg.BlockNo, msg.HasBlockNo, msg.BlockHash)
+		if err != nil {
+			context.Respond(message.GetQueryRsp{Result: nil, Err: err})
+			break
+		}
+		ctrState, err := stateDB.OpenContractStateAccount(types.ToAccountID(address))
 		if err != nil {
 			logger.Error().Str("hash", enc.ToString(address)).Err(err).Msg("failed to get state for contract")
 			context.Respond(message.GetQueryRsp{Result: nil, Err: err})
 		} else {
-			bs := state.NewBlockState(cw.sdb.OpenNewStateDB(cw.sdb.GetRoot()))
+			bs := state.NewBlockState(cw.sdb.OpenNewStateDB(root))
 			ret, err := contract.Query(address, bs, cw.cdb, ctrState, msg.Queryinfo)
 			context.Respond(message.GetQueryRsp{Result: ret, Err: err})
 		}
@@ -789,7 +995,15 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 		var contractProof *types.AccountProof
 		var err error
 
-		address, err := getAddressNameResolved(cw.sdb, msg.ContractAddress)
+		root, stateDB, err := cw.getStateAndRootAt(msg.Root, msg.BlockNo, msg.HasBlockNo, msg.BlockHash)
+		if err != nil {
+			context.Respond(message.GetStateQueryRsp{
+				Result: nil,
+				Err:    err,
+			})
+			break
+		}
+		address, err := cw.getAddressNameResolvedAt(stateDB, msg.ContractAddress, msg.BlockNo, msg.HasBlockNo, msg.BlockHash)
 		if err != nil {
 			context.Respond(message.GetStateQueryRsp{
 				Result: nil,
@@ -798,7 +1012,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			break
 		}
 		id := types.ToAccountID(address)
-		contractProof, err = cw.sdb.GetStateDB().GetAccountAndProof(id[:], msg.Root, msg.Compressed)
+		contractProof, err = cw.sdb.GetStateDB().GetAccountAndProof(id[:], root, msg.Compressed)
 		if err != nil {
 			logger.Error().Str("hash", enc.ToString(address)).Err(err).Msg("failed to get state for account")
 		} else if contractProof.Inclusion {
@@ -840,7 +1054,7 @@ func (cw *ChainWorker) Receive(context actor.Context) {
 			Err:     err,
 		})
 	case *message.GetNameInfo:
-		owner, err := cw.getNameInfo(msg.Name, msg.BlockNo)
+		owner, err := cw.getNameInfo(msg.Name, msg.BlockNo, msg.Reverse)
 		context.Respond(&message.GetNameInfoRsp{
 			Owner: owner,
 			Err:   err,
@@ -405,3 +405,73 @@ func checkBlockEqual(t *testing.T, x *types.Block, y *types.Block) bool {
 
 	return true
 }
+
+func TestGetStateRootAt(t *testing.T) {
+	cs, mainChain := testAddBlock(t, 5)
+
+	root, err := cs.getStateRootAt(0, false, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, root, "no selector means the best state")
+
+	root, err = cs.getStateRootAt(0, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, mainChain.GetBlockByNo(0).GetHeader().GetBlocksRootHash(), root, "the genesis block can be selected")
+
+	block := mainChain.GetBlockByNo(3)
+	root, err = cs.getStateRootAt(3, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, block.GetHeader().GetBlocksRootHash(), root)
+
+	root, err = cs.getStateRootAt(0, false, block.BlockHash())
+	assert.NoError(t, err)
+	assert.Equal(t, block.GetHeader().GetBlocksRootHash(), root)
+
+	_, err = cs.getStateRootAt(uint64(mainChain.Best+1), true, nil)
+	assert.Error(t, err)
+}
+
+func TestListEventsByIndex(t *testing.T) {
+	cs, mainChain := testAddBlock(t, 5)
+
+	contract := types.AddressPadding([]byte("event_test"))
+	r := types.NewReceipt(contract, "SUCCESS", "")
+	r.TxHash = make([]byte, 32)
+	r.Events = []*types.Event{
+		{ContractAddress: contract, EventName: "transfer", JsonArgs: `["alice", 1]`},
+		{ContractAddress: contract, EventName: "transfer", JsonArgs: `["bob", 2]`},
+		{ContractAddress: contract, EventName: "mint", JsonArgs: `["alice"]`},
+	}
+	receipts := &types.Receipts{}
+	receipts.Set([]*types.Receipt{r})
+
+	block := mainChain.GetBlockByNo(3)
+	cs.cdb.writeReceipts(block.BlockHash(), block.BlockNo(), receipts)
+
+	events, err := cs.listEvents(&types.FilterInfo{ContractAddress: []byte("event_test")})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+
+	events, err = cs.listEvents(&types.FilterInfo{ContractAddress: []byte("event_test"), EventName: "transfer"})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	events, err = cs.listEvents(&types.FilterInfo{ContractAddress: []byte("event_test"), EventName: "transfer",
+		ArgFilter: []byte(`{"0": "bob"}`)})
+	assert.NoError(t, err)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, `["bob", 2]`, events[0].JsonArgs)
+		assert.Equal(t, block.BlockNo(), events[0].BlockNo)
+	}
+
+	events, err = cs.listEvents(&types.FilterInfo{ContractAddress: []byte("event_test"), Blockfrom: 4})
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	dbTx := cs.cdb.NewTx()
+	cs.cdb.deleteReceipts(&dbTx, block.BlockHash(), block.BlockNo())
+	dbTx.Commit()
+
+	events, err = cs.listEvents(&types.FilterInfo{ContractAddress: []byte("event_test")})
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+}
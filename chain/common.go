@@ -76,7 +76,13 @@ func initChainParams(genesis *types.Genesis) {
 	if err := setConsensusName(genesis.ConsensusType()); err != nil {
 		logger.Panic().Err(err).Msg("invalid consensus type in genesis block")
 	}
+	types.InitHardfork(genesis.HardforkConfig())
+	logger.Info().Str("hardfork", genesis.HardforkConfig().String()).Msg("set hardfork heights from genesis")
 	system.InitDefaultBpCount(len(genesis.BPs))
+	if id, err := genesis.ChainID(); err == nil {
+		system.InitChainID(id)
+	}
+	system.InitIssuance(genesis.Issuance)
 	if genesis.TotalBalance() != nil {
 		types.MaxAER = genesis.TotalBalance()
 		logger.Info().Str("TotalBalance", types.MaxAER.String()).Msg("set total from genesis")
@@ -14,6 +14,7 @@ import (
 	"github.com/aergoio/aergo/contract/enterprise"
 	"github.com/aergoio/aergo/contract/name"
 	"github.com/aergoio/aergo/contract/system"
+	"github.com/aergoio/aergo/fee"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
@@ -53,6 +54,23 @@ func executeGovernanceTx(ccc consensus.ChainConsensusCluster, bs *state.BlockSta
 	return events, err
 }
 
+// validateGasPrice checks that the gas price of a transaction paying the fee
+// is not lower than the minimum gas price in effect at blockNo. The governance
+// transactions are free.
+func validateGasPrice(bs *state.BlockState, txBody *types.TxBody, blockNo types.BlockNo) error {
+	if txBody.GetType() == types.TxType_GOVERNANCE {
+		return nil
+	}
+	scs, err := bs.GetSystemAccountState()
+	if err != nil {
+		return err
+	}
+	if !fee.IsEnoughGasPrice(txBody.GetGasPriceBigInt(), system.GetGasPrice(scs, blockNo)) {
+		return types.ErrTxInvalidPrice
+	}
+	return nil
+}
+
 // InitGenesisBPs opens system contract and put initial voting result
 // it also set *State in Genesis to use statedb
 func InitGenesisBPs(states *state.StateDB, genesis *types.Genesis) error {
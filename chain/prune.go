@@ -0,0 +1,74 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/types"
+)
+
+const (
+	stateModeArchive = "archive"
+	stateModePrune   = "prune"
+
+	// statePruneInterval is the number of blocks between the prunings of the
+	// states in prune mode.
+	statePruneInterval = 1024
+)
+
+// libAccessor is implemented by the consensus whose blocks may be
+// reorganized until they become irreversible.
+type libAccessor interface {
+	LibNo() types.BlockNo
+}
+
+// pruneState deletes the states of the blocks older than both the recent
+// StateKeepBlocks blocks and the last irreversible block (LIB), which a
+// reorganization may roll back to. It runs in background at most once per
+// statePruneInterval blocks. The recovery points of the sql databases of
+// contracts older than the states kept are discarded as well, which is done
+// under the chain lock, since the databases are shared with the block
+// execution.
+func (cs *ChainService) pruneState() {
+	if cs.cfg.Blockchain.StateMode != stateModePrune {
+		return
+	}
+
+	var (
+		bestNo   = cs.cdb.getBestBlockNo()
+		keepFrom types.BlockNo
+	)
+	if keep := cs.cfg.Blockchain.StateKeepBlocks; bestNo >= keep {
+		keepFrom = bestNo - keep
+	}
+	if la, ok := cs.ChainConsensus.(libAccessor); ok {
+		if libNo := la.LibNo(); libNo < keepFrom {
+			keepFrom = libNo
+		}
+	}
+	if keepFrom < cs.prunedNo+statePruneInterval {
+		return
+	}
+
+	roots := make([][]byte, 0, bestNo-keepFrom+1)
+	for no := keepFrom; no <= bestNo; no++ {
+		block, err := cs.cdb.GetBlockByNo(no)
+		if err != nil {
+			logger.Warn().Err(err).Uint64("no", no).Msg("failed to get the block whose state is kept")
+			return
+		}
+		roots = append(roots, block.GetHeader().GetBlocksRootHash())
+	}
+	if !cs.sdb.Prune(roots) {
+		return
+	}
+	logger.Info().Uint64("from", keepFrom).Uint64("best", bestNo).Msg("start to prune old states")
+	cs.prunedNo = keepFrom
+
+	if err := contract.PruneRecoveryPoints(cs.sdb.OpenNewStateDB(roots[0])); err != nil {
+		logger.Warn().Err(err).Uint64("from", keepFrom).Msg("failed to prune the recovery points of sql databases")
+	}
+}
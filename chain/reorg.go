@@ -298,6 +298,13 @@ func (reorg *reorganizer) swapTxMapping() error {
 
 	var overwrap int
 
+	// delete account tx entries of old blocks before they are overwritten
+	idxTx := cdb.store.NewTx()
+	for _, oldBlock := range reorg.oldBlocks {
+		cdb.deleteAccountTxs(&idxTx, oldBlock.BlockHash())
+	}
+	idxTx.Commit()
+
 	// insert new tx mapping
 	for i := len(reorg.newBlocks) - 1; i >= 0; i-- {
 		newBlock := reorg.newBlocks[i]
@@ -315,6 +322,7 @@ func (reorg *reorganizer) swapTxMapping() error {
 			dbTx.Discard()
 			return err
 		}
+		cdb.addAccountTxs(&dbTx, newBlock)
 
 		dbTx.Commit()
 	}
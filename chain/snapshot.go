@@ -0,0 +1,128 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/types"
+)
+
+var (
+	ErrSnapshotNotEmpty  = errors.New("snapshot can be imported only to a chain of the genesis block")
+	ErrSnapshotNoState   = errors.New("state of snapshot block is not imported")
+	ErrSnapshotNotLinked = errors.New("block of snapshot is not linked to the previous block")
+	ErrSnapshotNoBPs     = errors.New("consensus cannot tell the block producers of snapshot blocks")
+	ErrSnapshotBadBP     = errors.New("block of snapshot is not produced by a block producer of its period")
+)
+
+// addSnapshotBlocks stores the blocks before the pivot of snapshot sync
+// without executing them. blocks must follow the last stored block, and each
+// of them must be signed by a BP of its period.
+func (cs *ChainService) addSnapshotBlocks(blocks []*types.Block) error {
+	if cs.cdb.getBestBlockNo() != 0 {
+		return ErrSnapshotNotEmpty
+	}
+	for _, block := range blocks {
+		if err := cs.verifySnapshotBlock(block); err != nil {
+			return err
+		}
+		if !bytes.Equal(block.GetHeader().GetTxsRootHash(), types.CalculateTxsRootHash(block.GetBody().GetTxs())) {
+			return ErrorBlockVerifyTxRoot
+		}
+		if err := cs.cdb.addSnapshotBlock(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifySnapshotBlock checks that block is linked to the stored block before
+// it, and that it is signed by a BP of its period.
+func (cs *ChainService) verifySnapshotBlock(block *types.Block) error {
+	if block.BlockNo() == 0 {
+		return ErrSnapshotNotLinked
+	}
+	prevHash, err := cs.cdb.getHashByNo(block.BlockNo() - 1)
+	if err != nil || !bytes.Equal(prevHash, block.GetHeader().GetPrevBlockHash()) {
+		return ErrSnapshotNotLinked
+	}
+
+	if err := cs.VerifySign(block); err != nil {
+		return err
+	}
+	lister, ok := cs.ChainConsensus.(consensus.BPLister)
+	if !ok {
+		return ErrSnapshotNoBPs
+	}
+	bps, err := lister.BPs(block.BlockNo())
+	if err != nil {
+		return err
+	}
+	id, err := block.BPID()
+	if err != nil {
+		return err
+	}
+	for _, bp := range bps {
+		if bp == id {
+			return nil
+		}
+	}
+	return ErrSnapshotBadBP
+}
+
+// importSnapshot makes block the best block of the chain. Its state must have
+// been imported and marked by snapshot sync, which replaces the execution of
+// the blocks before it, and the blocks before it must have been stored by
+// addSnapshotBlocks.
+func (cs *ChainService) importSnapshot(block *types.Block) error {
+	if cs.cdb.getBestBlockNo() != 0 {
+		return ErrSnapshotNotEmpty
+	}
+	if err := cs.verifySnapshotBlock(block); err != nil {
+		return err
+	}
+	root := block.GetHeader().GetBlocksRootHash()
+	if !cs.sdb.GetStateDB().HasMarker(root) {
+		return ErrSnapshotNoState
+	}
+
+	if err := cs.sdb.SetRoot(root); err != nil {
+		return err
+	}
+	if err := cs.cdb.connectSnapshot(block); err != nil {
+		return err
+	}
+	cs.Update(block)
+
+	logger.Info().Uint64("no", block.BlockNo()).Str("hash", block.ID()).
+		Str("root", enc.ToString(root)).Msg("imported snapshot block")
+
+	return nil
+}
+
+// getContractDB reads a part of the copy of a contract database for
+// snapshot sync. The copy is made on the request of its first part, while
+// the chain lock is held for no block to modify the database.
+func getContractDB(msg *message.GetContractDB) ([]byte, uint64, error) {
+	dbName := types.AccountID(types.ToHashID(msg.AccountID)).String()
+	if msg.Offset == 0 {
+		select {
+		case InAddBlock <- struct{}{}:
+		}
+		err := contract.CopyDatabaseFile(dbName, msg.RecoveryPoint)
+		<-InAddBlock
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return contract.ReadDatabaseFile(dbName, msg.RecoveryPoint, msg.Offset, msg.Size)
+}
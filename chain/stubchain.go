@@ -77,6 +77,19 @@ func (tchain *StubBlockChain) GetHashes(prevInfo *types.BlockInfo, count uint64)
 	return blkHashes, nil
 }
 
+func (tchain *StubBlockChain) GetHeaders(startNo types.BlockNo, count uint64) ([]*types.BlockHeader, error) {
+	if tchain.Best < int(startNo+count-1) {
+		return nil, ErrNotExistHash
+	}
+
+	headers := make([]*types.BlockHeader, 0, count)
+	for _, block := range tchain.Blocks[startNo : startNo+count] {
+		headers = append(headers, block.GetHeader())
+	}
+
+	return headers, nil
+}
+
 func (tchain *StubBlockChain) GetBlockInfo(no uint64) *types.BlockInfo {
 	return &types.BlockInfo{tchain.Hashes[no], no}
 }
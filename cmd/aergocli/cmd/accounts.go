@@ -9,6 +9,7 @@ import (
 	"syscall"
 
 	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh/terminal"
@@ -24,27 +25,33 @@ func init() {
 
 	newCmd.Flags().StringVar(&pw, "password", "", "Password")
 	newCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	newCmd.Flags().StringVar(&keystore, "keystore", "", "URL of an external signer holding the keys (http://host:port or unix:///path/to/socket)")
 
 	listCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	listCmd.Flags().StringVar(&keystore, "keystore", "", "URL of an external signer holding the keys (http://host:port or unix:///path/to/socket)")
 
 	unlockCmd.Flags().StringVar(&address, "address", "", "Address of account")
 	unlockCmd.MarkFlagRequired("address")
 	unlockCmd.Flags().StringVar(&pw, "password", "", "Password")
+	unlockCmd.Flags().StringVar(&keystore, "keystore", "", "URL of an external signer holding the keys (http://host:port or unix:///path/to/socket)")
 
 	lockCmd.Flags().StringVar(&address, "address", "", "Address of account")
 	lockCmd.MarkFlagRequired("address")
 	lockCmd.Flags().StringVar(&pw, "password", "", "Password")
+	lockCmd.Flags().StringVar(&keystore, "keystore", "", "URL of an external signer holding the keys (http://host:port or unix:///path/to/socket)")
 
 	importCmd.Flags().StringVar(&importFormat, "if", "", "Base58 import format string")
 	importCmd.MarkFlagRequired("if")
 	importCmd.Flags().StringVar(&pw, "password", "", "Password when exporting")
 	importCmd.Flags().StringVar(&to, "newpassword", "", "Password to be reset")
 	importCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	importCmd.Flags().StringVar(&keystore, "keystore", "", "URL of an external signer holding the keys (http://host:port or unix:///path/to/socket)")
 
 	exportCmd.Flags().StringVar(&address, "address", "", "Address of account")
 	exportCmd.MarkFlagRequired("address")
 	exportCmd.Flags().StringVar(&pw, "password", "", "Password")
 	exportCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data", "Path to data directory")
+	exportCmd.Flags().StringVar(&keystore, "keystore", "", "URL of an external signer holding the keys (http://host:port or unix:///path/to/socket)")
 
 	voteCmd.Flags().StringVar(&address, "address", "", "Account address of voter")
 	voteCmd.MarkFlagRequired("address")
@@ -60,8 +67,19 @@ func init() {
 	unstakeCmd.MarkFlagRequired("address")
 	unstakeCmd.Flags().StringVar(&amount, "amount", "0", "Amount of staking")
 	unstakeCmd.MarkFlagRequired("amount")
+	claimRewardCmd.Flags().StringVar(&address, "address", "", "Account address")
+	claimRewardCmd.MarkFlagRequired("address")
+	delegateCmd.Flags().StringVar(&address, "address", "", "Account address of delegator")
+	delegateCmd.MarkFlagRequired("address")
+	delegateCmd.Flags().StringVar(&to, "to", "", "Account address of proxy (revoke the delegation if omitted)")
 
-	accountCmd.AddCommand(newCmd, listCmd, unlockCmd, lockCmd, importCmd, exportCmd, voteCmd, stakeCmd, unstakeCmd)
+	historyCmd.Flags().StringVar(&address, "address", "", "Account address or name")
+	historyCmd.MarkFlagRequired("address")
+	historyCmd.Flags().Uint32Var(&historySize, "size", 20, "Maximum number of transactions to list")
+	historyCmd.Flags().Uint32Var(&historyOffset, "offset", 0, "Number of transactions to skip")
+	historyCmd.Flags().BoolVar(&historyAsc, "asc", false, "List oldest transactions first")
+
+	accountCmd.AddCommand(newCmd, listCmd, unlockCmd, lockCmd, importCmd, exportCmd, voteCmd, stakeCmd, unstakeCmd, claimRewardCmd, delegateCmd, historyCmd)
 	rootCmd.AddCommand(accountCmd)
 }
 
@@ -82,11 +100,15 @@ var newCmd = &cobra.Command{
 		}
 		var msg *types.Account
 		var addr []byte
-		if cmd.Flags().Changed("path") == false {
+		if !useKeystore(cmd) {
 			msg, err = client.CreateAccount(context.Background(), &param)
 		} else {
-			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			var ks key.Signer
+			ks, err = openKeystore(cmd)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
 			defer ks.CloseStore()
 			addr, err = ks.CreateKey(param.Passphrase)
 			if err != nil {
@@ -114,13 +136,14 @@ var listCmd = &cobra.Command{
 		var err error
 		var msg *types.AccountList
 		var addrs [][]byte
-		if cmd.Flags().Changed("path") == false {
+		if !useKeystore(cmd) {
 			msg, err = client.GetAccounts(context.Background(), &types.Empty{})
 		} else {
-			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
-			defer ks.CloseStore()
-			addrs, err = ks.GetAddresses()
+			var ks key.Signer
+			if ks, err = openKeystore(cmd); err == nil {
+				defer ks.CloseStore()
+				addrs, err = ks.GetAddresses()
+			}
 		}
 		if err != nil {
 			cmd.Printf("Failed: %s\n", err.Error())
@@ -148,13 +171,28 @@ var listCmd = &cobra.Command{
 
 var lockCmd = &cobra.Command{
 	Use:   "lock [flags]",
-	Short: "Lock account in the node",
+	Short: "Lock account in the node or the external signer",
 	Run: func(cmd *cobra.Command, args []string) {
 		param, err := parsePersonalParam(cmd)
 		if err != nil {
 			cmd.Printf("Failed: %s\n", err.Error())
 			return
 		}
+		if useKeystore(cmd) {
+			ks, err := openKeystore(cmd)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			defer ks.CloseStore()
+			addr, err := ks.Lock(param.Account.Address, param.Passphrase)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			cmd.Println(types.EncodeAddress(addr))
+			return
+		}
 		msg, err := client.LockAccount(context.Background(), param)
 		if err != nil {
 			cmd.Printf("Failed: %s\n", err.Error())
@@ -166,13 +204,28 @@ var lockCmd = &cobra.Command{
 
 var unlockCmd = &cobra.Command{
 	Use:   "unlock [flags]",
-	Short: "Unlock account in the node",
+	Short: "Unlock account in the node or the external signer",
 	Run: func(cmd *cobra.Command, args []string) {
 		param, err := parsePersonalParam(cmd)
 		if err != nil {
 			cmd.Printf("Failed: %s\n", err.Error())
 			return
 		}
+		if useKeystore(cmd) {
+			ks, err := openKeystore(cmd)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			defer ks.CloseStore()
+			addr, err := ks.Unlock(param.Account.Address, param.Passphrase)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
+			cmd.Println(types.EncodeAddress(addr))
+			return
+		}
 		msg, err := client.UnlockAccount(context.Background(), param)
 		if err != nil {
 			cmd.Printf("Failed: %s\n", err.Error())
@@ -210,7 +263,7 @@ var importCmd = &cobra.Command{
 			wif.Newpass = wif.Oldpass
 		}
 
-		if cmd.Flags().Changed("path") == false {
+		if !useKeystore(cmd) {
 			msg, errRemote := client.ImportAccount(context.Background(), wif)
 			if errRemote != nil {
 				cmd.Printf("Failed: %s\n", errRemote.Error())
@@ -218,8 +271,11 @@ var importCmd = &cobra.Command{
 			}
 			address = msg.GetAddress()
 		} else {
-			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			ks, err := openKeystore(cmd)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
 			defer ks.CloseStore()
 			address, err = ks.ImportKey(importBuf, wif.Oldpass, wif.Newpass)
 			if err != nil {
@@ -241,7 +297,7 @@ var exportCmd = &cobra.Command{
 			return
 		}
 		var result []byte
-		if cmd.Flags().Changed("path") == false {
+		if !useKeystore(cmd) {
 			msg, err := client.ExportAccount(context.Background(), param)
 			if err != nil {
 				cmd.Printf("Failed: %s\n", err.Error())
@@ -249,8 +305,11 @@ var exportCmd = &cobra.Command{
 			}
 			result = msg.Value
 		} else {
-			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			ks, err := openKeystore(cmd)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
 			defer ks.CloseStore()
 			wif, err := ks.ExportKey(param.Account.Address, param.Passphrase)
 			if err != nil {
@@ -263,6 +322,35 @@ var exportCmd = &cobra.Command{
 	},
 }
 
+var (
+	historySize   uint32
+	historyOffset uint32
+	historyAsc    bool
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history [flags]",
+	Short: "List transactions sent from or to the account",
+	Run: func(cmd *cobra.Command, args []string) {
+		account, err := types.DecodeAddress(address)
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		msg, err := client.ListAccountTxs(context.Background(), &types.AccountTxsParams{
+			Account: account,
+			Size:    historySize,
+			Offset:  historyOffset,
+			Asc:     historyAsc,
+		})
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		cmd.Println(util.AccountTxListConvBase58Addr(msg))
+	},
+}
+
 func parsePersonalParam(cmd *cobra.Command) (*types.Personal, error) {
 	var err error
 	param := &types.Personal{Account: &types.Account{}}
@@ -304,8 +392,22 @@ func getPasswd(cmd *cobra.Command, isNew bool) (string, error) {
 	return string(password), err
 }
 
+// useKeystore reports whether cmd works on the keys of the cli, which are in
+// the data directory or in the external signer of --keystore, instead of the
+// keys of the node.
+func useKeystore(cmd *cobra.Command) bool {
+	return cmd.Flags().Changed("path") || cmd.Flags().Changed("keystore")
+}
+
+func openKeystore(cmd *cobra.Command) (key.Signer, error) {
+	if cmd.Flags().Changed("keystore") {
+		return key.NewRemoteSigner(keystore)
+	}
+	return key.NewStore(os.ExpandEnv(dataDir), 0), nil
+}
+
 func preConnectAergo(cmd *cobra.Command, args []string) {
-	if cmd.Flags().Changed("path") == false {
+	if !useKeystore(cmd) {
 		connectAergo(cmd, args)
 	} else {
 		client = nil
@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"encoding/json"
 	"os"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/mr-tron/base58/base58"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,3 +45,57 @@ func TestAccountWithPath(t *testing.T) {
 	os.RemoveAll(testDir)
 	os.RemoveAll(testDir2)
 }
+
+func TestAccountHistoryWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	const testAddress = "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	const testTxHashString = "BdAoKcLSsrscjdpTPGe9DoFsz4mP9ezbc4Dk5fuBTT4e"
+	account, _ := types.DecodeAddress(testAddress)
+	testTxHash, _ := base58.Decode(testTxHashString)
+
+	mock.EXPECT().ListAccountTxs(
+		gomock.Any(),
+		&types.AccountTxsParams{Account: account, Size: 5, Offset: 10},
+	).Return(
+		&types.AccountTxList{
+			Account: account,
+			Txs:     []*types.AccountTx{{TxHash: testTxHash, BlockNo: 3}},
+		},
+		nil,
+	).MaxTimes(1)
+
+	output, err := executeCommand(rootCmd, "account", "history", "--address", testAddress, "--size", "5", "--offset", "10")
+	assert.NoError(t, err, "should be success")
+	out := &util.InOutAccountTxList{}
+	err = json.Unmarshal([]byte(output), out)
+	assert.NoError(t, err, "should be json output")
+	assert.Equal(t, testAddress, out.Account)
+	if assert.Len(t, out.Txs, 1) {
+		assert.Equal(t, testTxHashString, out.Txs[0].TxHash)
+		assert.Equal(t, uint64(3), out.Txs[0].BlockNo)
+	}
+}
+
+func TestAccountHistoryByNameWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	const testName = "ab1234567890"
+
+	mock.EXPECT().ListAccountTxs(
+		gomock.Any(),
+		&types.AccountTxsParams{Account: []byte(testName), Size: 20},
+	).Return(
+		&types.AccountTxList{Account: []byte(testName)},
+		nil,
+	).MaxTimes(1)
+
+	output, err := executeCommand(rootCmd, "account", "history", "--address", testName, "--size", "20", "--offset", "0")
+	assert.NoError(t, err, "should be success")
+	out := &util.InOutAccountTxList{}
+	err = json.Unmarshal([]byte(output), out)
+	assert.NoError(t, err, "should be json output")
+	assert.Equal(t, testName, out.Account)
+}
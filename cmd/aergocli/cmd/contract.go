@@ -20,12 +20,13 @@ import (
 )
 
 var (
-	client     *util.ConnClient
-	data       string
-	nonce      uint64
-	toJson     bool
-	gover      bool
-	contractID string
+	client        *util.ConnClient
+	data          string
+	nonce         uint64
+	toJson        bool
+	gover         bool
+	feeDelegation bool
+	contractID    string
 )
 
 func init() {
@@ -45,6 +46,17 @@ func init() {
 	deployCmd.PersistentFlags().StringVar(&amount, "amount", "0", "setting amount")
 	deployCmd.PersistentFlags().StringVarP(&contractID, "redeploy", "r", "", "re-redeploy the contract")
 
+	redeployCmd := &cobra.Command{
+		Use:                   "redeploy [flags] --payload 'payload string' creator contract\n  aergocli contract redeploy [flags] creator contract bcfile abifile",
+		Short:                 "Upgrade the code of a deployed contract, keeping its state",
+		Long:                  "Upgrade the code of a deployed contract, keeping its state.\nThe migrate function of the new code, if any, is called with the arguments instead of the constructor.",
+		Args:                  cobra.MinimumNArgs(2),
+		Run:                   runRedeployCmd,
+		DisableFlagsInUseLine: true,
+	}
+	redeployCmd.PersistentFlags().StringVar(&data, "payload", "", "result of compiling a contract")
+	redeployCmd.PersistentFlags().StringVar(&amount, "amount", "0", "setting amount")
+
 	callCmd := &cobra.Command{
 		Use:   "call [flags] sender contract funcname '[argument...]'",
 		Short: "Call a contract function",
@@ -56,6 +68,7 @@ func init() {
 	callCmd.PersistentFlags().StringVar(&chainIdHash, "chainidhash", "", "chain id hash value encoded by base58")
 	callCmd.PersistentFlags().BoolVar(&toJson, "tojson", false, "get jsontx")
 	callCmd.PersistentFlags().BoolVar(&gover, "governance", false, "setting type")
+	callCmd.PersistentFlags().BoolVar(&feeDelegation, "feedelegation", false, "let the contract pay the fee of the call")
 
 	stateQueryCmd := &cobra.Command{
 		Use:   "statequery [flags] contract varname varindex",
@@ -65,9 +78,21 @@ func init() {
 	}
 	stateQueryCmd.Flags().StringVar(&stateroot, "root", "", "Query the state at a specified state root")
 	stateQueryCmd.Flags().BoolVar(&compressed, "compressed", false, "Get a compressed proof for the state")
+	stateQueryCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Query the state as of a specified block height")
+	stateQueryCmd.Flags().StringVar(&statehash, "blockhash", "", "Query the state as of a specified block hash")
+
+	queryCmd := &cobra.Command{
+		Use:   "query [flags] contract funcname '[argument...]'",
+		Short: "Query contract by executing read-only function",
+		Args:  cobra.MinimumNArgs(2),
+		Run:   runQueryCmd,
+	}
+	queryCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Query the contract as of a specified block height")
+	queryCmd.Flags().StringVar(&statehash, "blockhash", "", "Query the contract as of a specified block hash")
 
 	contractCmd.AddCommand(
 		deployCmd,
+		redeployCmd,
 		callCmd,
 		&cobra.Command{
 			Use:   "abi [flags] contract",
@@ -75,12 +100,7 @@ func init() {
 			Args:  cobra.MinimumNArgs(1),
 			Run:   runGetABICmd,
 		},
-		&cobra.Command{
-			Use:   "query [flags] contract funcname '[argument...]'",
-			Short: "Query contract by executing read-only function",
-			Args:  cobra.MinimumNArgs(2),
-			Run:   runQueryCmd,
-		},
+		queryCmd,
 		stateQueryCmd,
 	)
 	rootCmd.AddCommand(contractCmd)
@@ -185,6 +205,11 @@ func runDeployCmd(cmd *cobra.Command, args []string) {
 	cmd.Println(util.JSON(msg))
 }
 
+func runRedeployCmd(cmd *cobra.Command, args []string) {
+	contractID = args[1]
+	runDeployCmd(cmd, append([]string{args[0]}, args[2:]...))
+}
+
 func runCallCmd(cmd *cobra.Command, args []string) {
 	caller, err := types.DecodeAddress(args[0])
 	if err != nil {
@@ -240,6 +265,8 @@ func runCallCmd(cmd *cobra.Command, args []string) {
 	txType := types.TxType_NORMAL
 	if gover {
 		txType = types.TxType_GOVERNANCE
+	} else if feeDelegation {
+		txType = types.TxType_FEEDELEGATION
 	}
 
 	tx := &types.Tx{
@@ -316,9 +343,17 @@ func runQueryCmd(cmd *cobra.Command, args []string) {
 		log.Fatal(err)
 	}
 
+	blockHash, err := decodeStateBlockHash()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	query := &types.Query{
 		ContractAddress: contract,
 		Queryinfo:       callinfo,
+		BlockNo:         blockNo,
+		HasBlockNo:      hasBlockNo(cmd),
+		BlockHash:       blockHash,
 	}
 
 	ret, err := client.QueryContract(context.Background(), query)
@@ -342,6 +377,11 @@ func runQueryStateCmd(cmd *cobra.Command, args []string) {
 			return
 		}
 	}
+	blockHash, err := decodeStateBlockHash()
+	if err != nil {
+		cmd.Printf("decode error: %s", err.Error())
+		return
+	}
 	storageKeyPlain := bytes.NewBufferString("_sv_")
 	storageKeyPlain.WriteString(args[1])
 	if len(args) > 2 {
@@ -354,6 +394,9 @@ func runQueryStateCmd(cmd *cobra.Command, args []string) {
 		StorageKeys:     [][]byte{storageKey},
 		Root:            root,
 		Compressed:      compressed,
+		BlockNo:         blockNo,
+		HasBlockNo:      hasBlockNo(cmd),
+		BlockHash:       blockHash,
 	}
 	ret, err := client.QueryContractState(context.Background(), stateQuery)
 	if err != nil {
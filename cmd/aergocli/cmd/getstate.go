@@ -24,6 +24,8 @@ func init() {
 	getstateCmd.Flags().StringVar(&address, "address", "", "Get state from the address")
 	getstateCmd.MarkFlagRequired("address")
 	getstateCmd.Flags().StringVar(&stateroot, "root", "", "Get the state at a specified state root")
+	getstateCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Get the state as of a specified block height")
+	getstateCmd.Flags().StringVar(&statehash, "blockhash", "", "Get the state as of a specified block hash")
 	getstateCmd.Flags().BoolVar(&proof, "proof", false, "Get the proof for the state")
 	getstateCmd.Flags().BoolVar(&compressed, "compressed", false, "Get a compressed proof for the state")
 	getstateCmd.Flags().BoolVar(&staking, "staking", false, "Get the staking info from the address")
@@ -41,6 +43,11 @@ func execGetState(cmd *cobra.Command, args []string) {
 			return
 		}
 	}
+	blockHash, err := decodeStateBlockHash()
+	if err != nil {
+		cmd.Printf("decode error: %s", err.Error())
+		return
+	}
 	addr, err := types.DecodeAddress(address)
 	if err != nil {
 		cmd.Printf("Failed: %s\n", err.Error())
@@ -58,17 +65,28 @@ func execGetState(cmd *cobra.Command, args []string) {
 			cmd.Printf("Failed: %s", err.Error())
 			return
 		}
-		cmd.Printf(`{"account":"%s", "staked":"%s", "when":%d}`+"\n",
-			address, amount, msg.GetWhen())
+		reward, err := util.ConvertUnit(msg.GetRewardBigInt(), unit)
+		if err != nil {
+			cmd.Printf("Failed: %s", err.Error())
+			return
+		}
+		cmd.Printf(`{"account":"%s", "staked":"%s", "when":%d, "reward":"%s"}`+"\n",
+			address, amount, msg.GetWhen(), reward)
 
 		return
 	}
 
 	if !proof {
-		// NOTE GetState first queries the statedb buffer.
-		// So the prefered way to get the state is with a proof
-		msg, err := client.GetState(context.Background(),
-			&types.SingleBytes{Value: addr})
+		var msg *types.State
+		if hasBlockNo(cmd) || blockHash != nil {
+			msg, err = client.GetStateAt(context.Background(),
+				&types.AccountAndRoot{Account: addr, BlockNo: blockNo, HasBlockNo: hasBlockNo(cmd), BlockHash: blockHash})
+		} else {
+			// NOTE GetState first queries the statedb buffer.
+			// So the prefered way to get the state is with a proof
+			msg, err = client.GetState(context.Background(),
+				&types.SingleBytes{Value: addr})
+		}
 		if err != nil {
 			cmd.Printf("Failed: %s", err.Error())
 			return
@@ -84,7 +102,8 @@ func execGetState(cmd *cobra.Command, args []string) {
 		// Get the state and proof at a specific root.
 		// If root is nil, the latest block is queried.
 		msg, err := client.GetStateAndProof(context.Background(),
-			&types.AccountAndRoot{Account: addr, Root: root, Compressed: compressed})
+			&types.AccountAndRoot{Account: addr, Root: root, Compressed: compressed,
+				BlockNo: blockNo, HasBlockNo: hasBlockNo(cmd), BlockHash: blockHash})
 		if err != nil {
 			cmd.Printf("Failed: %s", err.Error())
 			return
@@ -98,3 +117,17 @@ func execGetState(cmd *cobra.Command, args []string) {
 			address, msg.GetState().GetNonce(), balance, msg.GetInclusion(), len(msg.GetAuditPath()), msg.GetHeight())
 	}
 }
+
+// decodeStateBlockHash decodes the block hash given by the --blockhash flag.
+func decodeStateBlockHash() ([]byte, error) {
+	if len(statehash) == 0 {
+		return nil, nil
+	}
+	return base58.Decode(statehash)
+}
+
+// hasBlockNo reports whether the block height is given by the --blockno flag,
+// which distinguishes the genesis block from the default of the best block.
+func hasBlockNo(cmd *cobra.Command) bool {
+	return cmd.Flags().Changed("blockno")
+}
@@ -0,0 +1,113 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pendingFrom string
+	pendingTo   string
+	pendingFull bool
+)
+
+func init() {
+	mempoolCmd := &cobra.Command{
+		Use:   "mempool [flags] subcommand",
+		Short: "Mempool command",
+		Long: "Inspect the mempool of the node.\n" +
+			"Pending txs can be included in the next block, while queued txs wait for\n" +
+			"the txs of the missing nonces.",
+	}
+
+	contentCmd := &cobra.Command{
+		Use:   "content address",
+		Short: "Show the pending and queued txs of an account",
+		Args:  cobra.MinimumNArgs(1),
+		Run:   execMempoolContent,
+	}
+
+	summaryCmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Show the number of pending and queued txs of each account",
+		Run:   execMempoolSummary,
+	}
+
+	streamCmd := &cobra.Command{
+		Use:   "stream [flags]",
+		Short: "Stream the txs accepted by the mempool",
+		Run:   execMempoolStream,
+	}
+	streamCmd.Flags().StringVar(&pendingFrom, "from", "", "show only the txs sent from the address")
+	streamCmd.Flags().StringVar(&pendingTo, "to", "", "show only the txs sent to the address")
+	streamCmd.Flags().BoolVar(&pendingFull, "full", false, "show the whole txs instead of their hashes")
+
+	mempoolCmd.AddCommand(contentCmd, summaryCmd, streamCmd)
+	rootCmd.AddCommand(mempoolCmd)
+}
+
+func execMempoolContent(cmd *cobra.Command, args []string) {
+	account, err := types.DecodeAddress(args[0])
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	msg, err := client.GetMempoolContent(context.Background(), &types.AccountAddress{Value: account})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.MempoolAccountConvBase58Addr(msg))
+}
+
+func execMempoolSummary(cmd *cobra.Command, args []string) {
+	msg, err := client.GetMempoolSummary(context.Background(), &types.Empty{})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	cmd.Println(util.MempoolSummaryConvBase58Addr(msg))
+}
+
+func execMempoolStream(cmd *cobra.Command, args []string) {
+	filter := &types.PendingTxFilter{FullTx: pendingFull}
+	var err error
+	if pendingFrom != "" {
+		if filter.Account, err = types.DecodeAddress(pendingFrom); err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+	}
+	if pendingTo != "" {
+		if filter.Recipient, err = types.DecodeAddress(pendingTo); err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+	}
+	stream, err := client.ListPendingTxStream(context.Background(), filter)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return
+	}
+	for {
+		tx, err := stream.Recv()
+		if err != nil {
+			cmd.Printf("Failed: %s\n", err.Error())
+			return
+		}
+		if pendingFull {
+			cmd.Println(util.TxConvBase58Addr(tx))
+		} else {
+			cmd.Println(base58.Encode(tx.GetHash()))
+		}
+	}
+}
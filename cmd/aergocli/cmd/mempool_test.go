@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMempoolContentWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	testAccount := "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	account, _ := types.DecodeAddress(testAccount)
+	pending := &types.Tx{Body: &types.TxBody{Account: account, Nonce: 3}}
+	pending.Hash = pending.CalculateTxHash()
+	queued := &types.Tx{Body: &types.TxBody{Account: account, Nonce: 5}}
+	queued.Hash = queued.CalculateTxHash()
+
+	mock.EXPECT().GetMempoolContent(
+		gomock.Any(),
+		&types.AccountAddress{Value: account},
+	).Return(
+		&types.MempoolAccount{Account: account, NextNonce: 4, Pending: []*types.Tx{pending}, Queued: []*types.Tx{queued}},
+		nil,
+	).MaxTimes(1)
+
+	output, err := executeCommand(rootCmd, "mempool", "content", testAccount)
+	assert.NoError(t, err, "should no error")
+	out := &util.InOutMempoolAccount{}
+	assert.NoError(t, json.Unmarshal([]byte(output), out))
+	assert.Equal(t, testAccount, out.Account)
+	assert.Equal(t, uint64(4), out.NextNonce)
+	assert.Len(t, out.Pending, 1)
+	assert.Len(t, out.Queued, 1)
+	assert.Equal(t, uint64(5), out.Queued[0].Body.Nonce)
+}
+
+func TestMempoolSummaryWithMock(t *testing.T) {
+	mock := initMock(t)
+	defer deinitMock()
+
+	testAccount := "AmNL5neKQS2ZwRuBeqfcfHMLg3aSmGoefEh5bW8ozWxrtmxaGHZ3"
+	account, _ := types.DecodeAddress(testAccount)
+	mock.EXPECT().GetMempoolSummary(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(
+		&types.MempoolSummary{Total: 3, Orphan: 1, Bytes: 300,
+			Accounts: []*types.MempoolAccountSummary{{Account: account, NextNonce: 4, Pending: 2, Queued: 1}}},
+		nil,
+	).MaxTimes(1)
+
+	output, err := executeCommand(rootCmd, "mempool", "summary")
+	assert.NoError(t, err, "should no error")
+	out := &util.InOutMempoolSummary{}
+	assert.NoError(t, json.Unmarshal([]byte(output), out))
+	assert.Equal(t, int32(3), out.Total)
+	assert.Len(t, out.Accounts, 1)
+	assert.Equal(t, testAccount, out.Accounts[0].Account)
+	assert.Equal(t, int32(1), out.Accounts[0].Queued)
+}
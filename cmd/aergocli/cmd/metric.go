@@ -19,11 +19,13 @@ var metricCmd = &cobra.Command{
 }
 
 var (
-	metricP2Pnet bool
+	metricP2Pnet  bool
+	metricMempool bool
 )
 func init() {
 	rootCmd.AddCommand(metricCmd)
 	metricCmd.Flags().BoolVar(&metricP2Pnet, "p2pnet", true, "Get network transfer metric")
+	metricCmd.Flags().BoolVar(&metricMempool, "mempool", false, "Get mempool capacity and eviction metric")
 }
 
 func execMetric(cmd *cobra.Command, args []string) {
@@ -31,6 +33,9 @@ func execMetric(cmd *cobra.Command, args []string) {
 	if metricP2Pnet {
 		req.Types = append(req.Types, types.MetricType_P2P_NETWORK)
 	}
+	if metricMempool {
+		req.Types = append(req.Types, types.MetricType_MEMPOOL)
+	}
 
 	msg, err := client.Metric(context.Background(), req)
 	if err != nil {
@@ -341,6 +341,42 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetEnterpriseConfig(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnterpriseConfig", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetEnterpriseConfig), varargs...)
 }
 
+// GetMempoolContent mocks base method
+func (m *MockAergoRPCServiceClient) GetMempoolContent(arg0 context.Context, arg1 *types.AccountAddress, arg2 ...grpc.CallOption) (*types.MempoolAccount, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMempoolContent", varargs...)
+	ret0, _ := ret[0].(*types.MempoolAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMempoolContent indicates an expected call of GetMempoolContent
+func (mr *MockAergoRPCServiceClientMockRecorder) GetMempoolContent(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMempoolContent", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetMempoolContent), varargs...)
+}
+
+// GetMempoolSummary mocks base method
+func (m *MockAergoRPCServiceClient) GetMempoolSummary(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*types.MempoolSummary, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMempoolSummary", varargs...)
+	ret0, _ := ret[0].(*types.MempoolSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMempoolSummary indicates an expected call of GetMempoolSummary
+func (mr *MockAergoRPCServiceClientMockRecorder) GetMempoolSummary(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMempoolSummary", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetMempoolSummary), varargs...)
+}
+
 // GetNameInfo mocks base method
 func (m *MockAergoRPCServiceClient) GetNameInfo(arg0 context.Context, arg1 *types.Name, arg2 ...grpc.CallOption) (*types.NameInfo, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -467,6 +503,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) GetStateAndProof(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateAndProof", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetStateAndProof), varargs...)
 }
 
+// GetStateAt mocks base method
+func (m *MockAergoRPCServiceClient) GetStateAt(arg0 context.Context, arg1 *types.AccountAndRoot, arg2 ...grpc.CallOption) (*types.State, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStateAt", varargs...)
+	ret0, _ := ret[0].(*types.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateAt indicates an expected call of GetStateAt
+func (mr *MockAergoRPCServiceClientMockRecorder) GetStateAt(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateAt", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).GetStateAt), varargs...)
+}
+
 // GetTX mocks base method
 func (m *MockAergoRPCServiceClient) GetTX(arg0 context.Context, arg1 *types.SingleBytes, arg2 ...grpc.CallOption) (*types.Tx, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -521,6 +575,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) ImportAccount(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccount", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ImportAccount), varargs...)
 }
 
+// ListAccountTxs mocks base method
+func (m *MockAergoRPCServiceClient) ListAccountTxs(arg0 context.Context, arg1 *types.AccountTxsParams, arg2 ...grpc.CallOption) (*types.AccountTxList, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountTxs", varargs...)
+	ret0, _ := ret[0].(*types.AccountTxList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountTxs indicates an expected call of ListAccountTxs
+func (mr *MockAergoRPCServiceClientMockRecorder) ListAccountTxs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountTxs", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListAccountTxs), varargs...)
+}
+
 // ListBlockHeaders mocks base method
 func (m *MockAergoRPCServiceClient) ListBlockHeaders(arg0 context.Context, arg1 *types.ListParams, arg2 ...grpc.CallOption) (*types.BlockHeaderList, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -629,6 +701,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) ListEvents(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListEvents), varargs...)
 }
 
+// ListPendingTxStream mocks base method
+func (m *MockAergoRPCServiceClient) ListPendingTxStream(arg0 context.Context, arg1 *types.PendingTxFilter, arg2 ...grpc.CallOption) (types.AergoRPCService_ListPendingTxStreamClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPendingTxStream", varargs...)
+	ret0, _ := ret[0].(types.AergoRPCService_ListPendingTxStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingTxStream indicates an expected call of ListPendingTxStream
+func (mr *MockAergoRPCServiceClientMockRecorder) ListPendingTxStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingTxStream", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).ListPendingTxStream), varargs...)
+}
+
 // LockAccount mocks base method
 func (m *MockAergoRPCServiceClient) LockAccount(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.Account, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -755,6 +845,24 @@ func (mr *MockAergoRPCServiceClientMockRecorder) SignTX(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SignTX), varargs...)
 }
 
+// SimulateTX mocks base method
+func (m *MockAergoRPCServiceClient) SimulateTX(arg0 context.Context, arg1 *types.Tx, arg2 ...grpc.CallOption) (*types.SimulateResult, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SimulateTX", varargs...)
+	ret0, _ := ret[0].(*types.SimulateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTX indicates an expected call of SimulateTX
+func (mr *MockAergoRPCServiceClientMockRecorder) SimulateTX(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTX", reflect.TypeOf((*MockAergoRPCServiceClient)(nil).SimulateTX), varargs...)
+}
+
 // UnlockAccount mocks base method
 func (m *MockAergoRPCServiceClient) UnlockAccount(arg0 context.Context, arg1 *types.Personal, arg2 ...grpc.CallOption) (*types.Account, error) {
 	varargs := []interface{}{arg0, arg1}
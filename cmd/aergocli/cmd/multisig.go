@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/aergoio/aergo/account/key"
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/aergoio/aergo/types"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/mr-tron/base58/base58"
+	"github.com/spf13/cobra"
+)
+
+var msThreshold uint32
+var msKeys []string
+
+func init() {
+	multisigCmd := &cobra.Command{
+		Use:   "multisig [flags] subcommand",
+		Short: "Multi-signature account command",
+		Long: "Multi-signature account command.\n" +
+			"A tx from a multisig account is built as a json tx, signed by each key holder\n" +
+			"with 'multisig sign' and sent with 'committx' once enough keys have signed.",
+	}
+
+	msAddressCmd := &cobra.Command{
+		Use:   "address threshold key...",
+		Short: "Print the address of a multisig account",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  execMultisigAddress,
+	}
+
+	msSignCmd := &cobra.Command{
+		Use:   "sign",
+		Short: "Add a signature to a tx sent from a multisig account",
+		RunE:  execMultisigSign,
+	}
+	msSignCmd.Flags().StringVar(&jsonTx, "jsontx", "", "transaction json to sign")
+	msSignCmd.MarkFlagRequired("jsontx")
+	msSignCmd.Flags().Uint32Var(&msThreshold, "threshold", 0, "number of signatures required (for the first signature)")
+	msSignCmd.Flags().StringSliceVar(&msKeys, "keys", nil, "addresses of the keys of the account (for the first signature)")
+	msSignCmd.Flags().StringVar(&dataDir, "path", "$HOME/.aergo/data/cli", "path to data directory")
+	msSignCmd.Flags().StringVar(&address, "address", "", "address of account to use for signing")
+	msSignCmd.Flags().StringVar(&pw, "password", "", "local account password")
+	msSignCmd.Flags().StringVar(&privKey, "key", "", "base58 encoded key for sign")
+	msSignCmd.Flags().StringVar(&keystore, "keystore", "", "URL of an external signer holding the keys (http://host:port or unix:///path/to/socket)")
+
+	msInfoCmd := &cobra.Command{
+		Use:   "info address",
+		Short: "Print the keys of a multisig account",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  execMultisigInfo,
+	}
+
+	multisigCmd.AddCommand(msAddressCmd, msSignCmd, msInfoCmd)
+	rootCmd.AddCommand(multisigCmd)
+}
+
+func parseMultisig(threshold uint32, encodedKeys []string) (*types.Multisig, error) {
+	keys := make([][]byte, len(encodedKeys))
+	for i, k := range encodedKeys {
+		addr, err := types.DecodeAddress(k)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = addr
+	}
+	return types.NewMultisig(threshold, keys)
+}
+
+func execMultisigAddress(cmd *cobra.Command, args []string) error {
+	threshold, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return errors.New("Failed to parse threshold: " + err.Error())
+	}
+	ms, err := parseMultisig(uint32(threshold), args[1:])
+	if err != nil {
+		return err
+	}
+	cmd.Println(types.EncodeAddress(ms.Address()))
+	return nil
+}
+
+func execMultisigSign(cmd *cobra.Command, args []string) error {
+	param, err := util.ParseBase58TxBody([]byte(jsonTx))
+	if err != nil {
+		return err
+	}
+	tx := &types.Tx{Body: param}
+
+	var ms *types.Multisig
+	if len(msKeys) > 0 {
+		if ms, err = parseMultisig(msThreshold, msKeys); err != nil {
+			return err
+		}
+	}
+	if ms == nil && len(tx.Body.Sign) == 0 {
+		return errors.New("--threshold and --keys are required for the first signature")
+	}
+
+	if privKey != "" {
+		rawKey, err := base58.Decode(privKey)
+		if err != nil {
+			return err
+		}
+		signKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), rawKey)
+		if err = key.SignMultisigTx(tx, ms, signKey); err != nil {
+			return err
+		}
+	} else {
+		if address == "" {
+			return errors.New("--key or --address is required")
+		}
+		signer, err := types.DecodeAddress(address)
+		if err != nil {
+			return err
+		}
+		ks, err := openKeystore(cmd)
+		if err != nil {
+			return err
+		}
+		defer ks.CloseStore()
+		sign, err := ks.Sign(signer, pw, key.CalculateHashWithoutSign(tx.Body))
+		if err != nil {
+			return err
+		}
+		if err = key.AddMultisigSign(tx, ms, signer, sign); err != nil {
+			return err
+		}
+	}
+	cmd.Println(util.TxConvBase58Addr(tx))
+	return nil
+}
+
+func execMultisigInfo(cmd *cobra.Command, args []string) error {
+	addr, err := types.DecodeAddress(args[0])
+	if err != nil {
+		return err
+	}
+	msg, err := client.GetState(context.Background(), &types.SingleBytes{Value: addr})
+	if err != nil {
+		return err
+	}
+	ms := msg.GetMultisig()
+	if ms == nil {
+		return errors.New("no multisig keys recorded for " + args[0])
+	}
+	keys := make([]string, len(ms.Keys))
+	for i, k := range ms.Keys {
+		keys[i] = types.EncodeAddress(k)
+	}
+	cmd.Println(util.B58JSON(struct {
+		Account   string
+		Threshold uint32
+		Keys      []string
+	}{args[0], ms.Threshold, keys}))
+	return nil
+}
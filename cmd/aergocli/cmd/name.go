@@ -11,6 +11,8 @@ import (
 	"errors"
 	"log"
 	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/aergoio/aergo/cmd/aergocli/util"
 	"github.com/aergoio/aergo/types"
@@ -62,7 +64,50 @@ func init() {
 	ownerCmd.MarkFlagRequired("name")
 	ownerCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Block height")
 
-	nameCmd.AddCommand(newCmd, updateCmd, ownerCmd)
+	renewCmd := &cobra.Command{
+		Use:                   "renew",
+		Short:                 "Renew the registration of account name",
+		RunE:                  execNameRenew,
+		DisableFlagsInUseLine: true,
+	}
+	renewCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	renewCmd.MarkFlagRequired("from")
+	renewCmd.Flags().StringVar(&name, "name", "", "Name of account to renew")
+	renewCmd.MarkFlagRequired("name")
+	renewCmd.Flags().StringVar(&spending, "amount", "1aergo", "Spending for renew name. at least 1 aergo")
+
+	primaryCmd := &cobra.Command{
+		Use:                   "primary",
+		Short:                 "Set the primary name of account, to which the account is resolved back",
+		RunE:                  execNamePrimary,
+		DisableFlagsInUseLine: true,
+	}
+	primaryCmd.Flags().StringVar(&from, "from", "", "Sender account address")
+	primaryCmd.MarkFlagRequired("from")
+	primaryCmd.Flags().StringVar(&name, "name", "", "Name resolved to the sender")
+	primaryCmd.MarkFlagRequired("name")
+
+	reverseCmd := &cobra.Command{
+		Use:                   "reverse",
+		Short:                 "Primary name of account address",
+		Run:                   execNameReverse,
+		DisableFlagsInUseLine: true,
+	}
+	reverseCmd.Flags().StringVar(&address, "address", "", "Account address")
+	reverseCmd.MarkFlagRequired("address")
+	reverseCmd.Flags().Uint64VarP(&blockNo, "blockno", "n", 0, "Block height")
+
+	nameCmd.AddCommand(newCmd, updateCmd, ownerCmd, renewCmd, primaryCmd, reverseCmd)
+}
+
+// validateNameLength checks the length of the top level name, to which the
+// labels of the subdomains are prepended.
+func validateNameLength(name string) error {
+	labels := strings.Split(name, ".")
+	if len(labels[len(labels)-1]) != types.NameLength {
+		return errors.New("The name must be 12 alphabetic characters\n")
+	}
+	return nil
 }
 
 func execNameNew(cmd *cobra.Command, args []string) error {
@@ -71,8 +116,8 @@ func execNameNew(cmd *cobra.Command, args []string) error {
 		return errors.New("Wrong address in --from flag\n" + err.Error())
 	}
 
-	if len(name) != types.NameLength {
-		return errors.New("The name must be 12 alphabetic characters\n")
+	if err := validateNameLength(name); err != nil {
+		return err
 	}
 	amount, err := util.ParseUnit(spending)
 	if err != nil {
@@ -130,8 +175,8 @@ func execNameUpdate(cmd *cobra.Command, args []string) error {
 		amount = big.NewInt(0)
 	} else {
 		ci.Name = types.NameUpdate
-		if len(name) != types.NameLength {
-			return errors.New("The name must be 12 alphabetic characters\n")
+		if err := validateNameLength(name); err != nil {
+			return err
 		}
 		err = json.Unmarshal([]byte("[\""+name+"\",\""+to+"\"]"), &ci.Args)
 		if err != nil {
@@ -161,13 +206,73 @@ func execNameUpdate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func execNameRenew(cmd *cobra.Command, args []string) error {
+	if err := validateNameLength(name); err != nil {
+		return err
+	}
+	amount, err := util.ParseUnit(spending)
+	if err != nil {
+		return errors.New("Wrong value in --amount flag\n" + err.Error())
+	}
+	return sendNameTx(cmd, types.NameRenew, amount)
+}
+
+func execNamePrimary(cmd *cobra.Command, args []string) error {
+	if err := validateNameLength(name); err != nil {
+		return err
+	}
+	return sendNameTx(cmd, types.NameSetPrimary, big.NewInt(0))
+}
+
+func sendNameTx(cmd *cobra.Command, operation string, amount *big.Int) error {
+	account, err := types.DecodeAddress(from)
+	if err != nil {
+		return errors.New("Wrong address in --from flag\n" + err.Error())
+	}
+	payload, err := json.Marshal(&types.CallInfo{Name: operation, Args: []interface{}{name}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	tx := &types.Tx{
+		Body: &types.TxBody{
+			Account:   account,
+			Recipient: []byte(types.AergoName),
+			Amount:    amount.Bytes(),
+			Payload:   payload,
+			GasLimit:  0,
+			Type:      types.TxType_GOVERNANCE,
+		},
+	}
+	msg, err := client.SendTX(context.Background(), tx)
+	if err != nil {
+		cmd.Printf("Failed request to aergo sever\n" + err.Error())
+		return nil
+	}
+	cmd.Println(util.JSON(msg))
+	return nil
+}
+
 func execNameOwner(cmd *cobra.Command, args []string) {
 	msg, err := client.GetNameInfo(context.Background(), &types.Name{Name: name, BlockNo: blockNo})
 	if err != nil {
 		cmd.Println(err.Error())
 		return
 	}
+	printNameInfo(cmd, msg)
+}
+
+func execNameReverse(cmd *cobra.Command, args []string) {
+	msg, err := client.GetNameInfo(context.Background(), &types.Name{Name: address, BlockNo: blockNo, Reverse: true})
+	if err != nil {
+		cmd.Println(err.Error())
+		return
+	}
+	printNameInfo(cmd, msg)
+}
+
+func printNameInfo(cmd *cobra.Command, msg *types.NameInfo) {
 	cmd.Println("{\n \"" + msg.Name.Name + "\": {\n  " +
 		"\"Owner\": \"" + types.EncodeAddress(msg.Owner) + "\",\n  " +
-		"\"Destination\": \"" + types.EncodeAddress(msg.Destination) + "\"\n  }\n}")
+		"\"Destination\": \"" + types.EncodeAddress(msg.Destination) + "\",\n  " +
+		"\"ExpireAt\": " + strconv.FormatUint(msg.ExpireAt, 10) + "\n  }\n}")
 }
@@ -40,6 +40,7 @@ var (
 	privKey   string
 	pw        string
 	dataDir   string
+	keystore  string
 
 	from   string
 	to     string
@@ -49,6 +50,7 @@ var (
 
 	address    string
 	stateroot  string
+	statehash  string
 	proof      bool
 	compressed bool
 
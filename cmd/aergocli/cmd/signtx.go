@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"os"
 
 	"github.com/aergoio/aergo/account/key"
 	"github.com/aergoio/aergo/cmd/aergocli/util"
@@ -19,6 +18,7 @@ func init() {
 	signCmd.Flags().StringVar(&address, "address", "1", "address of account to use for signing")
 	signCmd.Flags().StringVar(&pw, "password", "", "local account password")
 	signCmd.Flags().StringVar(&privKey, "key", "", "base58 encoded key for sign")
+	signCmd.Flags().StringVar(&keystore, "keystore", "", "URL of an external signer holding the keys (http://host:port or unix:///path/to/socket)")
 	rootCmd.AddCommand(verifyCmd)
 	verifyCmd.Flags().StringVar(&jsonTx, "jsontx", "", "transaction list json to verify")
 	verifyCmd.Flags().BoolVar(&remote, "remote", false, "verify in the node")
@@ -57,7 +57,7 @@ var signCmd = &cobra.Command{
 			}
 			cmd.Println(types.EncodeAddress(key.GenerateAddress(pubkey.ToECDSA())))
 			msg = tx
-		} else if cmd.Flags().Changed("path") == false {
+		} else if !useKeystore(cmd) {
 			msg, err = client.SignTX(context.Background(), &types.Tx{Body: param})
 		} else {
 			tx := &types.Tx{Body: param}
@@ -71,8 +71,11 @@ var signCmd = &cobra.Command{
 				return
 			}
 
-			dataEnvPath := os.ExpandEnv(dataDir)
-			ks := key.NewStore(dataEnvPath, 0)
+			ks, err := openKeystore(cmd)
+			if err != nil {
+				cmd.Printf("Failed: %s\n", err.Error())
+				return
+			}
 			defer ks.CloseStore()
 			addr, err := types.DecodeAddress(address)
 			if err != nil {
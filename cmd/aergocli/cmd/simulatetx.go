@@ -0,0 +1,54 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+
+	"github.com/aergoio/aergo/cmd/aergocli/util"
+	"github.com/spf13/cobra"
+)
+
+var simulatetxCmd = &cobra.Command{
+	Use:   "simulatetx",
+	Short: "Simulate a transaction on the best block without committing it",
+	Args:  cobra.MinimumNArgs(0),
+	RunE:  execSimulateTX,
+}
+
+func init() {
+	rootCmd.AddCommand(simulatetxCmd)
+
+	simulatetxCmd.Flags().StringVar(&jsonTx, "jsontx", "", "Transaction json. The hash and sign can be omitted")
+	simulatetxCmd.Flags().StringVar(&jsonPath, "jsontxpath", "", "Transaction json file path")
+}
+
+func execSimulateTX(cmd *cobra.Command, args []string) error {
+	if jsonPath != "" {
+		b, readerr := ioutil.ReadFile(jsonPath)
+		if readerr != nil {
+			return errors.New("Failed to read --jsontxpath\n" + readerr.Error())
+		}
+		jsonTx = string(b)
+	}
+	if jsonTx == "" {
+		return errors.New("need to transaction json input")
+	}
+	txs, err := util.ParseBase58Tx([]byte(jsonTx))
+	if err != nil {
+		return errors.New("Failed to parse --jsontx\n" + err.Error())
+	}
+	for _, tx := range txs {
+		msg, err := client.SimulateTX(context.Background(), tx)
+		if err != nil {
+			return errors.New("Failed request to aergo server\n" + err.Error())
+		}
+		cmd.Println(util.JSON(msg))
+	}
+	return nil
+}
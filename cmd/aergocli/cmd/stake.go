@@ -35,6 +35,51 @@ func execUnstake(cmd *cobra.Command, args []string) error {
 	return sendStake(cmd, false)
 }
 
+var claimRewardCmd = &cobra.Command{
+	Use:   "claimreward",
+	Short: "Claim the reward of voting from aergo system",
+	RunE:  execClaimReward,
+}
+
+func execClaimReward(cmd *cobra.Command, args []string) error {
+	account, err := types.DecodeAddress(address)
+	if err != nil {
+		return errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
+	}
+	payload, err := json.Marshal(types.CallInfo{Name: types.ClaimReward})
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return nil
+	}
+	return sendSystemTx(cmd, account, nil, payload)
+}
+
+var delegateCmd = &cobra.Command{
+	Use:   "delegate",
+	Short: "Delegate voting power to a proxy",
+	RunE:  execDelegate,
+}
+
+func execDelegate(cmd *cobra.Command, args []string) error {
+	account, err := types.DecodeAddress(address)
+	if err != nil {
+		return errors.New("Failed to parse --address flag (" + address + ")\n" + err.Error())
+	}
+	ci := types.CallInfo{Name: types.Delegate}
+	if to != "" {
+		if _, err := types.DecodeAddress(to); err != nil {
+			return errors.New("Failed to parse --to flag (" + to + ")\n" + err.Error())
+		}
+		ci.Args = append(ci.Args, to)
+	}
+	payload, err := json.Marshal(ci)
+	if err != nil {
+		cmd.Printf("Failed: %s\n", err.Error())
+		return nil
+	}
+	return sendSystemTx(cmd, account, nil, payload)
+}
+
 func sendStake(cmd *cobra.Command, s bool) error {
 	account, err := types.DecodeAddress(address)
 	if err != nil {
@@ -55,11 +100,15 @@ func sendStake(cmd *cobra.Command, s bool) error {
 		cmd.Printf("Failed: %s\n", err.Error())
 		return nil
 	}
+	return sendSystemTx(cmd, account, amountBigInt.Bytes(), payload)
+}
+
+func sendSystemTx(cmd *cobra.Command, account, amount, payload []byte) error {
 	tx := &types.Tx{
 		Body: &types.TxBody{
 			Account:   account,
 			Recipient: []byte(types.AergoSystem),
-			Amount:    amountBigInt.Bytes(),
+			Amount:    amount,
 			Payload:   payload,
 			GasLimit:  0,
 			Type:      types.TxType_GOVERNANCE,
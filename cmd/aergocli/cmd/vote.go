@@ -106,7 +106,7 @@ func execVote(cmd *cobra.Command, args []string) {
 		ci.Name = getVoteCmd(election)
 		numberArg, ok := new(big.Int).SetString(to, 10)
 		if !ok {
-			cmd.Printf("Failed: %s\n", err.Error())
+			cmd.Printf("Failed: invalid number %s\n", to)
 			return
 		}
 		ci.Args = append(ci.Args, numberArg.String())
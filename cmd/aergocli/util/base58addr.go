@@ -68,6 +68,39 @@ type InOutBlockIdx struct {
 	BlockNo   uint64
 }
 
+type InOutAccountTx struct {
+	TxHash    string
+	BlockNo   uint64
+	BlockHash string
+	TxIdx     int32
+}
+
+type InOutAccountTxList struct {
+	Account string
+	Txs     []*InOutAccountTx
+}
+
+type InOutMempoolAccount struct {
+	Account   string
+	NextNonce uint64
+	Pending   []*InOutTx
+	Queued    []*InOutTx
+}
+
+type InOutMempoolAccountSummary struct {
+	Account   string
+	NextNonce uint64
+	Pending   int32
+	Queued    int32
+}
+
+type InOutMempoolSummary struct {
+	Total    int32
+	Orphan   int32
+	Bytes    uint64
+	Accounts []*InOutMempoolAccountSummary
+}
+
 type InOutPeerAddress struct {
 	Address string
 	Port    string
@@ -326,6 +359,66 @@ func BlockConvBase58Addr(b *types.Block) string {
 	return toString(ConvBlock(b))
 }
 
+func ConvAccountTxList(l *types.AccountTxList) *InOutAccountTxList {
+	out := &InOutAccountTxList{Account: types.EncodeAddress(l.GetAccount())}
+	out.Txs = make([]*InOutAccountTx, len(l.GetTxs()))
+	for i, tx := range l.GetTxs() {
+		out.Txs[i] = &InOutAccountTx{
+			TxHash:    base58.Encode(tx.GetTxHash()),
+			BlockNo:   tx.GetBlockNo(),
+			BlockHash: base58.Encode(tx.GetBlockHash()),
+			TxIdx:     tx.GetTxIdx(),
+		}
+	}
+	return out
+}
+
+func AccountTxListConvBase58Addr(l *types.AccountTxList) string {
+	return toString(ConvAccountTxList(l))
+}
+
+func ConvMempoolAccount(m *types.MempoolAccount) *InOutMempoolAccount {
+	convTxs := func(txs []*types.Tx) []*InOutTx {
+		out := make([]*InOutTx, len(txs))
+		for i, tx := range txs {
+			out[i] = ConvTx(tx)
+		}
+		return out
+	}
+	return &InOutMempoolAccount{
+		Account:   types.EncodeAddress(m.GetAccount()),
+		NextNonce: m.GetNextNonce(),
+		Pending:   convTxs(m.GetPending()),
+		Queued:    convTxs(m.GetQueued()),
+	}
+}
+
+func MempoolAccountConvBase58Addr(m *types.MempoolAccount) string {
+	return toString(ConvMempoolAccount(m))
+}
+
+func ConvMempoolSummary(m *types.MempoolSummary) *InOutMempoolSummary {
+	out := &InOutMempoolSummary{
+		Total:    m.GetTotal(),
+		Orphan:   m.GetOrphan(),
+		Bytes:    m.GetBytes(),
+		Accounts: make([]*InOutMempoolAccountSummary, len(m.GetAccounts())),
+	}
+	for i, acc := range m.GetAccounts() {
+		out.Accounts[i] = &InOutMempoolAccountSummary{
+			Account:   types.EncodeAddress(acc.GetAccount()),
+			NextNonce: acc.GetNextNonce(),
+			Pending:   acc.GetPending(),
+			Queued:    acc.GetQueued(),
+		}
+	}
+	return out
+}
+
+func MempoolSummaryConvBase58Addr(m *types.MempoolSummary) string {
+	return toString(ConvMempoolSummary(m))
+}
+
 func PeerListToString(p *types.PeerList) string {
 	peers := []*InOutPeer{}
 	for _, peer := range p.GetPeers() {
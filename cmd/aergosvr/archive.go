@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/consensus/impl"
+	"github.com/aergoio/aergo/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportFrom uint64
+	exportTo   uint64
+)
+
+func init() {
+	exportCmd.Flags().Uint64Var(&exportFrom, "from", 0, "number of the first block to export")
+	exportCmd.Flags().Uint64Var(&exportTo, "to", 0, "number of the last block to export (default: the best block)")
+
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(importCmd)
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export <archive file>",
+	Short: "Export blocks and receipts to an archive file",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		core := getCore(cfg.DataDir)
+		if core == nil {
+			return
+		}
+		defer core.Close()
+
+		to := types.BlockNo(exportTo)
+		if !cmd.Flags().Changed("to") {
+			to = ^types.BlockNo(0)
+		}
+		if to < exportFrom {
+			fmt.Printf("invalid block range (from:%d, to:%d)\n", exportFrom, to)
+			return
+		}
+
+		file, err := os.Create(args[0])
+		if err != nil {
+			fmt.Printf("fail to create %s (error:%s)\n", args[0], err)
+			return
+		}
+		defer file.Close()
+
+		n, err := core.ExportBlocks(file, exportFrom, to)
+		if err != nil {
+			fmt.Printf("fail to export blocks (exported:%d, error:%s)\n", n, err)
+			return
+		}
+		fmt.Printf("%d blocks are exported to %s\n", n, args[0])
+	},
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import <archive file>",
+	Short: "Import blocks from an archive file",
+	Long:  "Import blocks from an archive file made by export. The blocks are verified and executed as the ones received from the network.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		file, err := os.Open(args[0])
+		if err != nil {
+			fmt.Printf("fail to open %s (error:%s)\n", args[0], err)
+			return
+		}
+		defer file.Close()
+
+		chainSvc := chain.NewChainService(cfg)
+		defer chainSvc.BeforeStop()
+
+		if _, err := impl.NewOffline(cfg, chainSvc); err != nil {
+			fmt.Printf("fail to init consensus (error:%s)\n", err)
+			return
+		}
+
+		n, err := chainSvc.ImportBlocks(file)
+		if err != nil {
+			fmt.Printf("fail to import blocks (read:%d, error:%s)\n", n, err)
+			return
+		}
+		fmt.Printf("%d blocks in %s are imported\n", n, args[0])
+	},
+}
@@ -0,0 +1,48 @@
+package exec
+
+import (
+	"fmt"
+
+	"github.com/aergoio/aergo/cmd/brick/context"
+	"github.com/aergoio/aergo/contract"
+)
+
+func init() {
+	registerExec(&redeployContract{})
+}
+
+type redeployContract struct {
+	deployContract
+}
+
+func (c *redeployContract) Command() string {
+	return "redeploy"
+}
+
+func (c *redeployContract) Usage() string {
+	return fmt.Sprintf("redeploy <sender_name> <amount> <contract_name> `<definition_file_path>` `[migrate_json_arg]`")
+}
+
+func (c *redeployContract) Describe() string {
+	return "upgrade the code of a deployed smart contract, keeping its state"
+}
+
+func (c *redeployContract) Run(args string) (string, error) {
+	accountName, amount, contractName, defPath, migrateArg, _ := c.parse(args)
+
+	defByte, err := c.readDefFile(defPath)
+	if err != nil {
+		return "", err
+	}
+
+	err = context.Get().ConnectBlock(
+		contract.NewRawLuaTxDefBig(accountName, contractName, amount, string(defByte)).Redeploy().Constructor(migrateArg),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	updateContractInfoInterface(contractName, defPath)
+
+	return "redeploy a smart contract successfully", nil
+}
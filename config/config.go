@@ -113,6 +113,11 @@ func (ctx *ServerContext) GetDefaultBlockchainConfig() *BlockchainConfig {
 		ForceResetHeight: 0,
 		ZeroFee:          true,
 		StateTrace:       0,
+		AccountTxIndex:   false,
+		SnapshotSync:     false,
+		SnapshotPivot:    1024,
+		StateMode:        "archive",
+		StateKeepBlocks:  128,
 	}
 }
 
@@ -123,6 +128,11 @@ func (ctx *ServerContext) GetDefaultMempoolConfig() *MempoolConfig {
 		FadeoutPeriod:  types.DefaultEvictPeriod,
 		VerifierNumber: runtime.NumCPU(),
 		DumpFilePath:   ctx.ExpandPathEnv("$HOME/mempool.dump"),
+		PriceBump:      types.DefaultPriceBump,
+		MaxTxs:         types.DefaultMempoolMaxTxs,
+		MaxBytes:       types.DefaultMempoolMaxBytes,
+		AccountTxs:     types.DefaultMempoolAccountTxs,
+		MaxOrphans:     types.DefaultMempoolMaxOrphans,
 	}
 }
 
@@ -97,6 +97,11 @@ type BlockchainConfig struct {
 	ZeroFee          bool   `mapstructure:"zerofee" description:"enable zero-fee mode(works only on private network)"`
 	VerifyOnly       bool   `mapstructure:"verifyonly" description:"In verify only mode, server verifies block chain of disk. server never modifies block chain'"`
 	StateTrace       uint64 `mapstructure:"statetrace" description:"dump trace of setting state"`
+	AccountTxIndex   bool   `mapstructure:"accounttxindex" description:"index transactions by sender and recipient account"`
+	SnapshotSync     bool   `mapstructure:"snapshotsync" description:"sync a new node by downloading the state of a recent block instead of executing all blocks"`
+	SnapshotPivot    uint64 `mapstructure:"snapshotpivot" description:"number of blocks between the block whose state is downloaded in snapshot sync and the best block of peer"`
+	StateMode        string `mapstructure:"statemode" description:"state storage mode: archive keeps the states of all blocks, prune deletes the states of old blocks"`
+	StateKeepBlocks  uint64 `mapstructure:"statekeepblocks" description:"number of recent blocks whose states are kept in prune mode, besides the ones after the last irreversible block"`
 }
 
 // MempoolConfig defines configurations for mempool service
@@ -106,12 +111,18 @@ type MempoolConfig struct {
 	FadeoutPeriod  int    `mapstructure:"fadeoutperiod" description:"time period for evict transactions(in hour)"`
 	VerifierNumber int    `mapstructure:"verifiers" description:"number of concurrent verifier"`
 	DumpFilePath   string `mapstructure:"dumpfilepath" description:"file path for recording mempool at process termintation"`
+	PriceBump      int    `mapstructure:"pricebump" description:"minimum gas price increase (in percent) to replace a pending tx with the same nonce"`
+	MaxTxs         int    `mapstructure:"maxtxs" description:"maximum number of transactions in mempool (0 for no limit)"`
+	MaxBytes       int    `mapstructure:"maxbytes" description:"maximum total size of transactions in mempool (in bytes, 0 for no limit)"`
+	AccountTxs     int    `mapstructure:"accounttxs" description:"maximum number of transactions of an account in mempool (0 for no limit)"`
+	MaxOrphans     int    `mapstructure:"maxorphans" description:"maximum number of orphan transactions in mempool (0 for no limit)"`
 }
 
 // ConsensusConfig defines configurations for consensus service
 type ConsensusConfig struct {
 	EnableBp      bool        `mapstructure:"enablebp" description:"enable block production"`
 	BlockInterval int64       `mapstructure:"blockinterval" description:"block production interval (sec)"`
+	TxSource      string      `mapstructure:"txsource" description:"URL of an external block builder which orders the txs of new blocks (http://host:port or unix:///path/to/socket), or empty to take them from mempool"`
 	Raft          *RaftConfig `mapstructure:"raft"`
 }
 
@@ -143,7 +154,8 @@ type MonitorConfig struct {
 
 // Account defines configurations for account service
 type AccountConfig struct {
-	UnlockTimeout uint `mapstructure:"unlocktimeout" description:"lock automatically after timeout (sec)"`
+	UnlockTimeout uint   `mapstructure:"unlocktimeout" description:"lock automatically after timeout (sec)"`
+	Signer        string `mapstructure:"signer" description:"url of an external signer holding the keys (http://host:port or unix:///path/to/socket). the keys are kept in the data directory if empty"`
 }
 
 /*
@@ -214,6 +226,11 @@ coinbaseaccount = "{{.Blockchain.CoinbaseAccount}}"
 maxanchorcount = "{{.Blockchain.MaxAnchorCount}}"
 verifiercount = "{{.Blockchain.VerifierCount}}"
 forceresetheight = "{{.Blockchain.ForceResetHeight}}"
+accounttxindex = {{.Blockchain.AccountTxIndex}}
+snapshotsync = {{.Blockchain.SnapshotSync}}
+snapshotpivot = {{.Blockchain.SnapshotPivot}}
+statemode = "{{.Blockchain.StateMode}}"
+statekeepblocks = {{.Blockchain.StateKeepBlocks}}
 
 [mempool]
 showmetrics = {{.Mempool.ShowMetrics}}
@@ -221,10 +238,16 @@ enablefadeout = {{.Mempool.EnableFadeout}}
 fadeoutperiod = {{.Mempool.FadeoutPeriod}}
 verifiers = {{.Mempool.VerifierNumber}}
 dumpfilepath = "{{.Mempool.DumpFilePath}}"
+pricebump = {{.Mempool.PriceBump}}
+maxtxs = {{.Mempool.MaxTxs}}
+maxbytes = {{.Mempool.MaxBytes}}
+accounttxs = {{.Mempool.AccountTxs}}
+maxorphans = {{.Mempool.MaxOrphans}}
 
 [consensus]
 enablebp = {{.Consensus.EnableBp}}
 blockinterval = {{.Consensus.BlockInterval}}
+txsource = "{{.Consensus.TxSource}}"
 
 [monitor]
 protocol = "{{.Monitor.ServerProtocol}}"
@@ -232,6 +255,7 @@ endpoint = "{{.Monitor.ServerEndpoint}}"
 
 [account]
 unlocktimeout = "{{.Account.UnlockTimeout}}"
+signer = "{{.Account.Signer}}"
 
 [auth]
 enablelocalconf = "{{.Auth.EnableLocalConf}}"
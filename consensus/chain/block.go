@@ -65,7 +65,7 @@ func MaxBlockBodySize() uint32 {
 
 // GenerateBlock generate & return a new block
 func GenerateBlock(hs component.ICompSyncRequester, prevBlock *types.Block, bState *state.BlockState, txOp TxOp, ts int64, skipEmpty bool) (*types.Block, error) {
-	transactions, err := GatherTXs(hs, bState, txOp, MaxBlockBodySize())
+	transactions, err := GatherTXs(hs, bState, txOp, prevBlock.BlockNo()+1, MaxBlockBodySize())
 	if err != nil {
 		return nil, err
 	}
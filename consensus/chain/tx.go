@@ -17,6 +17,7 @@ import (
 	"github.com/Cofresi/aergo/pkg/component"
 	"github.com/Cofresi/aergo/state"
 	"github.com/Cofresi/aergo/types"
+	"github.com/aergoio/aergo/consensus"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -98,9 +99,25 @@ func UnlockChain() {
 	<-chain.InAddBlock
 }
 
+// fetchCandidates returns the candidate transactions of block blockNo from the
+// TxSource of the block factories. If the TxSource fails, the transactions
+// are taken from the mempool in its order.
+func fetchCandidates(hs component.ICompSyncRequester, blockNo types.BlockNo, maxBlockBodySize uint32) []types.Transaction {
+	src := consensus.GetTxSource()
+	if src == nil {
+		src = MempoolTxSource{}
+	}
+	txs, err := src.Txs(hs, blockNo, maxBlockBodySize)
+	if err != nil {
+		logger.Error().Err(err).Msg("can't fetch transactions from tx source, fall back to mempool")
+		txs, _ = MempoolTxSource{}.Txs(hs, blockNo, maxBlockBodySize)
+	}
+	return txs
+}
+
 // GatherTXs returns transactions from txIn. The selection is done by applying
 // txDo.
-func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp TxOp, maxBlockBodySize uint32) ([]types.Transaction, error) {
+func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp TxOp, blockNo types.BlockNo, maxBlockBodySize uint32) ([]types.Transaction, error) {
 	var (
 		nCollected int
 		nCand      int
@@ -110,14 +127,19 @@ func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp T
 		logger.Debug().Msg("start gathering tx")
 	}
 
+	// the tx source may call an external block builder, which must not
+	// hold up the chain
+	txIn := fetchCandidates(hs, blockNo, maxBlockBodySize)
+
 	if err := LockChain(); err != nil {
 		return nil, ErrBestBlock
 	}
 	defer UnlockChain()
 
-	txIn := FetchTXs(hs, maxBlockBodySize)
 	nCand = len(txIn)
-	if nCand == 0 {
+	// an empty block is still executed from the V2 hardfork, since coins may
+	// be minted and the slots of the BPs are recorded in it
+	if nCand == 0 && !types.IsV2Fork(blockNo) {
 		return txIn, nil
 	}
 	txRes := make([]types.Transaction, 0, nCand)
@@ -167,11 +189,11 @@ func GatherTXs(hs component.ICompSyncRequester, bState *state.BlockState, txOp T
 
 	nCollected = len(txRes)
 
-	if err := chain.SendRewardCoinbase(bState, chain.CoinbaseAccount); err != nil {
+	if err := chain.SendRewardCoinbase(bState, chain.CoinbaseAccount, blockNo); err != nil {
 		return nil, err
 	}
 
-	if err := contract.SaveRecoveryPoint(bState); err != nil {
+	if err := contract.SaveRecoveryPoint(bState, blockNo); err != nil {
 		return nil, err
 	}
 
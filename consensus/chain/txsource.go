@@ -0,0 +1,159 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Cofresi/aergo/message"
+	"github.com/Cofresi/aergo/pkg/component"
+	"github.com/Cofresi/aergo/types"
+	"github.com/aergoio/aergo/consensus"
+	"github.com/mr-tron/base58/base58"
+)
+
+// The external builder protocol is a JSON request and response over HTTP,
+// served either on a TCP address or on a Unix socket. The block factory posts
+// to the path /txs
+//
+//	{"blockNo": 123, "maxBlockBodySize": 1048576}
+//
+// and the builder responds with the hashes of the transactions to include, in
+// order, or an error message:
+//
+//	{"hashes": ["base58 tx hash", ...]}
+//	{"error": "message"}
+//
+// The transactions are taken from the mempool, so that they are verified as
+// any other transaction. The hashes not found in the mempool are skipped.
+
+const externalTxSourceTimeout = 500 * time.Millisecond
+
+// MempoolTxSource takes the transactions from the mempool in its order.
+type MempoolTxSource struct{}
+
+// Txs returns the transactions from the mempool.
+func (MempoolTxSource) Txs(hs component.ICompSyncRequester, blockNo types.BlockNo, maxBlockBodySize uint32) ([]types.Transaction, error) {
+	return FetchTXs(hs, maxBlockBodySize), nil
+}
+
+type builderRequest struct {
+	BlockNo          types.BlockNo `json:"blockNo"`
+	MaxBlockBodySize uint32        `json:"maxBlockBodySize"`
+}
+
+type builderResponse struct {
+	Hashes []string `json:"hashes,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// ExternalTxSource takes the order of the transactions from an external block
+// builder.
+type ExternalTxSource struct {
+	base   string
+	client *http.Client
+}
+
+// NewExternalTxSource returns a TxSource of the external block builder at
+// builderURL, which is either http://host:port or unix:///path/to/socket.
+func NewExternalTxSource(builderURL string) (*ExternalTxSource, error) {
+	u, err := url.Parse(builderURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http":
+		return &ExternalTxSource{
+			base:   strings.TrimSuffix(builderURL, "/"),
+			client: &http.Client{Timeout: externalTxSourceTimeout},
+		}, nil
+	case "unix":
+		if u.Path == "" {
+			return nil, fmt.Errorf("no socket path in %s", builderURL)
+		}
+		var d net.Dialer
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return d.DialContext(ctx, "unix", u.Path)
+			},
+		}
+		return &ExternalTxSource{
+			base:   "http://unix",
+			client: &http.Client{Timeout: externalTxSourceTimeout, Transport: transport},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported block builder url %s", builderURL)
+	}
+}
+
+// Txs returns the transactions in the order of the external block builder.
+func (s *ExternalTxSource) Txs(hs component.ICompSyncRequester, blockNo types.BlockNo, maxBlockBodySize uint32) ([]types.Transaction, error) {
+	hashes, err := s.order(blockNo, maxBlockBodySize)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]types.Transaction, 0, len(hashes))
+	for start := 0; start < len(hashes); start += message.MaxReqestHashes {
+		end := start + message.MaxReqestHashes
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+		result, err := hs.RequestFuture(message.MemPoolSvc,
+			&message.MemPoolExistEx{Hashes: hashes[start:end]}, time.Second,
+			"consensus/chain.(*ExternalTxSource).Txs").Result()
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range result.(*message.MemPoolExistExRsp).Txs {
+			if tx != nil {
+				txs = append(txs, types.NewTransaction(tx))
+			}
+		}
+	}
+	if len(txs) < len(hashes) {
+		logger.Debug().Int("ordered", len(hashes)).Int("found", len(txs)).Msg("txs of block builder missing in mempool")
+	}
+	return txs, nil
+}
+
+func (s *ExternalTxSource) order(blockNo types.BlockNo, maxBlockBodySize uint32) ([]types.TxHash, error) {
+	body, err := json.Marshal(&builderRequest{BlockNo: blockNo, MaxBlockBodySize: maxBlockBodySize})
+	if err != nil {
+		return nil, err
+	}
+	httpRsp, err := s.client.Post(s.base+"/txs", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer httpRsp.Body.Close()
+	var rsp builderResponse
+	if err = json.NewDecoder(httpRsp.Body).Decode(&rsp); err != nil {
+		return nil, fmt.Errorf("invalid block builder response: %s", httpRsp.Status)
+	}
+	if rsp.Error != "" {
+		return nil, errors.New(rsp.Error)
+	}
+	hashes := make([]types.TxHash, len(rsp.Hashes))
+	for i, h := range rsp.Hashes {
+		if hashes[i], err = base58.Decode(h); err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
+var _ consensus.TxSource = MempoolTxSource{}
+var _ consensus.TxSource = (*ExternalTxSource)(nil)
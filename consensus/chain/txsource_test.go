@@ -0,0 +1,49 @@
+package chain
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mr-tron/base58/base58"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExternalTxSourceOrder(t *testing.T) {
+	hashes := []string{
+		base58.Encode([]byte("first tx hash")),
+		base58.Encode([]byte("second tx hash")),
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req builderRequest
+		assert.Equal(t, "/txs", r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		rsp := builderResponse{Hashes: hashes}
+		if req.BlockNo == 0 {
+			rsp = builderResponse{Error: "no block"}
+		}
+		json.NewEncoder(w).Encode(&rsp)
+	}))
+	defer server.Close()
+
+	src, err := NewExternalTxSource(server.URL)
+	assert.NoError(t, err)
+
+	order, err := src.order(10, 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("first tx hash"), order[0])
+	assert.Equal(t, []byte("second tx hash"), order[1])
+
+	_, err = src.order(0, 1024)
+	assert.EqualError(t, err, "no block")
+}
+
+func TestNewExternalTxSource(t *testing.T) {
+	_, err := NewExternalTxSource("unix:///tmp/builder.sock")
+	assert.NoError(t, err)
+	_, err = NewExternalTxSource("unix://")
+	assert.Error(t, err)
+	_, err = NewExternalTxSource("ftp://localhost")
+	assert.Error(t, err)
+}
@@ -158,6 +158,24 @@ type ChainConsensus interface {
 	Info() string
 }
 
+// SlotReporter is implemented by the consensus which assigns the time slots
+// to the BPs.
+type SlotReporter interface {
+	BPLister
+	// MissedBPs returns the IDs of the BPs in bps, which are elected for the
+	// block of ts, which did not produce a block in their slots between the
+	// block of prevTs and the one of ts.
+	MissedBPs(bps []types.PeerID, prevTs, ts int64) []types.PeerID
+}
+
+// BPLister is implemented by the consensus which can tell the BPs of a block
+// without executing it.
+type BPLister interface {
+	// BPs returns the IDs of the BPs allowed to produce the block of
+	// blockNo.
+	BPs(blockNo types.BlockNo) ([]types.PeerID, error)
+}
+
 type ChainConsensusCluster interface {
 	MakeConfChangeProposal(req *types.MembershipChange) (*ConfChangePropose, error)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/Cofresi/aergo-lib/log"
 	bc "github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/consensus/chain"
+	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
 	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/internal/enc"
 	"github.com/aergoio/aergo/pkg/component"
@@ -55,10 +56,13 @@ type BlockFactory struct {
 	privKey          crypto.PrivKey
 	txOp             chain.TxOp
 	sdb              *state.ChainStateDB
+	bpc              *bp.Cluster
+	status           *Status
 }
 
 // NewBlockFactory returns a new BlockFactory
-func NewBlockFactory(hub *component.ComponentHub, sdb *state.ChainStateDB, quitC <-chan interface{}) *BlockFactory {
+func NewBlockFactory(hub *component.ComponentHub, sdb *state.ChainStateDB, bpc *bp.Cluster, status *Status,
+	quitC <-chan interface{}) *BlockFactory {
 	bf := &BlockFactory{
 		ComponentHub:     hub,
 		jobQueue:         make(chan interface{}, slotQueueMax),
@@ -69,6 +73,8 @@ func NewBlockFactory(hub *component.ComponentHub, sdb *state.ChainStateDB, quitC
 		ID:               p2pkey.NodeSID(),
 		privKey:          p2pkey.NodePrivKey(),
 		sdb:              sdb,
+		bpc:              bpc,
+		status:           status,
 	}
 
 	bf.txOp = chain.NewCompTxOp(
@@ -215,6 +221,18 @@ func (bf *BlockFactory) generateBlock(bpi *bpInfo, lpbNo types.BlockNo) (block *
 
 	bs = bf.sdb.NewBlockState(bpi.bestBlock.GetHeader().GetBlocksRootHash())
 
+	// the slots are recorded from the V2 hardfork
+	if blockNo := bpi.bestBlock.BlockNo() + 1; types.IsV2Fork(blockNo) {
+		bps, err := bf.status.BPs(blockNo)
+		if err != nil {
+			return nil, nil, err
+		}
+		missed := missedBPs(bps, bpi.bestBlock.GetHeader().GetTimestamp(), ts)
+		if err = bc.RecordSlots(bs, bps, p2pkey.NodeID(), missed, blockNo); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	txOp := chain.NewCompTxOp(
 		bf.txOp,
 		newTxExec(contract.ChainAccessor(bpi.ChainDB), bpi.bestBlock.GetHeader().GetBlockNo()+1, ts, bpi.bestBlock.BlockHash(), bpi.bestBlock.GetHeader().ChainID),
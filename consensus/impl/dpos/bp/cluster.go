@@ -362,7 +362,7 @@ func (sn *Snapshots) AddSnapshot(refBlockNo types.BlockNo) ([]string, error) {
 		err error
 	)
 
-	if bps, err = sn.gatherRankers(); err != nil {
+	if bps, err = sn.gatherRankers(refBlockNo); err != nil {
 		return nil, err
 	}
 
@@ -379,8 +379,8 @@ func (sn *Snapshots) AddSnapshot(refBlockNo types.BlockNo) ([]string, error) {
 	return bps, nil
 }
 
-func (sn *Snapshots) gatherRankers() ([]string, error) {
-	return system.GetRankers(sn.sdb)
+func (sn *Snapshots) gatherRankers(refBlockNo types.BlockNo) ([]string, error) {
+	return system.GetRankers(sn.sdb, refBlockNo)
 }
 
 // UpdateCluster updates the current BP list by the ones corresponding to
@@ -479,18 +479,34 @@ func (sn *Snapshots) getCurrentCluster(blockNo types.BlockNo) ([]string, error)
 	return sn.loadClusterSnapshot(blockNo)
 }
 
+// BPs returns the IDs of the BPs of the snapshot corresponding to blockNo.
+func (sn *Snapshots) BPs(blockNo types.BlockNo) ([]types.PeerID, error) {
+	list, err := sn.getCurrentCluster(blockNo)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]types.PeerID, len(list))
+	for i, id := range list {
+		if ids[i], err = types.IDB58Decode(id); err != nil {
+			return nil, fmt.Errorf("invalid node ID[%d]: %s", i, err.Error())
+		}
+	}
+	return ids, nil
+}
+
 func (sn *Snapshots) loadClusterSnapshot(blockNo types.BlockNo) ([]string, error) {
 	var (
 		block *types.Block
 		err   error
 	)
 
-	block, err = sn.cdb.GetBlockByNo(snapBlockNo(blockNo))
+	refBlockNo := snapBlockNo(blockNo)
+	block, err = sn.cdb.GetBlockByNo(refBlockNo)
 	if err != nil {
 		return nil, err
 	}
 
 	stateDB := sn.sdb.OpenNewStateDB(block.GetHeader().GetBlocksRootHash())
 
-	return system.GetRankers(stateDB)
+	return system.GetRankers(stateDB, refBlockNo)
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/consensus/impl/dpos/bp"
 	"github.com/aergoio/aergo/consensus/impl/dpos/slot"
+	"github.com/aergoio/aergo/contract/system"
 	"github.com/aergoio/aergo/pkg/component"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
@@ -57,6 +58,7 @@ type DPoS struct {
 	*component.ComponentHub
 	bpc  *bp.Cluster
 	bf   *BlockFactory
+	sdb  *state.ChainStateDB
 	quit chan interface{}
 }
 
@@ -100,13 +102,15 @@ func New(cfg *config.Config, hub *component.ComponentHub, cdb consensus.ChainDB,
 	Init(bpc.Size())
 
 	quitC := make(chan interface{})
+	status := NewStatus(bpc, cdb, sdb, cfg.Blockchain.ForceResetHeight)
 
 	return &DPoS{
-		Status:       NewStatus(bpc, cdb, sdb, cfg.Blockchain.ForceResetHeight),
+		Status:       status,
 		ComponentHub: hub,
 		ChainDB:      cdb,
 		bpc:          bpc,
-		bf:           NewBlockFactory(hub, sdb, quitC),
+		bf:           NewBlockFactory(hub, sdb, bpc, status, quitC),
+		sdb:          sdb,
 		quit:         quitC,
 	}, nil
 }
@@ -219,6 +223,25 @@ func (dpos *DPoS) IsBlockValid(block *types.Block, bestBlock *types.Block) error
 	return nil
 }
 
+// MissedBPs returns the IDs of the BPs in bps which did not produce a block in
+// their slots between the block of prevTs and the one of ts.
+func (dpos *DPoS) MissedBPs(bps []types.PeerID, prevTs, ts int64) []types.PeerID {
+	return missedBPs(bps, prevTs, ts)
+}
+
+// missedBPs uses the BP list of the election of the block of ts rather than
+// the current cluster, so that the producer and the verifiers of the block
+// agree on it.
+func missedBPs(bps []types.PeerID, prevTs, ts int64) []types.PeerID {
+	var missed []types.PeerID
+	for _, idx := range slot.BpIndexesBetween(slot.NewFromUnixNano(prevTs), slot.NewFromUnixNano(ts), uint16(len(bps))) {
+		if int(idx) < len(bps) {
+			missed = append(missed, bps[idx])
+		}
+	}
+	return missed
+}
+
 func (dpos *DPoS) bpIdx() bp.Index {
 	return dpos.bpc.BpID2Index(dpos.bpid())
 }
@@ -265,6 +288,20 @@ func (dpos *DPoS) ConsensusInfo() *types.ConsensusInfo {
 
 	})
 
+	type lpbInfo struct {
+		BPID      string
+		Height    types.BlockNo
+		Hash      string
+		Timestamp string
+	}
+	s := struct {
+		NodeID              string
+		RecentBlockProduced *lpbInfo `json:",omitempty"`
+		Penalties           []*system.Penalty
+	}{
+		NodeID: dpos.bf.ID,
+	}
+
 	if dpos.done {
 		var lpbNo types.BlockNo
 
@@ -274,31 +311,28 @@ func (dpos *DPoS) ConsensusInfo() *types.ConsensusInfo {
 
 		if lpbNo > 0 {
 			if block, err := dpos.GetBlockByNo(lpbNo); err == nil {
-				type lpbInfo struct {
-					BPID      string
-					Height    types.BlockNo
-					Hash      string
-					Timestamp string
-				}
-				s := struct {
-					NodeID              string
-					RecentBlockProduced lpbInfo
-				}{
-					NodeID: dpos.bf.ID,
-					RecentBlockProduced: lpbInfo{
-						BPID:      block.BPID2Str(),
-						Height:    lpbNo,
-						Hash:      block.ID(),
-						Timestamp: block.Localtime().String(),
-					},
-				}
-				if m, err := json.Marshal(s); err == nil {
-					ci.Info = string(m)
+				s.RecentBlockProduced = &lpbInfo{
+					BPID:      block.BPID2Str(),
+					Height:    lpbNo,
+					Hash:      block.ID(),
+					Timestamp: block.Localtime().String(),
 				}
 			}
 		}
 	}
 
+	if scs, err := dpos.sdb.GetSystemAccountState(); err == nil {
+		if penalties, err := system.GetPenalties(scs); err == nil {
+			s.Penalties = penalties
+		} else {
+			logger.Debug().Err(err).Msg("failed to get the penalties of the BPs")
+		}
+	}
+
+	if m, err := json.Marshal(s); err == nil {
+		ci.Info = string(m)
+	}
+
 	return ci
 }
 
@@ -330,6 +364,14 @@ func isBpTiming(block *types.Block, s *slot.Slot) bool {
 	return true
 }
 
+// LibNo returns the block number of the last irreversible block (LIB).
+func (dpos *DPoS) LibNo() types.BlockNo {
+	if lib := dpos.lib(); lib != nil {
+		return lib.BlockNo
+	}
+	return 0
+}
+
 func (dpos *DPoS) NeedNotify() bool {
 	return true
 }
@@ -134,6 +134,20 @@ func (s *Slot) NextBpIndex(bpCount uint16) int64 {
 	return s.nextIndex % int64(bpCount)
 }
 
+// BpIndexesBetween returns the BP indexes of the slots between s1 and s2,
+// excluding both. Since each BP has a slot every bpCount slots, at most
+// bpCount indexes are returned.
+func BpIndexesBetween(s1, s2 *Slot, bpCount uint16) []int64 {
+	if s1 == nil || s2 == nil || bpCount == 0 {
+		return nil
+	}
+	var indexes []int64
+	for i := s1.nextIndex + 1; i < s2.nextIndex && len(indexes) < int(bpCount); i++ {
+		indexes = append(indexes, i%int64(bpCount))
+	}
+	return indexes
+}
+
 func msToPrevIndex(ms int64) int64 {
 	return msToIndex(ms)
 }
@@ -59,3 +59,14 @@ func TestSlotFuture(t *testing.T) {
 	assert.True(t, Time(time.Now().Add(2*time.Second)).IsFuture(), "must be a future slot")
 	assert.True(t, Time(time.Now().Add(3*time.Second)).IsFuture(), "must be a future slot")
 }
+
+func TestBpIndexesBetween(t *testing.T) {
+	Init(bpInterval)
+
+	base := time.Unix(1000, 0)
+	prev := Time(base)
+	assert.Empty(t, BpIndexesBetween(prev, Time(base.Add(time.Second)), nSlots), "no slot missed")
+	assert.Equal(t, []int64{1, 2}, BpIndexesBetween(prev, Time(base.Add(3*time.Second)), nSlots))
+	assert.Len(t, BpIndexesBetween(prev, Time(base.Add(100*time.Second)), nSlots), nSlots,
+		"each BP is counted once")
+}
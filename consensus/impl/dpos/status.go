@@ -19,13 +19,18 @@ type Status struct {
 	bestBlock *types.Block
 	libState  *libStatus
 	bps       *bp.Snapshots
+	cluster   bp.ClusterMember
 }
 
 // NewStatus returns a newly allocated Status.
 func NewStatus(c bp.ClusterMember, cdb consensus.ChainDB, sdb *state.ChainStateDB, resetHeight types.BlockNo) *Status {
+	// The BP snapshots are loaded first since the BP count may have been
+	// changed by the votes.
+	bps := bp.NewSnapshots(c, cdb, sdb)
 	s := &Status{
 		libState: newLibStatus(consensusBlockCount(c.Size())),
-		bps:      bp.NewSnapshots(c, cdb, sdb),
+		bps:      bps,
+		cluster:  c,
 	}
 	s.init(cdb, resetHeight)
 
@@ -79,6 +84,7 @@ func (s *Status) Update(block *types.Block) {
 		}
 
 		s.bps.AddSnapshot(block.BlockNo())
+		s.updateConfirmsRequired()
 	} else {
 		// Rollback resulting from a reorganization.
 		logger.Debug().
@@ -94,6 +100,7 @@ func (s *Status) Update(block *types.Block) {
 
 		// Rollback BP list. -- BP list is alos affected by a fork.
 		s.bps.UpdateCluster(block.BlockNo())
+		s.updateConfirmsRequired()
 	}
 
 	s.libState.gc()
@@ -101,6 +108,24 @@ func (s *Status) Update(block *types.Block) {
 	s.bestBlock = block
 }
 
+// BPs returns the IDs of the BPs elected for the block of blockNo.
+func (s *Status) BPs(blockNo types.BlockNo) ([]types.PeerID, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.bps.BPs(blockNo)
+}
+
+// updateConfirmsRequired adjusts the number of the confirmations for a LIB to
+// the size of the BP cluster, which follows the BP count decided by the votes.
+func (s *Status) updateConfirmsRequired() {
+	if confirms := consensusBlockCount(s.cluster.Size()); confirms != s.libState.confirmsRequired {
+		logger.Info().Uint16("from", s.libState.confirmsRequired).Uint16("to", confirms).
+			Msg("number of confirmations for LIB changed")
+		s.libState.confirmsRequired = confirms
+	}
+}
+
 func (s *Status) libNo() types.BlockNo {
 	s.RLock()
 	defer s.RUnlock()
@@ -6,9 +6,12 @@
 package impl
 
 import (
+	"fmt"
+
 	"github.com/aergoio/aergo/chain"
 	"github.com/aergoio/aergo/config"
 	"github.com/aergoio/aergo/consensus"
+	cchain "github.com/aergoio/aergo/consensus/chain"
 	"github.com/aergoio/aergo/consensus/impl/dpos"
 	"github.com/aergoio/aergo/consensus/impl/raftv2"
 	"github.com/aergoio/aergo/consensus/impl/sbp"
@@ -37,6 +40,14 @@ func New(cfg *config.Config, hub *component.ComponentHub, cs *chain.ChainService
 
 	consensus.InitBlockInterval(blockInterval)
 
+	if cfg.Consensus.TxSource != "" {
+		src, err := cchain.NewExternalTxSource(cfg.Consensus.TxSource)
+		if err != nil {
+			return nil, err
+		}
+		consensus.SetTxSource(src)
+	}
+
 	if c, err = newConsensus(cfg, hub, cs, p2psvc.GetPeerAccessor()); err == nil {
 		// Link mutual references.
 		cs.SetChainConsensus(c)
@@ -47,6 +58,28 @@ func New(cfg *config.Config, hub *component.ComponentHub, cs *chain.ChainService
 	return c, err
 }
 
+// NewOffline returns consensus.Consensus linked only to cs, which verifies the
+// blocks added without the other services, like the ones imported from a
+// chain archive. It never produces blocks.
+func NewOffline(cfg *config.Config, cs *chain.ChainService) (consensus.Consensus, error) {
+	name := cs.CDB().GetGenesisInfo().ConsensusType()
+	if name == raftv2.GetName() {
+		return nil, fmt.Errorf("blocks of %s can not be verified offline", name)
+	}
+
+	if chain.IsPublic() {
+		consensus.InitBlockInterval(1)
+	} else {
+		consensus.InitBlockInterval(cfg.Consensus.BlockInterval)
+	}
+
+	c, err := newConsensus(cfg, component.NewComponentHub(), cs, nil)
+	if err == nil {
+		cs.SetChainConsensus(c)
+	}
+	return c, err
+}
+
 func newConsensus(cfg *config.Config, hub *component.ComponentHub,
 	cs *chain.ChainService, pa p2pcommon.PeerAccessor) (consensus.Consensus, error) {
 	cdb := cs.CDB()
@@ -65,6 +98,9 @@ func newConsensus(cfg *config.Config, hub *component.ComponentHub,
 type GenesisValidator func(genesis *types.Genesis) error
 
 func ValidateGenesis(genesis *types.Genesis) error {
+	if err := genesis.Validate(); err != nil {
+		return err
+	}
 	name := strings.ToLower(genesis.ConsensusType())
 
 	validators := map[string]GenesisValidator{
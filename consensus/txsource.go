@@ -0,0 +1,33 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package consensus
+
+import (
+	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/types"
+)
+
+// TxSource supplies the candidate transactions of a new block in the order
+// which the block factory tries them. The block factory still executes every
+// candidate and stops at the block size and time limits, so a source only
+// decides the order.
+type TxSource interface {
+	Txs(hs component.ICompSyncRequester, blockNo types.BlockNo, maxBlockBodySize uint32) ([]types.Transaction, error)
+}
+
+// curTxSource is the TxSource of the block factories. The block factories
+// take the transactions from the mempool if it is nil.
+var curTxSource TxSource
+
+// SetTxSource sets the TxSource of the block factories.
+func SetTxSource(s TxSource) {
+	curTxSource = s
+}
+
+// GetTxSource returns the TxSource of the block factories.
+func GetTxSource() TxSource {
+	return curTxSource
+}
@@ -54,11 +54,29 @@ func SetPreloadTx(tx *types.Tx, service int) {
 }
 
 func Execute(bs *state.BlockState, cdb ChainAccessor, tx *types.Tx, blockNo uint64, ts int64, prevBlockHash []byte,
-	sender, receiver *state.V, preLoadService int) (rv string, events []*types.Event, usedFee *big.Int, err error) {
+	sender, receiver *state.V, preLoadService int) (rv string, events []*types.Event, usedFee *big.Int, gasUsed uint64, err error) {
 
 	txBody := tx.GetBody()
 
-	usedFee = fee.PayloadTxFee(len(txBody.GetPayload()))
+	// every transaction is metered from the V2 hardfork
+	var limit uint64
+	if types.IsV2Fork(blockNo) {
+		balance := new(big.Int).Sub(sender.Balance(), txBody.GetAmountBigInt())
+		if txBody.Type == types.TxType_FEEDELEGATION {
+			balance = receiver.Balance()
+		}
+		limit = txGasLimit(txBody, balance)
+		gasUsed = intrinsicGas(txBody)
+		if gasUsed >= limit {
+			gasUsed = limit
+			usedFee = gasFee(txBody, gasUsed)
+			err = newVmError(errNotEnoughGas)
+			return
+		}
+		usedFee = gasFee(txBody, gasUsed)
+	} else {
+		usedFee = fee.PayloadTxFee(len(txBody.GetPayload()))
+	}
 
 	// Transfer balance
 	if sender.AccountID() != receiver.AccountID() {
@@ -104,37 +122,49 @@ func Execute(bs *state.BlockState, cdb ChainAccessor, tx *types.Tx, blockNo uint
 	}
 
 	var cFee *big.Int
+	var stateSet *StateSet
 	if ex != nil {
+		stateSet = ex.stateSet
+		stateSet.setGasLimit(txBody, limit)
+		ex.setCountHook(stateSet.instLimit())
 		rv, events, cFee, err = PreCall(ex, bs, sender, contractState, blockNo, ts, receiver.RP(), prevBlockHash)
 	} else {
-		stateSet := NewContext(bs, cdb, sender, receiver, contractState, sender.ID(),
+		stateSet = NewContext(bs, cdb, sender, receiver, contractState, sender.ID(),
 			tx.GetHash(), blockNo, ts, prevBlockHash, "", true,
 			false, receiver.RP(), preLoadService, txBody.GetAmountBigInt())
+		stateSet.setGasLimit(txBody, limit)
 		if stateSet.traceFile != nil {
 			defer stateSet.traceFile.Close()
 		}
-		if receiver.IsDeploy() {
+		if receiver.IsRedeploy() && types.IsV2Fork(blockNo) {
+			rv, events, cFee, err = Upgrade(contractState, txBody.Payload, receiver.ID(), stateSet)
+		} else if receiver.IsDeploy() {
 			rv, events, cFee, err = Create(contractState, txBody.Payload, receiver.ID(), stateSet)
 		} else {
 			rv, events, cFee, err = Call(contractState, txBody.Payload, receiver.ID(), stateSet)
 		}
 	}
 
-	usedFee.Add(usedFee, cFee)
+	if stateSet.isMetered() {
+		gasUsed += stateSet.gasUsed
+		usedFee = gasFee(txBody, gasUsed)
+	} else {
+		usedFee.Add(usedFee, cFee)
+	}
 
 	if err != nil {
 		if isSystemError(err) {
-			return "", events, usedFee, err
+			return "", events, usedFee, gasUsed, err
 		}
-		return "", events, usedFee, newVmError(err)
+		return "", events, usedFee, gasUsed, newVmError(err)
 	}
 
 	err = bs.StageContractState(contractState)
 	if err != nil {
-		return "", events, usedFee, err
+		return "", events, usedFee, gasUsed, err
 	}
 
-	return rv, events, usedFee, nil
+	return rv, events, usedFee, gasUsed, nil
 }
 
 func PreLoadRequest(bs *state.BlockState, next, current *types.Tx, preLoadService int) {
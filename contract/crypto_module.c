@@ -10,7 +10,7 @@ static int crypto_sha256(lua_State *L)
     luaL_checktype(L, 1, LUA_TSTRING);
     arg = (char *)lua_tolstring(L, 1, &len);
 
-    ret = LuaCryptoSha256(L, arg, len);
+    ret = LuaCryptoSha256(L, vm_exec_context(L), arg, len);
     if (ret.r1 < 0) {
         strPushAndRelease(L, ret.r1);
         lua_error(L);
@@ -62,7 +62,7 @@ static int crypto_verifyProof(lua_State *L)
     for (i = proofIndex; i <= argc; ++i) {
         proof[i-proofIndex].data = (char *)lua_tolstring(L, i, &proof[i-proofIndex].len);
     }
-    b = LuaCryptoVerifyProof(k, kLen, v, vLen, h, hLen, proof, nProof);
+    b = LuaCryptoVerifyProof(L, vm_exec_context(L), k, kLen, v, vLen, h, hLen, proof, nProof);
     free(proof);
     lua_pushboolean(L, b);
     return 1;
@@ -77,7 +77,7 @@ static int crypto_keccak256(lua_State *L)
     luaL_checktype(L, 1, LUA_TSTRING);
     arg = (char *)lua_tolstring(L, 1, &len);
 
-    ret = LuaCryptoKeccak256(arg, len);
+    ret = LuaCryptoKeccak256(L, vm_exec_context(L), arg, len);
     lua_pushlstring(L, ret.r0, ret.r1);
     free(ret.r0);
 	return 1;
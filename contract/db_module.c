@@ -18,6 +18,8 @@
 #define RESOURCE_RS_KEY "_RESOURCE_RS_KEY_"
 
 extern const int *getLuaExecContext(lua_State *L);
+extern void LuaSqlStmtGas(lua_State *L, int *service);
+extern void LuaSqlRowGas(lua_State *L, int *service);
 
 static int append_resource(lua_State *L, const char *key, void *data)
 {
@@ -179,6 +181,7 @@ static int db_rs_next(lua_State *L)
     db_rs_t *rs = get_db_rs(L, 1);
     int rc;
 
+    LuaSqlRowGas(L, vm_exec_context(L));
     rc = sqlite3_step(rs->s);
     if (rc == SQLITE_DONE) {
         db_rs_close(L, rs, 1);
@@ -312,9 +315,11 @@ static int db_pstmt_exec(lua_State *L)
 {
     int rc, n;
     db_pstmt_t *pstmt = get_db_pstmt(L, 1);
+    int *service;
 
     /*check for exec in function */
-	getLuaExecContext(L);
+	service = (int *)getLuaExecContext(L);
+    LuaSqlStmtGas(L, service);
 
     rc = bind(L, pstmt->db, pstmt->s);
     if (rc == -1) {
@@ -338,8 +343,10 @@ static int db_pstmt_query(lua_State *L)
     int rc;
     db_pstmt_t *pstmt = get_db_pstmt(L, 1);
     db_rs_t *rs;
+    int *service;
 
-	getLuaExecContext(L);
+	service = (int *)getLuaExecContext(L);
+    LuaSqlStmtGas(L, service);
     if (!sqlite3_stmt_readonly(pstmt->s)) {
         luaL_error(L, "invalid sql command(permitted readonly)");
     }
@@ -391,9 +398,11 @@ static int db_exec(lua_State *L)
     sqlite3 *db;
     sqlite3_stmt *s;
     int rc;
+    int *service;
 
     /*check for exec in function */
-	getLuaExecContext(L);
+	service = (int *)getLuaExecContext(L);
+    LuaSqlStmtGas(L, service);
     cmd = luaL_checkstring(L, 1);
     if (!sqlcheck_is_permitted_sql(cmd)) {
         luaL_error(L, "invalid sql command");
@@ -426,8 +435,10 @@ static int db_query(lua_State *L)
     sqlite3 *db;
     sqlite3_stmt *s;
     db_rs_t *rs;
+    int *service;
 
-	getLuaExecContext(L);
+	service = (int *)getLuaExecContext(L);
+    LuaSqlStmtGas(L, service);
     query = luaL_checkstring(L, 1);
     if (!sqlcheck_is_readonly_sql(query)) {
         luaL_error(L, "invalid sql command(permitted readonly)");
@@ -0,0 +1,164 @@
+package contract
+
+/*
+#include "vm.h"
+*/
+import "C"
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/types"
+)
+
+// Gas schedule. A unit of gas is one Lua instruction, so that the charges
+// of the builtins below are deducted from the same budget as the opcodes.
+// The schedule applies to every transaction from the V2 hardfork; the
+// transactions before it keep the instruction limit and the size based fee.
+const (
+	gasTxBase         = 20000
+	gasPayloadByte    = 10
+	gasStateRead      = 200
+	gasStateWrite     = 5000
+	gasStateWriteByte = 10
+	gasStateDelete    = 1000
+	gasIndexKey       = 50
+	gasSqlStmt        = 1000
+	gasSqlRow         = 100
+	gasHash           = 300
+	gasHashByte       = 5
+	gasProofNode      = 1000
+	gasEvent          = 500
+	gasEventByte      = 10
+)
+
+var errNotEnoughGas = errors.New("not enough gas")
+
+// intrinsicGas returns the gas charged for a transaction before any contract
+// runs.
+func intrinsicGas(txBody *types.TxBody) uint64 {
+	return gasTxBase + uint64(len(txBody.GetPayload()))*gasPayloadByte
+}
+
+// txGasLimit returns the gas limit of txBody. A transaction without a gas
+// limit may use as much gas as balance, which is left to the fee payer after
+// the amount is sent, pays for, up to the instruction limit of the unmetered
+// transactions.
+func txGasLimit(txBody *types.TxBody, balance *big.Int) uint64 {
+	if limit := txBody.GetGasLimit(); limit > 0 {
+		return limit
+	}
+	limit := intrinsicGas(txBody) + uint64(callMaxInstLimit)
+	price := txBody.GetGasPriceBigInt()
+	if fee.IsZeroFee() || price.Sign() == 0 {
+		return limit
+	}
+	if balance.Sign() <= 0 {
+		return 0
+	}
+	affordable := new(big.Int).Div(balance, price)
+	if affordable.IsUint64() && affordable.Uint64() < limit {
+		return affordable.Uint64()
+	}
+	return limit
+}
+
+// setGasLimit sets the gas limit of the contract execution of txBody to
+// limit, from which the intrinsic gas is reserved in advance. A limit of 0
+// leaves the execution unmetered.
+func (s *StateSet) setGasLimit(txBody *types.TxBody, limit uint64) {
+	if iGas := intrinsicGas(txBody); limit > iGas {
+		s.gasLimit = limit - iGas
+	} else {
+		s.gasLimit = 0
+	}
+}
+
+func (s *StateSet) isMetered() bool {
+	return s.gasLimit > 0
+}
+
+// instLimit returns the instruction limit of the top level call.
+func (s *StateSet) instLimit() C.int {
+	if !s.isMetered() {
+		return callMaxInstLimit
+	}
+	if s.gasLimit > math.MaxInt32 {
+		return C.int(math.MaxInt32)
+	}
+	return C.int(s.gasLimit)
+}
+
+// setGasUsed records the gas used by the top level call ce which started
+// with limit instructions.
+func (s *StateSet) setGasUsed(ce *Executor, limit C.int) {
+	if !s.isMetered() || ce == nil || ce.L == nil {
+		return
+	}
+	remain := C.luaL_instcount(ce.L)
+	if remain < 0 || remain > limit {
+		remain = 0
+	}
+	s.gasUsed = uint64(limit - remain)
+}
+
+// useGas deducts gas from the instruction budget of L.
+func useGas(L *LState, s *StateSet, gas int) {
+	if s == nil || !s.isMetered() || gas <= 0 {
+		return
+	}
+	if gas > math.MaxInt32 {
+		gas = math.MaxInt32
+	}
+	setInstMinusCount(L, C.int(gas))
+}
+
+// execStateSet returns the state set of service, or nil outside of a
+// function call.
+func execStateSet(service *C.int) *StateSet {
+	if service == nil || *service < 0 {
+		return nil
+	}
+	return curStateSet[*service]
+}
+
+//export LuaSqlStmtGas
+func LuaSqlStmtGas(L *LState, service *C.int) {
+	useGas(L, execStateSet(service), gasSqlStmt)
+}
+
+//export LuaSqlRowGas
+func LuaSqlRowGas(L *LState, service *C.int) {
+	useGas(L, execStateSet(service), gasSqlRow)
+}
+
+// callInstLimit returns the instruction limit of a contract called from L. The
+// gas option of the call caps the limit of a metered transaction.
+func callInstLimit(L *LState, s *StateSet, gas uint64) C.int {
+	limit := minusCallCount(C.luaL_instcount(L), luaCallCountDeduc)
+	if s.isMetered() && gas > 0 && gas < uint64(limit) {
+		limit = C.int(gas)
+	}
+	return limit
+}
+
+// setCallInstCount charges the instructions used by child, which was called
+// with limit instructions, to parent.
+func setCallInstCount(parent *LState, child *LState, limit C.int, s *StateSet) {
+	if !s.isMetered() {
+		setInstCount(parent, child)
+		return
+	}
+	used := limit - C.luaL_instcount(child)
+	C.luaL_setinstcount(parent, minusCallCount(C.luaL_instcount(parent), luaCallCountDeduc+used))
+}
+
+// gasFee returns the fee of gasUsed at the gas price of txBody.
+func gasFee(txBody *types.TxBody, gasUsed uint64) *big.Int {
+	if fee.IsZeroFee() {
+		return zeroFee
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), txBody.GetGasPriceBigInt())
+}
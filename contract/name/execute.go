@@ -16,7 +16,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 
 	systemContractState, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID([]byte(types.AergoSystem)))
 
-	ci, err := ValidateNameTx(txBody, sender, scs, systemContractState)
+	ci, err := ValidateNameTx(txBody, sender, scs, systemContractState, blockNo)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +39,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 	switch ci.Name {
 	case types.NameCreate:
 		if err = CreateName(scs, txBody, sender, nameState,
-			ci.Args[0].(string)); err != nil {
+			ci.Args[0].(string), blockNo); err != nil {
 			return nil, err
 		}
 		events = append(events, &types.Event{
@@ -50,7 +50,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 		})
 	case types.NameUpdate:
 		if err = UpdateName(bs, scs, txBody, sender, nameState,
-			ci.Args[0].(string), ci.Args[1].(string)); err != nil {
+			ci.Args[0].(string), ci.Args[1].(string), blockNo); err != nil {
 			return nil, err
 		}
 		events = append(events, &types.Event{
@@ -60,6 +60,28 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 			JsonArgs: `{"name":"` + ci.Args[0].(string) +
 				`","to":"` + ci.Args[1].(string) + `"}`,
 		})
+	case types.NameRenew:
+		if err = RenewName(scs, txBody, sender, nameState,
+			ci.Args[0].(string), blockNo); err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "renew name",
+			JsonArgs:        `{"name":"` + ci.Args[0].(string) + `"}`,
+		})
+	case types.NameSetPrimary:
+		if err = SetPrimaryName(scs, sender, ci.Args[0].(string)); err != nil {
+			return nil, err
+		}
+		events = append(events, &types.Event{
+			ContractAddress: receiver.ID(),
+			EventIdx:        0,
+			EventName:       "set primary name",
+			JsonArgs: `{"name":"` + ci.Args[0].(string) +
+				`","address":"` + types.EncodeAddress(sender.ID()) + `"}`,
+		})
 	case types.SetContractOwner:
 		ownerState, err := SetContractOwner(bs, scs, ci.Args[0].(string), nameState)
 		if err != nil {
@@ -72,7 +94,7 @@ func ExecuteNameTx(bs *state.BlockState, scs *state.ContractState, txBody *types
 }
 
 func ValidateNameTx(tx *types.TxBody, sender *state.V,
-	scs, systemcs *state.ContractState) (*types.CallInfo, error) {
+	scs, systemcs *state.ContractState, blockNo types.BlockNo) (*types.CallInfo, error) {
 	if sender != nil && sender.Balance().Cmp(tx.GetAmountBigInt()) < 0 {
 		return nil, types.ErrInsufficientBalance
 	}
@@ -81,25 +103,67 @@ func ValidateNameTx(tx *types.TxBody, sender *state.V,
 		return nil, err
 	}
 	name := ci.Args[0].(string)
+	// the subdomains, the renewal and the primary names are accepted from the
+	// V2 hardfork
+	if !types.IsV2Fork(blockNo) &&
+		(isSubdomain([]byte(name)) || ci.Name == types.NameRenew || ci.Name == types.NameSetPrimary) {
+		return nil, errors.New("could not execute unknown cmd")
+	}
 	switch ci.Name {
 	case types.NameCreate:
-		namePrice := system.GetNamePrice(systemcs)
+		if isSubdomain([]byte(name)) {
+			// a subdomain is created, or taken back, by the owner of its parent
+			parent := parentOf([]byte(name))
+			if !isController(scs, tx.Account, parent) {
+				return nil, fmt.Errorf("owner not matched : %s", string(parent))
+			}
+			if isExpired(scs, parent, blockNo) {
+				return nil, fmt.Errorf("expired name %s", string(parent))
+			}
+			break
+		}
+		namePrice := system.GetNamePrice(systemcs, blockNo)
 		if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
 			return nil, types.ErrTooSmallAmount
 		}
 		owner := getOwner(scs, []byte(name), false)
-		if owner != nil {
+		if owner != nil && !isExpired(scs, []byte(name), blockNo) {
 			return nil, fmt.Errorf("aleady occupied %s", string(name))
 		}
 	case types.NameUpdate:
-		namePrice := system.GetNamePrice(systemcs)
+		namePrice := system.GetNamePrice(systemcs, blockNo)
 		if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
 			return nil, types.ErrTooSmallAmount
 		}
 		if (!bytes.Equal(tx.Account, []byte(name))) &&
-			(!bytes.Equal(tx.Account, getOwner(scs, []byte(name), false))) {
+			(!isController(scs, tx.Account, []byte(name))) {
 			return nil, fmt.Errorf("owner not matched : %s", name)
 		}
+		if isExpired(scs, []byte(name), blockNo) {
+			return nil, fmt.Errorf("expired name %s", name)
+		}
+	case types.NameRenew:
+		if isSubdomain([]byte(name)) {
+			return nil, fmt.Errorf("subdomain %s expires with its parent", name)
+		}
+		namePrice := system.GetNamePrice(systemcs, blockNo)
+		if namePrice.Cmp(tx.GetAmountBigInt()) > 0 {
+			return nil, types.ErrTooSmallAmount
+		}
+		nameMap := getNameMap(scs, []byte(name), false)
+		if nameMap == nil || !bytes.Equal(tx.Account, nameMap.Owner) {
+			return nil, fmt.Errorf("owner not matched : %s", name)
+		}
+		if nameMap.ExpireAt == 0 {
+			return nil, fmt.Errorf("%s never expires", name)
+		}
+	case types.NameSetPrimary:
+		if isExpired(scs, []byte(name), blockNo) {
+			return nil, fmt.Errorf("expired name %s", name)
+		}
+		if !bytes.Equal(tx.Account, getAddress(scs, []byte(name), blockNo)) {
+			return nil, fmt.Errorf("%s is not resolved to the sender", name)
+		}
 	case types.SetContractOwner:
 		owner := getOwner(scs, []byte(types.AergoName), false)
 		if owner != nil {
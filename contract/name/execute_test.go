@@ -38,7 +38,7 @@ func TestExcuteNameTx(t *testing.T) {
 	commitContractState(t, bs, scs)
 	scs = openContractState(t, bs)
 
-	ret := GetAddress(scs, []byte(name))
+	ret := GetAddress(scs, []byte(name), 1)
 	assert.Equal(t, txBody.Account, ret, "pubkey address")
 	ret = GetOwner(scs, []byte(name))
 	assert.Equal(t, txBody.Account, ret, "pubkey owner")
@@ -54,7 +54,7 @@ func TestExcuteNameTx(t *testing.T) {
 	commitContractState(t, bs, scs)
 	scs = openContractState(t, bs)
 
-	ret = GetAddress(scs, []byte(name))
+	ret = GetAddress(scs, []byte(name), 2)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "pubkey address")
 	ret = GetOwner(scs, []byte(name))
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "pubkey owner")
@@ -115,3 +115,132 @@ func nextBlockContractState(t *testing.T, bs *state.BlockState, scs *state.Contr
 	commitContractState(t, bs, scs)
 	return openContractState(t, bs)
 }
+
+func TestSubdomainNameTx(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	owner := types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	other := types.ToAddress("AmNHAxiGbZJjKjdGGNj2NBoAXGwdzX9Bg59eqbek9n49JpiaZ3As")
+	buyer := types.ToAddress("AmMSMkVHQ6qRVA7G7rqwjvv2NBwB48tTekJ2jFMrjfZrsofePgay")
+	name := "AB1234567890"
+	subdomain := "pay.ab1234567890"
+
+	ownerState, _ := sdb.GetStateDB().GetAccountStateV(owner)
+	ownerState.AddBalance(types.MaxAER)
+	otherState, _ := sdb.GetStateDB().GetAccountStateV(other)
+	otherState.AddBalance(types.MaxAER)
+	buyerState, _ := sdb.GetStateDB().GetAccountStateV(buyer)
+	receiver, _ := sdb.GetStateDB().GetAccountStateV([]byte(types.AergoName))
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+
+	execute := func(sender *state.V, payload []byte, amount []byte, blockNo types.BlockNo) error {
+		txBody := &types.TxBody{Account: sender.ID(), Recipient: []byte(types.AergoName),
+			Amount: amount, Payload: payload}
+		_, err := ExecuteNameTx(bs, scs, txBody, sender, receiver, blockNo)
+		return err
+	}
+	price := types.NamePrice.Bytes()
+
+	err := execute(ownerState, buildNamePayload(name, types.NameCreate, ""), price, 1)
+	assert.NoError(t, err, "create name")
+	scs = nextBlockContractState(t, bs, scs)
+
+	err = execute(otherState, buildNamePayload(subdomain, types.NameCreate, ""), nil, 2)
+	assert.Error(t, err, "only the owner of the parent creates a subdomain")
+	err = execute(ownerState, buildNamePayload(subdomain, types.NameCreate, ""), nil, 2)
+	assert.NoError(t, err, "create subdomain")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, owner, GetAddress(scs, []byte(subdomain), 3), "resolve subdomain")
+	assert.Equal(t, []byte(types.AergoSystem), GetAddress(scs, []byte(types.AergoSystem), 3),
+		"governance contract is not a registered name")
+
+	err = execute(ownerState, buildNamePayload(subdomain, types.NameUpdate, types.EncodeAddress(buyer)), price, 3)
+	assert.NoError(t, err, "update subdomain")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, buyer, GetOwner(scs, []byte(subdomain)), "owner of subdomain")
+
+	// reverse resolution
+	err = execute(ownerState, buildNamePayload(subdomain, types.NameSetPrimary, ""), nil, 4)
+	assert.Error(t, err, "the name is not resolved to the sender")
+	err = execute(buyerState, buildNamePayload(subdomain, types.NameSetPrimary, ""), nil, 4)
+	assert.NoError(t, err, "set primary name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, subdomain, GetPrimaryName(scs, buyer, 5), "primary name")
+	assert.Equal(t, "", GetPrimaryName(scs, owner, 5), "no primary name")
+
+	// expiry and renewal
+	expireAt := 1 + RegistrationPeriod()
+	info := getNameInfo(scs, subdomain, 5)
+	assert.Equal(t, expireAt, info.ExpireAt, "subdomain expires with its parent")
+	err = execute(otherState, buildNamePayload(name, types.NameRenew, ""), price, 5)
+	assert.Error(t, err, "only the owner renews the name")
+	err = execute(ownerState, buildNamePayload(name, types.NameRenew, ""), price, 5)
+	assert.NoError(t, err, "renew name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, expireAt+RegistrationPeriod(), getNameInfo(scs, name, 6).ExpireAt)
+
+	err = execute(otherState, buildNamePayload(name, types.NameCreate, ""), price, expireAt)
+	assert.Error(t, err, "the renewed name is occupied")
+	expired := expireAt + RegistrationPeriod()
+	assert.Equal(t, owner, GetAddress(scs, []byte(name), expired-1))
+	assert.Nil(t, GetAddress(scs, []byte(name), expired), "the expired name is not resolved")
+	assert.Nil(t, GetAddress(scs, []byte(subdomain), expired), "the subdomain expires with its parent")
+	assert.Equal(t, "", GetPrimaryName(scs, buyer, expired), "the expired primary name is ignored")
+
+	err = execute(otherState, buildNamePayload(name, types.NameCreate, ""), price, expired)
+	assert.NoError(t, err, "create the expired name")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, other, GetOwner(scs, []byte(name)), "new owner")
+	assert.Nil(t, GetAddress(scs, []byte(subdomain), expired+1),
+		"the subdomain of the previous registration is not resolved")
+	assert.Equal(t, "", GetPrimaryName(scs, buyer, expired+1))
+
+	err = execute(ownerState, buildNamePayload("shop.ab1234567890", types.NameCreate, ""), nil, expired+1)
+	assert.Error(t, err, "the previous owner does not control the subdomains")
+	err = execute(buyerState, buildNamePayload(subdomain, types.NameUpdate, types.EncodeAddress(buyer)), price,
+		expired+1)
+	assert.Error(t, err, "the owner of the previous subdomain does not control it")
+	err = execute(otherState, buildNamePayload(subdomain, types.NameCreate, ""), nil, expired+1)
+	assert.NoError(t, err, "create the subdomain again")
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, other, GetAddress(scs, []byte(subdomain), expired+2))
+}
+
+func TestNameBeforeV2(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	types.InitHardfork(&types.Hardfork{V2: 10})
+	defer types.InitHardfork(&types.Hardfork{})
+	owner := types.ToAddress("AmMXVdJ8DnEFysN58cox9RADC74dF1CLrQimKCMdB4XXMkJeuQgL")
+	name := "AB1234567890"
+
+	ownerState, _ := sdb.GetStateDB().GetAccountStateV(owner)
+	ownerState.AddBalance(types.MaxAER)
+	receiver, _ := sdb.GetStateDB().GetAccountStateV([]byte(types.AergoName))
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+
+	execute := func(payload []byte, amount []byte, blockNo types.BlockNo) error {
+		txBody := &types.TxBody{Account: owner, Recipient: []byte(types.AergoName), Amount: amount, Payload: payload}
+		_, err := ExecuteNameTx(bs, scs, txBody, ownerState, receiver, blockNo)
+		return err
+	}
+	price := types.NamePrice.Bytes()
+
+	assert.NoError(t, execute(buildNamePayload(name, types.NameCreate, ""), price, 1), "create name")
+	scs = nextBlockContractState(t, bs, scs)
+	nameMap := getNameMap(scs, []byte(name), true)
+	assert.Equal(t, byte(1), nameMap.Version, "the name created before V2 is of the version 1")
+	assert.Equal(t, types.BlockNo(0), nameMap.ExpireAt, "the name created before V2 never expires")
+
+	assert.Error(t, execute(buildNamePayload("pay.ab1234567890", types.NameCreate, ""), nil, 2))
+	assert.Error(t, execute(buildNamePayload(name, types.NameRenew, ""), price, 2))
+	assert.Equal(t, []byte("pay.ab1234567890"), GetAddress(scs, []byte("pay.ab1234567890"), 2),
+		"a dotted name is not resolved before V2")
+
+	assert.NoError(t, execute(buildNamePayload("pay.ab1234567890", types.NameCreate, ""), nil, 10))
+	scs = nextBlockContractState(t, bs, scs)
+	assert.Equal(t, owner, GetAddress(scs, []byte("pay.ab1234567890"), 11), "subdomain of a name without expiry")
+	assert.Equal(t, owner, GetAddress(scs, []byte(name), 11+RegistrationPeriod()))
+}
@@ -1,20 +1,42 @@
 package name
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"strings"
 
+	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 )
 
 var prefix = []byte("name")
+var reversePrefix = []byte("reverse")
+
+// registrationPeriodSec is the time in seconds for which a name is registered
+// from the V2 hardfork. The owner renews the name before it expires, otherwise
+// the name is no longer resolved and anyone may register it again.
+const registrationPeriodSec = 60 * 60 * 24 * 365
+
+// RegistrationPeriod returns the number of the blocks produced during
+// registrationPeriodSec.
+func RegistrationPeriod() types.BlockNo {
+	return types.BlockNo(registrationPeriodSec / consensus.BlockIntervalSec)
+}
 
 type NameMap struct {
 	Version     byte
 	Owner       []byte
 	Destination []byte
+	// ExpireAt is zero for a subdomain, which expires with its top level
+	// name, and for a name registered before the V2 hardfork, which never
+	// expires.
+	ExpireAt types.BlockNo
+	// Generation counts the registrations of a top level name. A subdomain
+	// records the generation of its top level name when created, and is
+	// resolved only while its top level name is in that generation.
+	Generation uint64
 }
 
 // AccountStateReader is an interface for getting a name account state.
@@ -22,27 +44,64 @@ type AccountStateReader interface {
 	GetNameAccountState() (*state.ContractState, error)
 }
 
-func CreateName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string) error {
+func CreateName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string,
+	blockNo types.BlockNo) error {
 	amount := tx.GetAmountBigInt()
 	sender.SubBalance(amount)
 	receiver.AddBalance(amount)
-	return createName(scs, []byte(name), sender.ID())
+	return createName(scs, []byte(name), sender.ID(), blockNo)
 }
 
-func createName(scs *state.ContractState, name []byte, owner []byte) error {
+// createName registers name to owner. From the V2 hardfork, a top level name
+// expires after RegistrationPeriod, and registering it again starts a new
+// generation of it.
+func createName(scs *state.ContractState, name []byte, owner []byte, blockNo types.BlockNo) error {
 	//	return setAddress(scs, name, owner)
-	return registerOwner(scs, name, owner, owner)
+	if !types.IsV2Fork(blockNo) {
+		return registerOwner(scs, name, owner, owner)
+	}
+	nameMap := &NameMap{Version: 2, Owner: owner, Destination: owner}
+	if isSubdomain(name) {
+		nameMap.Generation = getGeneration(scs, topLevelOf(name), false)
+	} else {
+		nameMap.ExpireAt = blockNo + RegistrationPeriod()
+		if old := getNameMap(scs, name, false); old != nil {
+			nameMap.Generation = old.Generation + 1
+		}
+	}
+	return setNameMap(scs, name, nameMap)
+}
+
+// RenewName extends the registration of name by RegistrationPeriod from its
+// expiry, or from blockNo if it has already expired.
+func RenewName(scs *state.ContractState, tx *types.TxBody, sender, receiver *state.V, name string,
+	blockNo types.BlockNo) error {
+	amount := tx.GetAmountBigInt()
+	sender.SubBalance(amount)
+	receiver.AddBalance(amount)
+	nameMap := getNameMap(scs, []byte(name), false)
+	if nameMap.ExpireAt < blockNo {
+		nameMap.ExpireAt = blockNo
+	}
+	nameMap.ExpireAt += RegistrationPeriod()
+	return setNameMap(scs, []byte(name), nameMap)
+}
+
+// SetPrimaryName sets name as the primary name of sender, to which sender is
+// resolved back.
+func SetPrimaryName(scs *state.ContractState, sender *state.V, name string) error {
+	return scs.SetData(append(reversePrefix, sender.ID()...), []byte(strings.ToLower(name)))
 }
 
 //UpdateName is avaliable after bid implement
 func UpdateName(bs *state.BlockState, scs *state.ContractState, tx *types.TxBody,
-	sender, receiver *state.V, name, to string) error {
+	sender, receiver *state.V, name, to string, blockNo types.BlockNo) error {
 	amount := tx.GetAmountBigInt()
-	if len(getAddress(scs, []byte(name))) <= types.NameLength {
+	if len(getAddress(scs, []byte(name), blockNo)) <= types.NameLength {
 		return fmt.Errorf("%s is not created yet", string(name))
 	}
 	destination, _ := types.DecodeAddress(to)
-	destination = GetAddress(scs, destination)
+	destination = GetAddress(scs, destination, blockNo)
 	sender.SubBalance(amount)
 	receiver.AddBalance(amount)
 	contract, err := bs.StateDB.OpenContractStateAccount(types.ToAccountID(destination))
@@ -65,20 +124,27 @@ func UpdateName(bs *state.BlockState, scs *state.ContractState, tx *types.TxBody
 
 func updateName(scs *state.ContractState, name []byte, owner []byte, to []byte) error {
 	//return setAddress(scs, name, to)
-	return registerOwner(scs, name, owner, to)
+	nameMap := getNameMap(scs, name, false)
+	if nameMap == nil {
+		return registerOwner(scs, name, owner, to)
+	}
+	// the expiry and the generation of the name are kept
+	nameMap.Owner = owner
+	nameMap.Destination = to
+	return setNameMap(scs, name, nameMap)
 }
 
 //Resolve is resolve name for chain
-func Resolve(bs *state.BlockState, name []byte) []byte {
-	if len(name) == types.AddressLength ||
-		strings.Contains(string(name), ".") {
+func Resolve(bs *state.BlockState, name []byte, blockNo types.BlockNo) []byte {
+	if len(name) == types.AddressLength || types.IsGovernanceName(name) ||
+		(isSubdomain(name) && !types.IsV2Fork(blockNo)) {
 		return name
 	}
 	scs, err := openContract(bs)
 	if err != nil {
 		return name
 	}
-	return getAddress(scs, name)
+	return GetAddress(scs, name, blockNo)
 }
 
 func openContract(bs *state.BlockState) (*state.ContractState, error) {
@@ -94,20 +160,26 @@ func openContract(bs *state.BlockState) (*state.ContractState, error) {
 }
 
 //GetAddress is resolve name for mempool
-func GetAddress(scs *state.ContractState, name []byte) []byte {
-	if len(name) == types.AddressLength ||
-		strings.Contains(string(name), ".") {
+func GetAddress(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
+	if len(name) == types.AddressLength || types.IsGovernanceName(name) ||
+		(isSubdomain(name) && !types.IsV2Fork(blockNo)) {
 		return name
 	}
-	return getAddress(scs, name)
+	return getAddress(scs, name, blockNo)
 }
 
-func getAddress(scs *state.ContractState, name []byte) []byte {
+// getAddress returns the destination of name at blockNo. An expired name is
+// not resolved, nor a subdomain created under a previous registration of its
+// top level name.
+func getAddress(scs *state.ContractState, name []byte, blockNo types.BlockNo) []byte {
 	nameMap := getNameMap(scs, name, true)
-	if nameMap != nil {
-		return nameMap.Destination
+	if nameMap == nil || !isCurrent(scs, name, nameMap, true) {
+		return nil
 	}
-	return nil
+	if expireAt := getExpireAt(scs, name, true); expireAt != 0 && expireAt <= blockNo {
+		return nil
+	}
+	return nameMap.Destination
 }
 
 func GetOwner(scs *state.ContractState, name []byte) []byte {
@@ -138,13 +210,116 @@ func getNameMap(scs *state.ContractState, name []byte, useInitial bool) *NameMap
 	return deserializeNameMap(ownerdata)
 }
 
-func GetNameInfo(r AccountStateReader, name string) (*types.NameInfo, error) {
+func isSubdomain(name []byte) bool {
+	return bytes.IndexByte(name, '.') >= 0
+}
+
+// parentOf returns the name of which name is a subdomain.
+func parentOf(name []byte) []byte {
+	return name[bytes.IndexByte(name, '.')+1:]
+}
+
+// topLevelOf returns the top level name above name, or name itself if it is
+// not a subdomain.
+func topLevelOf(name []byte) []byte {
+	return name[bytes.LastIndexByte(name, '.')+1:]
+}
+
+// getExpireAt returns the block number at which name expires. A subdomain
+// expires with the top level name above it. Zero means that name never
+// expires.
+func getExpireAt(scs *state.ContractState, name []byte, useInitial bool) types.BlockNo {
+	nameMap := getNameMap(scs, topLevelOf(name), useInitial)
+	if nameMap != nil {
+		return nameMap.ExpireAt
+	}
+	return 0
+}
+
+func getGeneration(scs *state.ContractState, name []byte, useInitial bool) uint64 {
+	nameMap := getNameMap(scs, name, useInitial)
+	if nameMap != nil {
+		return nameMap.Generation
+	}
+	return 0
+}
+
+// isCurrent returns true if nameMap, the registration of name, belongs to the
+// current generation of its top level name.
+func isCurrent(scs *state.ContractState, name []byte, nameMap *NameMap, useInitial bool) bool {
+	if !isSubdomain(name) {
+		return true
+	}
+	top := getNameMap(scs, topLevelOf(name), useInitial)
+	return top != nil && top.Generation == nameMap.Generation
+}
+
+func isExpired(scs *state.ContractState, name []byte, blockNo types.BlockNo) bool {
+	expireAt := getExpireAt(scs, name, false)
+	return expireAt != 0 && expireAt <= blockNo
+}
+
+// isController returns true if account manages name, which is allowed to the
+// owner of name and, for a subdomain, to the owners of the names above it. The
+// owners of the subdomains created under a previous registration of their top
+// level name manage nothing.
+func isController(scs *state.ContractState, account, name []byte) bool {
+	for {
+		nameMap := getNameMap(scs, name, false)
+		if nameMap != nil && isCurrent(scs, name, nameMap, false) && bytes.Equal(account, nameMap.Owner) {
+			return true
+		}
+		if !isSubdomain(name) {
+			return false
+		}
+		name = parentOf(name)
+	}
+}
+
+// GetPrimaryName returns the primary name of address at blockNo, or an empty
+// string if address has none. The primary name is ignored once it is no
+// longer resolved to address, such as when it expires.
+func GetPrimaryName(scs *state.ContractState, address []byte, blockNo types.BlockNo) string {
+	name, err := scs.GetInitialData(append(reversePrefix, address...))
+	if err != nil || len(name) == 0 {
+		return ""
+	}
+	if !bytes.Equal(getAddress(scs, name, blockNo), address) {
+		return ""
+	}
+	return string(name)
+}
+
+func GetNameInfo(r AccountStateReader, name string, blockNo types.BlockNo) (*types.NameInfo, error) {
+	scs, err := r.GetNameAccountState()
+	if err != nil {
+		return nil, err
+	}
+	return getNameInfo(scs, name, blockNo), nil
+}
+
+// GetPrimaryNameInfo returns the information of the primary name of address
+// at blockNo.
+func GetPrimaryNameInfo(r AccountStateReader, address []byte, blockNo types.BlockNo) (*types.NameInfo, error) {
 	scs, err := r.GetNameAccountState()
 	if err != nil {
 		return nil, err
 	}
+	name := GetPrimaryName(scs, address, blockNo)
+	if len(name) == 0 {
+		return nil, types.ErrNameNotFound
+	}
+	return getNameInfo(scs, name, blockNo), nil
+}
+
+func getNameInfo(scs *state.ContractState, name string, blockNo types.BlockNo) *types.NameInfo {
 	owner := getOwner(scs, []byte(name), true)
-	return &types.NameInfo{Name: &types.Name{Name: string(name)}, Owner: owner, Destination: GetAddress(scs, []byte(name))}, err
+	return &types.NameInfo{
+		Name:        &types.Name{Name: string(name)},
+		Owner:       owner,
+		Destination: GetAddress(scs, []byte(name), blockNo),
+		ExpireAt:    getExpireAt(scs, []byte(name), true),
+	}
 }
 
 func registerOwner(scs *state.ContractState, name, owner, destination []byte) error {
@@ -169,6 +344,12 @@ func serializeNameMap(n *NameMap) []byte {
 		binary.LittleEndian.PutUint64(buf, uint64(len(n.Destination)))
 		ret = append(ret, buf...)
 		ret = append(ret, n.Destination...)
+		if n.Version >= 2 {
+			binary.LittleEndian.PutUint64(buf, n.ExpireAt)
+			ret = append(ret, buf...)
+			binary.LittleEndian.PutUint64(buf, n.Generation)
+			ret = append(ret, buf...)
+		}
 	}
 	return ret
 }
@@ -176,7 +357,7 @@ func serializeNameMap(n *NameMap) []byte {
 func deserializeNameMap(data []byte) *NameMap {
 	if data != nil {
 		version := data[0]
-		if version != 1 {
+		if version != 1 && version != 2 {
 			panic("could not deserializeOwner, not supported version")
 		}
 		offset := 1
@@ -194,10 +375,24 @@ func deserializeNameMap(data []byte) *NameMap {
 		offset = next
 		next = offset + int(sizeOfDest)
 		destination := data[offset:next]
+
+		var expireAt types.BlockNo
+		var generation uint64
+		if version >= 2 {
+			offset = next
+			next = offset + 8
+			expireAt = binary.LittleEndian.Uint64(data[offset:next])
+
+			offset = next
+			next = offset + 8
+			generation = binary.LittleEndian.Uint64(data[offset:next])
+		}
 		return &NameMap{
 			Version:     version,
 			Owner:       owner,
 			Destination: destination,
+			ExpireAt:    expireAt,
+			Generation:  generation,
 		}
 	}
 	return nil
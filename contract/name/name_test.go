@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/Cofresi/aergo-lib/db"
+	"github.com/aergoio/aergo/consensus"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/stretchr/testify/assert"
@@ -47,23 +48,23 @@ func TestName(t *testing.T) {
 	scs := openContractState(t, bs)
 	systemcs := openSystemContractState(t, bs)
 
-	err := CreateName(scs, tx, sender, receiver, name)
+	err := CreateName(scs, tx, sender, receiver, name, 0)
 	assert.NoError(t, err, "create name")
 
 	scs = nextBlockContractState(t, bs, scs)
-	_, err = ValidateNameTx(tx, sender, scs, systemcs)
+	_, err = ValidateNameTx(tx, sender, scs, systemcs, 0)
 	assert.Error(t, err, "same name")
 
-	ret := getAddress(scs, []byte(name))
+	ret := getAddress(scs, []byte(name), 1)
 	assert.Equal(t, owner, ret, "registed owner")
 
 	tx.Payload = buildNamePayload(name, types.NameUpdate, buyer)
-	err = UpdateName(bs, scs, tx, sender, receiver, name, buyer)
+	err = UpdateName(bs, scs, tx, sender, receiver, name, buyer, 1)
 	assert.NoError(t, err, "update name")
 
 	scs = nextBlockContractState(t, bs, scs)
 
-	ret = getAddress(scs, []byte(name))
+	ret = getAddress(scs, []byte(name), 1)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "registed owner")
 }
 
@@ -81,7 +82,7 @@ func TestNameRecursive(t *testing.T) {
 	receiver, _ := sdb.GetStateDB().GetAccountStateV(tx.Recipient)
 	bs := sdb.NewBlockState(sdb.GetRoot())
 	scs := openContractState(t, bs)
-	err := CreateName(scs, tx, sender, receiver, name1)
+	err := CreateName(scs, tx, sender, receiver, name1, 0)
 	assert.NoError(t, err, "create name")
 
 	tx.Account = []byte(name1)
@@ -89,11 +90,11 @@ func TestNameRecursive(t *testing.T) {
 	tx.Payload = buildNamePayload(name2, types.NameCreate, "")
 
 	scs = nextBlockContractState(t, bs, scs)
-	err = CreateName(scs, tx, sender, receiver, name2)
+	err = CreateName(scs, tx, sender, receiver, name2, 0)
 	assert.NoError(t, err, "redirect name")
 
 	scs = nextBlockContractState(t, bs, scs)
-	ret := getAddress(scs, []byte(name2))
+	ret := getAddress(scs, []byte(name2), 1)
 	assert.Equal(t, owner, ret, "registed owner")
 	name1Owner := GetOwner(scs, []byte(name1))
 	t.Logf("name1 owner is %s", types.EncodeAddress(name1Owner))
@@ -104,10 +105,10 @@ func TestNameRecursive(t *testing.T) {
 
 	tx.Payload = buildNamePayload(name1, types.NameUpdate, buyer)
 
-	err = UpdateName(bs, scs, tx, sender, receiver, name1, buyer)
+	err = UpdateName(bs, scs, tx, sender, receiver, name1, buyer, 1)
 	assert.NoError(t, err, "update name")
 	scs = nextBlockContractState(t, bs, scs)
-	ret = getAddress(scs, []byte(name1))
+	ret = getAddress(scs, []byte(name1), 1)
 	assert.Equal(t, buyer, types.EncodeAddress(ret), "registed owner")
 }
 
@@ -123,7 +124,7 @@ func TestNameNil(t *testing.T) {
 	sender, _ := sdb.GetStateDB().GetAccountStateV(tx.Account)
 	receiver, _ := sdb.GetStateDB().GetAccountStateV(tx.Recipient)
 
-	err = CreateName(scs, tx, sender, receiver, name2)
+	err = CreateName(scs, tx, sender, receiver, name2, 0)
 	assert.NoError(t, err, "create name")
 }
 
@@ -159,7 +160,7 @@ func buildNamePayload(name string, operation string, buyer string) []byte {
 	var ci types.CallInfo
 	ci.Name = operation
 	if buyer != "" {
-		ci.Args = append(ci.Args, name, buyer)
+		ci.Args = append(ci.Args, name, buyer, 1)
 	} else {
 		ci.Args = append(ci.Args, name)
 	}
@@ -215,7 +216,38 @@ func TestNameMap(t *testing.T) {
 
 	resOwner = GetOwner(scs, []byte(name1))
 	assert.Equal(t, testNameMap.Owner, resOwner, "GetOwner")
-	resAddr := getAddress(scs, []byte(name1))
+	resAddr := getAddress(scs, []byte(name1), 1)
 	assert.Equal(t, testNameMap.Destination, resAddr, "getAddress")
 
 }
+
+func TestResolveDottedName(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	types.InitHardfork(&types.Hardfork{V2: 10})
+	defer types.InitHardfork(&types.Hardfork{})
+
+	bs := sdb.NewBlockState(sdb.GetRoot())
+	scs := openContractState(t, bs)
+
+	for _, gov := range []string{types.AergoSystem, types.AergoName, types.AergoEnterprise} {
+		assert.Equal(t, []byte(gov), Resolve(bs, []byte(gov), 10), gov)
+		assert.Equal(t, []byte(gov), GetAddress(scs, []byte(gov), 10), gov)
+	}
+	// a dotted name is resolved only from the V2 hardfork, whatever the length
+	// of its top level name
+	for _, name := range []string{"foo.abc", "pay.myorgabcdef"} {
+		assert.Equal(t, []byte(name), Resolve(bs, []byte(name), 9), name)
+		assert.Nil(t, Resolve(bs, []byte(name), 10), name)
+		assert.Nil(t, GetAddress(scs, []byte(name), 10), name)
+	}
+}
+
+func TestRegistrationPeriod(t *testing.T) {
+	defer func(interval int64) { consensus.BlockIntervalSec = interval }(consensus.BlockIntervalSec)
+
+	consensus.BlockIntervalSec = 1
+	assert.Equal(t, types.BlockNo(60*60*24*365), RegistrationPeriod())
+	consensus.BlockIntervalSec = 5
+	assert.Equal(t, types.BlockNo(60*60*24*73), RegistrationPeriod())
+}
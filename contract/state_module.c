@@ -7,7 +7,9 @@
 #include <stdlib.h>
 #include <stdint.h>
 #include "vm.h"
+#include "util.h"
 #include "system_module.h"
+#include "_cgo_export.h"
 
 #define STATE_MAP_ID            "__state_map__"
 #define STATE_ARRAY_ID          "__state_array__"
@@ -16,13 +18,29 @@
 #define STATE_VAR_KEY_PREFIX    "_sv_"
 #define STATE_VAR_META_LEN      "_sv_meta-len_"
 #define STATE_VAR_META_TYPE     "_sv_meta-type_"
+#define STATE_VAR_META_IDX      "_sv_meta-idx_"
+
+#define STATE_V2_FORK           "__state_v2_fork__"
 
 #define STATE_MAX_DIMENSION 5
+#define STATE_MAP_PAGE_SIZE 100
 
 static int state_map_delete(lua_State *L);
 static int state_array_append(lua_State *L);
 static int state_array_pairs(lua_State *L);
 
+extern const int *getLuaExecContext(lua_State *L);
+
+static int state_is_v2_fork(lua_State *L)
+{
+    int v2;
+
+    lua_getfield(L, LUA_REGISTRYINDEX, STATE_V2_FORK);
+    v2 = lua_toboolean(L, -1);
+    lua_pop(L, 1);
+    return v2;
+}
+
 /* map */
 
 typedef struct {
@@ -30,19 +48,22 @@ typedef struct {
     int key_type;
     int dimension;
     char *key;
+    int iterable;
 } state_map_t;
 
 static int state_map(lua_State *L)
 {
     int argn = lua_gettop(L);
+    int v2 = state_is_v2_fork(L);
 
     state_map_t *m = lua_newuserdata(L, sizeof(state_map_t));   /* m */
     m->id = NULL;
     m->key_type = LUA_TNONE;
     m->key = NULL;
+    m->iterable = 0;
     if (luaL_isinteger(L, 1))
         m->dimension = luaL_checkint(L, 1);
-    else if (argn == 0)
+    else if (argn == 0 || (v2 && lua_isnil(L, 1)))
         m->dimension = 1;
     else
         luaL_typerror(L, 1, "integer");
@@ -51,6 +72,16 @@ static int state_map(lua_State *L)
         luaL_error(L, "dimension over max limit(%d): %d, state.map",
                    STATE_MAX_DIMENSION, m->dimension);
     }
+    /* the options of a map are read from the V2 hardfork */
+    if (v2 && argn >= 2) {
+        luaL_checktype(L, 2, LUA_TTABLE);
+        lua_getfield(L, 2, "iterable");                         /* m iterable */
+        m->iterable = lua_toboolean(L, -1);
+        lua_pop(L, 1);                                          /* m */
+        if (m->iterable && m->dimension > 1) {
+            luaL_error(L, "only a map of one dimension can be iterable, state.map");
+        }
+    }
     luaL_getmetatable(L, STATE_MAP_ID);                         /* m mt */
     lua_setmetatable(L, -2);                                    /* m */
     return 1;
@@ -95,6 +126,93 @@ static void state_map_push_key(lua_State *L, state_map_t *m)
     lua_concat(L, 3);                               /* m key value f id-key */
 }
 
+static void state_map_push_index(lua_State *L, state_map_t *m)
+{
+    lua_pushstring(L, STATE_VAR_META_IDX);
+    lua_pushstring(L, m->id);
+    lua_concat(L, 2);
+}
+
+/*
+ * Pushes the key at idx as it is stored in the index. A number is encoded in
+ * 8 big endian bytes, which sort in the numeric order.
+ */
+static void state_map_push_index_key(lua_State *L, int idx, int key_type)
+{
+    union {
+        lua_Number n;
+        uint64_t u;
+    } v;
+    unsigned char b[8];
+    int i;
+
+    if (key_type != LUA_TNUMBER) {
+        lua_pushvalue(L, idx);
+        lua_tostring(L, -1);
+        return;
+    }
+    v.n = luaL_checknumber(L, idx);
+    if (v.n != v.n) {
+        luaL_error(L, "invalid number key: nan, state.map");
+    }
+    if (v.n == 0) {
+        v.n = 0;                                    /* -0 */
+    }
+    if (v.u >> 63) {
+        v.u = ~v.u;
+    } else {
+        v.u |= (uint64_t)1 << 63;
+    }
+    for (i = 0; i < 8; i++) {
+        b[i] = (unsigned char)(v.u >> (56 - 8 * i));
+    }
+    lua_pushlstring(L, (const char *)b, sizeof(b));
+}
+
+/* Pushes the map key of an index key of len bytes at p. */
+static void state_map_push_key_of_index(lua_State *L, const unsigned char *p, uint32_t len, int key_type)
+{
+    union {
+        lua_Number n;
+        uint64_t u;
+    } v;
+    int i;
+
+    if (key_type != LUA_TNUMBER || len != 8) {
+        lua_pushlstring(L, (const char *)p, len);
+        return;
+    }
+    v.u = 0;
+    for (i = 0; i < 8; i++) {
+        v.u = v.u << 8 | p[i];
+    }
+    if (v.u >> 63) {
+        v.u &= ~((uint64_t)1 << 63);
+    } else {
+        v.u = ~v.u;
+    }
+    lua_pushnumber(L, v.n);
+}
+
+static void state_map_update_index(lua_State *L, state_map_t *m, int del)
+{
+    /* m key */
+    int *service = (int *)getLuaExecContext(L);
+    const char *key;
+    size_t len;
+    char *errStr;
+
+    state_map_push_index(L, m);                     /* m key idx */
+    state_map_push_index_key(L, 2, lua_type(L, 2)); /* m key idx key */
+    key = lua_tolstring(L, -1, &len);
+    errStr = LuaMapIndexUpdate(L, service, (char *)lua_tostring(L, -2), (void *)key, len, del);
+    lua_pop(L, 2);                                  /* m key */
+    if (errStr != NULL) {
+        strPushAndRelease(L, errStr);
+        luaL_throwerror(L);
+    }
+}
+
 static int state_map_get(lua_State *L)
 {
     int key_type = LUA_TNONE;
@@ -117,6 +235,7 @@ static int state_map_get(lua_State *L)
         subm->id = strdup(m->id);
         subm->key_type = m->key_type;
         subm->dimension = m->dimension - 1;
+        subm->iterable = 0;
 
         luaL_getmetatable(L, STATE_MAP_ID);                         /* m mt */
         lua_setmetatable(L, -2);                                    /* m */
@@ -194,6 +313,10 @@ static int state_map_set(lua_State *L)
     lua_pushvalue(L, 3);                            /* m key value f id-key value */
     lua_pushstring(L, STATE_VAR_KEY_PREFIX);        /* m key value f id-key value prefix */
     lua_call(L, 3, 0);                              /* t key value */
+    if (m->iterable) {
+        /* a key set to nil is not listed */
+        state_map_update_index(L, m, lua_isnil(L, 3));
+    }
     return 0;
 }
 
@@ -218,6 +341,10 @@ static int state_map_delete(lua_State *L)
     state_map_push_key(L, m);                       /* m key f id-key */
     lua_pushstring(L, STATE_VAR_KEY_PREFIX);        /* m key f id-key prefix */
     lua_call(L, 2, 1);                              /* m key rv */
+    if (m->iterable) {
+        lua_pop(L, 1);                              /* m key */
+        state_map_update_index(L, m, 1);
+    }
     return 0;
 }
 
@@ -235,6 +362,222 @@ static int state_map_gc(lua_State *L)
     return 0;
 }
 
+/* map iteration */
+
+static int state_map_key_type(lua_State *L, state_map_t *m)
+{
+    int key_type = LUA_TSTRING;
+
+    lua_pushcfunction(L, getItemWithPrefix);        /* f */
+    lua_pushstring(L, m->id);                       /* f id */
+    lua_pushstring(L, STATE_VAR_META_TYPE);         /* f id prefix */
+    lua_call(L, 2, 1);                              /* t */
+    if (!lua_isnil(L, -1)) {
+        key_type = luaL_checkint(L, -1);
+    }
+    lua_pop(L, 1);
+    return key_type;
+}
+
+/* Pushes the bound at the top of the stack as an index key. */
+static const char *state_map_bound(lua_State *L, const char *name, int key_type, int *len)
+{
+    const char *bound;
+    size_t size;
+    int type = lua_type(L, -1);
+
+    if (type == LUA_TNIL || type == LUA_TNONE) {
+        *len = -1;
+        return NULL;
+    }
+    if (type != LUA_TNUMBER && type != LUA_TSTRING) {
+        luaL_error(L, "invalid %s type: " LUA_QS ", state.map", name, lua_typename(L, type));
+    }
+    if (key_type == LUA_TNUMBER && strcmp(name, "prefix") == 0) {
+        luaL_error(L, "prefix of number keys, state.map");
+    }
+    state_map_push_index_key(L, lua_gettop(L), key_type);
+    bound = lua_tolstring(L, -1, &size);
+    *len = size;
+    return bound;
+}
+
+/*
+ * Pushes a table of the keys of the iterable map m, which are selected by the
+ * options at opts and follow the cursor at after. Returns 1 if there are more
+ * keys after the last one.
+ */
+static int state_map_fetch_keys(lua_State *L, state_map_t *m, int opts, int after, int limit)
+{
+    int *service = (int *)getLuaExecContext(L);
+    static const char *names[] = {"prefix", "from", "to"};
+    const char *bounds[4] = {NULL, NULL, NULL, NULL};
+    int lens[4] = {-1, -1, -1, -1};
+    int top = lua_gettop(L);
+    int key_type, i, n;
+    struct LuaMapKeys_return ret;
+    unsigned char *p, *end;
+    uint32_t len;
+
+    if (!m->iterable) {
+        luaL_error(L, "state.map is not iterable: " LUA_QS, m->id);
+    }
+    key_type = state_map_key_type(L, m);
+    for (i = 0; opts != 0 && i < 3; i++) {
+        lua_getfield(L, opts, names[i]);
+        bounds[i] = state_map_bound(L, names[i], key_type, &lens[i]);
+    }
+    lua_pushvalue(L, after);
+    bounds[3] = state_map_bound(L, "cursor", key_type, &lens[3]);
+    state_map_push_index(L, m);                     /* ... bounds idx */
+
+    ret = LuaMapKeys(L, service, (char *)lua_tostring(L, -1),
+                     (void *)bounds[0], lens[0], (void *)bounds[1], lens[1],
+                     (void *)bounds[2], lens[2], (void *)bounds[3], lens[3], limit);
+    lua_settop(L, top);
+    if (ret.r3 != NULL) {
+        free(ret.r0);
+        strPushAndRelease(L, ret.r3);
+        luaL_throwerror(L);
+    }
+
+    lua_newtable(L);                                /* ... keys */
+    p = ret.r0;
+    end = p + ret.r1;
+    for (n = 1; p + 4 <= end; n++) {
+        len = p[0] | p[1] << 8 | p[2] << 16 | (uint32_t)p[3] << 24;
+        p += 4;
+        state_map_push_key_of_index(L, p, len, key_type);
+        lua_rawseti(L, -2, n);
+        p += len;
+    }
+    free(ret.r0);
+    return ret.r2;
+}
+
+static int state_map_page(int limit)
+{
+    if (limit < 0 || limit > STATE_MAP_PAGE_SIZE) {
+        return STATE_MAP_PAGE_SIZE;
+    }
+    return limit;
+}
+
+static int state_map_limit(lua_State *L, int opts, int def)
+{
+    int limit = def;
+
+    if (opts != 0) {
+        lua_getfield(L, opts, "limit");
+        if (!lua_isnil(L, -1)) {
+            if (!luaL_isinteger(L, -1) || (limit = lua_tointeger(L, -1)) <= 0) {
+                luaL_error(L, "invalid limit, state.map");
+            }
+        }
+        lua_pop(L, 1);
+    }
+    return limit;
+}
+
+static int state_map_iter(lua_State *L)
+{
+    /* upvalues: m opts keys pos more remaining */
+    state_map_t *m = luaL_checkudata(L, lua_upvalueindex(1), STATE_MAP_ID);
+    int opts = lua_istable(L, lua_upvalueindex(2)) ? lua_upvalueindex(2) : 0;
+    int pos = lua_tointeger(L, lua_upvalueindex(4)) + 1;
+    int remaining = lua_tointeger(L, lua_upvalueindex(6));
+    int more;
+
+    if (remaining == 0) {
+        return 0;
+    }
+    lua_rawgeti(L, lua_upvalueindex(3), pos);                   /* key */
+    if (lua_isnil(L, -1)) {
+        if (!lua_toboolean(L, lua_upvalueindex(5))) {
+            return 0;
+        }
+        lua_pop(L, 1);
+        lua_rawgeti(L, lua_upvalueindex(3), pos - 1);           /* cursor */
+        more = state_map_fetch_keys(L, m, opts, lua_gettop(L), state_map_page(remaining));
+        lua_replace(L, lua_upvalueindex(3));                    /* cursor */
+        lua_pushboolean(L, more);
+        lua_replace(L, lua_upvalueindex(5));
+        lua_pop(L, 1);
+        pos = 1;
+        lua_rawgeti(L, lua_upvalueindex(3), pos);               /* key */
+        if (lua_isnil(L, -1)) {
+            return 0;
+        }
+    }
+    lua_pushinteger(L, pos);
+    lua_replace(L, lua_upvalueindex(4));
+    if (remaining > 0) {
+        lua_pushinteger(L, remaining - 1);
+        lua_replace(L, lua_upvalueindex(6));
+    }
+    lua_pushcfunction(L, state_map_get);                        /* key f */
+    lua_pushvalue(L, lua_upvalueindex(1));                      /* key f m */
+    lua_pushvalue(L, -3);                                       /* key f m key */
+    lua_call(L, 2, 1);                                          /* key value */
+    return 2;
+}
+
+/* state.pairs(m [, opts]) iterates over the keys of m in order */
+static int state_map_pairs(lua_State *L)
+{
+    state_map_t *m = luaL_checkudata(L, 1, STATE_MAP_ID);
+    int opts = 0;
+    int limit, more;
+
+    if (!lua_isnoneornil(L, 2)) {
+        luaL_checktype(L, 2, LUA_TTABLE);
+        opts = 2;
+    }
+    lua_settop(L, 2);                                           /* m opts */
+    limit = state_map_limit(L, opts, -1);
+    if (opts != 0) {
+        lua_getfield(L, opts, "cursor");                        /* m opts cursor */
+    } else {
+        lua_pushnil(L);
+    }
+    more = state_map_fetch_keys(L, m, opts, 3, state_map_page(limit)); /* m opts cursor keys */
+    lua_pushvalue(L, 1);
+    lua_pushvalue(L, 2);
+    lua_pushvalue(L, 4);
+    lua_pushinteger(L, 0);
+    lua_pushboolean(L, more);
+    lua_pushinteger(L, limit);
+    lua_pushcclosure(L, state_map_iter, 6);
+    return 1;
+}
+
+/* state.keys(m [, opts]) returns a page of the keys of m and the cursor of the next page */
+static int state_map_keys(lua_State *L)
+{
+    state_map_t *m = luaL_checkudata(L, 1, STATE_MAP_ID);
+    int opts = 0;
+    int limit, more;
+
+    if (!lua_isnoneornil(L, 2)) {
+        luaL_checktype(L, 2, LUA_TTABLE);
+        opts = 2;
+    }
+    lua_settop(L, 2);                                           /* m opts */
+    limit = state_map_limit(L, opts, 0);
+    if (opts != 0) {
+        lua_getfield(L, opts, "cursor");                        /* m opts cursor */
+    } else {
+        lua_pushnil(L);
+    }
+    more = state_map_fetch_keys(L, m, opts, 3, limit);          /* m opts cursor keys */
+    if (more) {
+        lua_rawgeti(L, -1, lua_objlen(L, -1));                  /* m opts cursor keys next */
+    } else {
+        lua_pushnil(L);
+    }
+    return 2;
+}
+
 /* array */
 
 typedef struct {
@@ -688,3 +1031,19 @@ int luaopen_state(lua_State *L)
 
     return 1;
 }
+
+/* Opens the iterable maps of the V2 hardfork */
+int luaopen_state_v2(lua_State *L)
+{
+    static const luaL_Reg state_v2_lib[] = {
+        {"keys", state_map_keys},
+        {"pairs", state_map_pairs},
+        {NULL, NULL}
+    };
+
+    lua_pushboolean(L, 1);
+    lua_setfield(L, LUA_REGISTRYINDEX, STATE_V2_FORK);
+    luaL_register(L, "state", state_v2_lib);
+
+    return 1;
+}
@@ -9,5 +9,6 @@
 #include <lua.h>
 
 extern int luaopen_state(lua_State *L);
+extern int luaopen_state_v2(lua_State *L);
 
 #endif /* _STATE_MODULE_H */
@@ -5,25 +5,36 @@ package contract
 */
 import "C"
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/aergoio/aergo/internal/enc"
+	"hash"
+	"io"
+	"math"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/Cofresi/aergo-lib/log"
 	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
+	"github.com/minio/sha256-simd"
 )
 
 var (
-	ErrDBOpen = errors.New("failed to open the sql database")
-	ErrUndo   = errors.New("failed to undo the sql database")
-	ErrFindRp = errors.New("cannot find a recover point")
+	ErrDBOpen  = errors.New("failed to open the sql database")
+	ErrUndo    = errors.New("failed to undo the sql database")
+	ErrFindRp  = errors.New("cannot find a recover point")
+	ErrDBInUse = errors.New("the sql database is in use")
+	ErrDBHash  = errors.New("the sql database does not match its hash in the state")
 
 	database = &Database{}
 	load     sync.Once
@@ -37,12 +48,21 @@ var (
 const (
 	statesqlDriver = "statesql"
 	queryDriver    = "query"
+
+	// the copies of the databases for snapshot sync are removed after
+	// dbCopyTTL since they are made
+	dbCopyDir = "copy"
+	dbCopyTTL = time.Hour
 )
 
 type Database struct {
 	DBs        map[string]*DB
 	OpenDbName string
 	DataDir    string
+
+	// lock guards DBs against the copies of the database files, which are
+	// made outside of the block execution
+	lock sync.Mutex
 }
 
 func init() {
@@ -109,6 +129,9 @@ func LoadTestDatabase(dataDir string) error {
 }
 
 func CloseDatabase() {
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
 	for name, db := range database.DBs {
 		if db.tx != nil {
 			db.tx.Rollback()
@@ -119,9 +142,17 @@ func CloseDatabase() {
 	}
 }
 
-func SaveRecoveryPoint(bs *state.BlockState) error {
+// SaveRecoveryPoint commits the sql databases modified by the block of
+// blockNo, and saves their recovery points to the states of the contracts.
+// From the V2 hardfork, the hashes of their contents are saved as well, so
+// that their copies can be verified against the state in snapshot sync. Only
+// the tables written by the block are hashed again.
+func SaveRecoveryPoint(bs *state.BlockState, blockNo types.BlockNo) error {
 	defer CloseDatabase()
 
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
 	for id, db := range database.DBs {
 		if db.tx != nil {
 			err := db.tx.Commit()
@@ -147,12 +178,93 @@ func SaveRecoveryPoint(bs *state.BlockState) error {
 				if err != nil {
 					return err
 				}
+				if types.IsV2Fork(blockNo) {
+					if err := saveDatabaseHash(bs, db, &receiverChange); err != nil {
+						return err
+					}
+				}
 			}
 		}
 	}
 	return nil
 }
 
+func saveDatabaseHash(bs *state.BlockState, db *DB, st *types.State) error {
+	contractState, err := bs.OpenContractState(db.accountID, st)
+	if err != nil {
+		return err
+	}
+	b, err := contractState.GetData(sqlTableHashMetaKey)
+	if err != nil {
+		return err
+	}
+	// a database last written before the V2 hardfork is hashed in full
+	var prev *tableHashes
+	if len(b) != 0 {
+		if prev, err = decodeTableHashes(b); err != nil {
+			return err
+		}
+	}
+	th, err := db.hashTables(prev)
+	if err != nil {
+		return err
+	}
+	b = th.bytes()
+	if err := contractState.SetData(sqlTableHashMetaKey, b); err != nil {
+		return err
+	}
+	if err := contractState.SetData(sqlHashMetaKey, digest(b)); err != nil {
+		return err
+	}
+	return bs.StageContractState(contractState)
+}
+
+// PruneRecoveryPoints discards the commits of the sql databases before the
+// recovery points of their contracts in states, which is the oldest state
+// kept in prune mode. No block restores a database to the discarded ones.
+// The caller must hold the chain lock.
+func PruneRecoveryPoints(states *state.StateDB) error {
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
+	paths, err := filepath.Glob(filepath.Join(database.DataDir, "*.db"))
+	if err != nil {
+		return err
+	}
+	pruned := 0
+	for _, path := range paths {
+		dbName := strings.TrimSuffix(filepath.Base(path), ".db")
+		if _, ok := database.DBs[dbName]; ok {
+			continue
+		}
+		b, err := enc.ToBytes(dbName)
+		if err != nil {
+			continue
+		}
+		st, err := states.GetAccountState(types.AccountID(types.ToHashID(b)))
+		if err != nil {
+			return err
+		}
+		// no commit is before the recovery point kept
+		if st.SqlRecoveryPoint <= 1 {
+			continue
+		}
+		db, err := openDB(dbName)
+		if err != nil {
+			return err
+		}
+		err = db.discardRecoveryPoints(st.SqlRecoveryPoint)
+		_ = db.close()
+		delete(database.DBs, dbName)
+		if err != nil {
+			return err
+		}
+		pruned++
+	}
+	logger.Info().Int("pruned", pruned).Msg("pruned recovery points of sql databases")
+	return nil
+}
+
 func BeginTx(dbName string, rp uint64) (Tx, error) {
 	db, err := conn(dbName)
 	if err != nil {
@@ -170,6 +282,9 @@ func BeginReadOnly(dbName string, rp uint64) (Tx, error) {
 }
 
 func conn(dbName string) (*DB, error) {
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
 	if db, ok := database.DBs[dbName]; ok {
 		return db, nil
 	}
@@ -180,6 +295,149 @@ func dataSrc(dbName string) string {
 	return fmt.Sprintf("file:%s/%s.db?branches=on&max_db_size=%d", database.DataDir, dbName, StateSqlMaxDbSize)
 }
 
+func databaseFile(dbName string) string {
+	return filepath.Join(database.DataDir, dbName+".db")
+}
+
+func databaseCopyFile(dbName string, rp uint64) string {
+	return filepath.Join(database.DataDir, dbCopyDir, fmt.Sprintf("%s.%d.db", dbName, rp))
+}
+
+// CopyDatabaseFile copies the database file of dbName, which contains the
+// commits up to rp, to be served by ReadDatabaseFile. The copy is made once
+// for each rp, so that all the parts of it are read from the same file. The
+// caller must hold the chain lock for no block to modify the database in the
+// middle of the copy.
+func CopyDatabaseFile(dbName string, rp uint64) error {
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
+	if _, ok := database.DBs[dbName]; ok {
+		return ErrDBInUse
+	}
+	path := databaseCopyFile(dbName, rp)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := checkPath(filepath.Dir(path)); err != nil {
+		return err
+	}
+	removeOldCopies(dbName)
+
+	src, err := os.Open(databaseFile(dbName))
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	tmp := path + ".tmp"
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func removeOldCopies(dbName string) {
+	paths, _ := filepath.Glob(filepath.Join(database.DataDir, dbCopyDir, dbName+".*.db"))
+	for _, path := range paths {
+		if fi, err := os.Stat(path); err == nil && time.Since(fi.ModTime()) > dbCopyTTL {
+			_ = os.Remove(path)
+		}
+	}
+}
+
+// ReadDatabaseFile reads up to size bytes at offset of the copy of the
+// database file of dbName for rp, and returns them with the size of the
+// copy. It is used to copy the databases of contracts to the nodes in
+// snapshot sync.
+func ReadDatabaseFile(dbName string, rp uint64, offset uint64, size uint32) ([]byte, uint64, error) {
+	f, err := os.Open(databaseCopyFile(dbName, rp))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	total := uint64(fi.Size())
+	if offset >= total {
+		return nil, total, nil
+	}
+	if rest := total - offset; rest < uint64(size) {
+		size = uint32(rest)
+	}
+	buf := make([]byte, size)
+	n, err := f.ReadAt(buf, int64(offset))
+	if err != nil && err != io.EOF {
+		return nil, 0, err
+	}
+	return buf[:n], total, nil
+}
+
+// WriteDatabaseFile writes data at offset of the database file of dbName.
+// Writing at offset 0 truncates the file. The database must not be open.
+func WriteDatabaseFile(dbName string, offset uint64, data []byte) error {
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
+	if _, ok := database.DBs[dbName]; ok {
+		return ErrDBInUse
+	}
+	flag := os.O_WRONLY | os.O_CREATE
+	if offset == 0 {
+		flag |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(databaseFile(dbName), flag, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteAt(data, int64(offset))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// VerifyDatabase checks that the database of dbName, which was copied by
+// WriteDatabaseFile, matches the hash saved to the state of its contract at
+// the recovery point of the state. A database last written before the V2
+// hardfork has no hash and is accepted as it is.
+func VerifyDatabase(dbName string, contractState *state.ContractState) error {
+	h, err := contractState.GetData(sqlHashMetaKey)
+	if err != nil {
+		return err
+	}
+	if len(h) == 0 {
+		return nil
+	}
+	db, err := readOnlyConn(dbName)
+	if err != nil {
+		return err
+	}
+	defer db.close()
+
+	if err := db.snapshotView(contractState.SqlRecoveryPoint); err != nil {
+		return err
+	}
+	th, err := db.hashTables(nil)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(digest(th.bytes()), h) {
+		return ErrDBHash
+	}
+	return nil
+}
+
 func readOnlyConn(dbName string) (*DB, error) {
 	queryConnLock.Lock()
 	defer queryConnLock.Unlock()
@@ -237,6 +495,8 @@ func openDB(dbName string) (*DB, error) {
 	}
 	database.DBs[dbName].Conn = c
 	database.DBs[dbName].db = db
+	database.DBs[dbName].written = make(map[string]bool)
+	database.DBs[dbName].conn.RegisterAuthorizer(database.DBs[dbName].authorize)
 	return database.DBs[dbName], nil
 }
 
@@ -247,6 +507,10 @@ type DB struct {
 	conn      *SQLiteConn
 	name      string
 	accountID types.AccountID
+
+	// the tables and the schema written since the database was opened
+	written       map[string]bool
+	schemaWritten bool
 }
 
 func (db *DB) beginTx(rp uint64) (Tx, error) {
@@ -323,6 +587,18 @@ func (db *DB) rollbackToRecoveryPoint(rp uint64) error {
 	return err
 }
 
+// discardRecoveryPoints deletes the commits before rp from the database.
+func (db *DB) discardRecoveryPoints(rp uint64) error {
+	if logger.IsDebugEnabled() {
+		logger.Debug().Str("db_name", db.name).Uint64("rp", rp).Msg("discard recovery points")
+	}
+	_, err := db.ExecContext(
+		context.Background(),
+		fmt.Sprintf("pragma branch_discard(master.%d)", rp),
+	)
+	return err
+}
+
 func (db *DB) snapshotView(rp uint64) error {
 	if logger.IsDebugEnabled() {
 		logger.Debug().Uint64("rp", rp).Msgf("snapshot view, %p", db.Conn)
@@ -334,6 +610,215 @@ func (db *DB) snapshotView(rp uint64) error {
 	return err
 }
 
+// authorize records the tables and the schema that the statements of the
+// connection write, including the ones of triggers and foreign key actions.
+// It allows every statement.
+func (db *DB) authorize(op int, arg1, arg2, arg3 string) int {
+	switch op {
+	case SQLITE_INSERT, SQLITE_UPDATE, SQLITE_DELETE:
+		db.written[arg1] = true
+	case SQLITE_CREATE_TABLE, SQLITE_DROP_TABLE, SQLITE_ALTER_TABLE,
+		SQLITE_CREATE_INDEX, SQLITE_DROP_INDEX,
+		SQLITE_CREATE_TRIGGER, SQLITE_DROP_TRIGGER,
+		SQLITE_CREATE_VIEW, SQLITE_DROP_VIEW,
+		SQLITE_CREATE_VTABLE, SQLITE_DROP_VTABLE:
+		db.schemaWritten = true
+	}
+	return SQLITE_OK
+}
+
+// tableHashes holds the hash of the schema of a database and the hashes of
+// the rows of each of its tables.
+type tableHashes struct {
+	schema []byte
+	tables map[string][]byte
+}
+
+// bytes encodes th in the order of the table names.
+func (th *tableHashes) bytes() []byte {
+	names := make([]string, 0, len(th.tables))
+	for name := range th.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	var l [binary.MaxVarintLen64]byte
+	buf.Write(th.schema)
+	for _, name := range names {
+		buf.Write(l[:binary.PutUvarint(l[:], uint64(len(name)))])
+		buf.WriteString(name)
+		buf.Write(th.tables[name])
+	}
+	return buf.Bytes()
+}
+
+func decodeTableHashes(b []byte) (*tableHashes, error) {
+	if len(b) < sha256.Size {
+		return nil, ErrDBHash
+	}
+	th := &tableHashes{schema: b[:sha256.Size], tables: make(map[string][]byte)}
+	for b = b[sha256.Size:]; len(b) > 0; {
+		l, n := binary.Uvarint(b)
+		if n <= 0 || uint64(len(b)-n) < l+sha256.Size {
+			return nil, ErrDBHash
+		}
+		b = b[n:]
+		th.tables[string(b[:l])] = b[l : l+sha256.Size]
+		b = b[l+sha256.Size:]
+	}
+	return th, nil
+}
+
+func digest(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return h[:]
+}
+
+// hashTables returns the hashes of the schema and the tables of the database.
+// The hashes of prev are kept for the tables and the schema that are not
+// written since the database was opened. All are computed if prev is nil.
+// The tables of sqlite, such as sqlite_sequence, are small and always hashed,
+// since they are written without statements.
+func (db *DB) hashTables(prev *tableHashes) (*tableHashes, error) {
+	th := &tableHashes{tables: make(map[string][]byte)}
+	var tables []string
+	if prev == nil || db.schemaWritten {
+		var err error
+		if th.schema, tables, err = db.schemaHash(); err != nil {
+			return nil, err
+		}
+	} else {
+		th.schema = prev.schema
+		for name := range prev.tables {
+			tables = append(tables, name)
+		}
+	}
+	for _, name := range tables {
+		if h, ok := prev.table(name); ok && !db.written[name] && !strings.HasPrefix(name, "sqlite_") {
+			th.tables[name] = h
+			continue
+		}
+		h, err := db.tableHash(name)
+		if err != nil {
+			return nil, err
+		}
+		th.tables[name] = h
+	}
+	return th, nil
+}
+
+func (th *tableHashes) table(name string) ([]byte, bool) {
+	if th == nil {
+		return nil, false
+	}
+	h, ok := th.tables[name]
+	return h, ok
+}
+
+// schemaHash returns the hash of the schema and the names of the tables.
+func (db *DB) schemaHash() ([]byte, []string, error) {
+	rows, err := db.QueryContext(context.Background(),
+		"select type, name, ifnull(sql, '') from main.sqlite_master order by type, name")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	h := sha256.New()
+	var tables []string
+	for rows.Next() {
+		var typ, name, ddl string
+		if err := rows.Scan(&typ, &name, &ddl); err != nil {
+			return nil, nil, err
+		}
+		hashValues(h, typ, name, ddl)
+		if typ == "table" {
+			tables = append(tables, name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	return h.Sum(nil), tables, nil
+}
+
+// tableHash returns the hash of the rows of table.
+func (db *DB) tableHash(table string) ([]byte, error) {
+	rows, err := db.QueryContext(context.Background(),
+		fmt.Sprintf(`select * from main."%s"`, strings.Replace(table, `"`, `""`, -1)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]interface{}, len(cols))
+	ptrs := make([]interface{}, len(cols))
+	for i := range vals {
+		ptrs[i] = &vals[i]
+	}
+	h := sha256.New()
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		hashValues(h, vals...)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// hashValues writes the values of a row to h with their types.
+func hashValues(h hash.Hash, vals ...interface{}) {
+	var b [9]byte
+	for _, v := range vals {
+		switch v := v.(type) {
+		case nil:
+			h.Write(b[:1])
+		case int64:
+			b[0] = 1
+			binary.BigEndian.PutUint64(b[1:], uint64(v))
+			h.Write(b[:])
+		case float64:
+			b[0] = 2
+			binary.BigEndian.PutUint64(b[1:], math.Float64bits(v))
+			h.Write(b[:])
+		case bool:
+			b[0], b[1] = 3, 0
+			if v {
+				b[1] = 1
+			}
+			h.Write(b[:2])
+		case []byte:
+			b[0] = 4
+			binary.BigEndian.PutUint64(b[1:], uint64(len(v)))
+			h.Write(b[:])
+			h.Write(v)
+		case string:
+			b[0] = 5
+			binary.BigEndian.PutUint64(b[1:], uint64(len(v)))
+			h.Write(b[:])
+			h.Write([]byte(v))
+		case time.Time:
+			b[0] = 6
+			binary.BigEndian.PutUint64(b[1:], uint64(v.UnixNano()))
+			h.Write(b[:])
+		default:
+			s := fmt.Sprintf("%T:%v", v, v)
+			b[0] = 7
+			binary.BigEndian.PutUint64(b[1:], uint64(len(s)))
+			h.Write(b[:])
+			h.Write([]byte(s))
+		}
+	}
+}
+
 func (db *DB) close() error {
 	err := db.Conn.Close()
 	if err != nil {
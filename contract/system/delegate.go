@@ -0,0 +1,244 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var delegationKey = []byte("delegation")
+var delegatedKey = []byte("delegated")
+
+// delegation is the voting power given by a delegator to its proxy. The amount
+// is the staking of the delegator, which follows the delegator when it stakes
+// or unstakes.
+type delegation struct {
+	proxy  []byte
+	amount *big.Int
+}
+
+func serializeDelegation(d *delegation) []byte {
+	data := make([]byte, 8)
+	amount := d.amount.Bytes()
+	binary.LittleEndian.PutUint64(data, uint64(len(amount)))
+	data = append(data, amount...)
+	return append(data, d.proxy...)
+}
+
+func deserializeDelegation(data []byte) *delegation {
+	size := binary.LittleEndian.Uint64(data[:8])
+	return &delegation{
+		amount: new(big.Int).SetBytes(data[8 : 8+size]),
+		proxy:  data[8+size:],
+	}
+}
+
+func getDelegation(scs *state.ContractState, delegator []byte) (*delegation, error) {
+	data, err := scs.GetData(append(delegationKey, delegator...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return &delegation{amount: big.NewInt(0)}, nil
+	}
+	return deserializeDelegation(data), nil
+}
+
+func setDelegation(scs *state.ContractState, delegator []byte, d *delegation) error {
+	key := append(delegationKey, delegator...)
+	if d.proxy == nil {
+		return scs.DeleteData(key)
+	}
+	return scs.SetData(key, serializeDelegation(d))
+}
+
+func getDelegated(scs *state.ContractState, proxy []byte) (*big.Int, error) {
+	data, err := scs.GetData(append(delegatedKey, proxy...))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func addDelegated(scs *state.ContractState, proxy []byte, amount *big.Int) error {
+	delegated, err := getDelegated(scs, proxy)
+	if err != nil {
+		return err
+	}
+	return scs.SetData(append(delegatedKey, proxy...), delegated.Add(delegated, amount).Bytes())
+}
+
+// votingPower returns the weight of the votes of account, which is its own
+// staking plus the voting power delegated to it.
+func votingPower(scs *state.ContractState, account []byte, staked *types.Staking) (*big.Int, error) {
+	delegated, err := getDelegated(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	return delegated.Add(delegated, staked.GetAmountBigInt()), nil
+}
+
+// withdrawDelegation takes amount of the voting power given by a delegator
+// back from proxy when the delegator unstakes. The votes of the proxy are
+// refreshed, as the votes of the delegator would be.
+func withdrawDelegation(scs *state.ContractState, proxy []byte, amount *big.Int, blockNo types.BlockNo) error {
+	if amount.Sign() == 0 {
+		return nil
+	}
+	if err := addDelegated(scs, proxy, new(big.Int).Neg(amount)); err != nil {
+		return err
+	}
+	staked, err := getStaking(scs, proxy)
+	if err != nil {
+		return err
+	}
+	power, err := votingPower(scs, proxy, staked)
+	if err != nil {
+		return err
+	}
+	return refreshVotes(scs, proxy, power, blockNo)
+}
+
+// refreshDelegation sets the voting power delegated by delegator to its
+// staking after the staking changes. Like the staking of the proxy, the raised
+// power is counted from the next vote of the proxy.
+func refreshDelegation(scs *state.ContractState, delegator []byte, staked *types.Staking, blockNo types.BlockNo) error {
+	d, err := getDelegation(scs, delegator)
+	if err != nil {
+		return err
+	}
+	if d.proxy == nil || d.amount.Cmp(staked.GetAmountBigInt()) == 0 {
+		return nil
+	}
+	diff := new(big.Int).Sub(d.amount, staked.GetAmountBigInt())
+	d.amount = staked.GetAmountBigInt()
+	if err := setDelegation(scs, delegator, d); err != nil {
+		return err
+	}
+	if diff.Sign() < 0 {
+		return addDelegated(scs, d.proxy, diff.Neg(diff))
+	}
+	return withdrawDelegation(scs, d.proxy, diff, blockNo)
+}
+
+func delegate(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	account := sender.ID()
+	staked := context.Staked
+	old := context.Delegation
+	if old.proxy != nil {
+		// the votes of the old proxy keep the revoked power until they are
+		// refreshed, when the proxy votes or its staking is lowered
+		if err := addDelegated(scs, old.proxy, new(big.Int).Neg(old.amount)); err != nil {
+			return nil, err
+		}
+	}
+
+	var proxy string
+	d := &delegation{amount: big.NewInt(0)}
+	if len(context.Call.Args) != 0 {
+		proxy = context.Call.Args[0].(string)
+		d.proxy, _ = types.DecodeAddress(proxy)
+		d.amount = staked.GetAmountBigInt()
+		// the voting power of the delegator is used only by the proxy
+		if err := refreshVotes(scs, account, big.NewInt(0), blockNo); err != nil {
+			return nil, err
+		}
+		if err := addDelegated(scs, d.proxy, d.amount); err != nil {
+			return nil, err
+		}
+	}
+	if err := setDelegation(scs, account, d); err != nil {
+		return nil, err
+	}
+	staked.When = blockNo
+	if err := setStaking(scs, account, staked); err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "delegate",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(account) +
+			`", "proxy":"` + proxy + `"}`,
+	}, nil
+}
+
+func validateForDelegation(account []byte, ci *types.CallInfo, scs *state.ContractState,
+	blockNo types.BlockNo) (*types.Staking, *delegation, error) {
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	old, err := getDelegation(scs, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	if old.proxy != nil && staked.GetWhen()+VotingDelay > blockNo {
+		return nil, nil, types.ErrLessTimeHasPassed
+	}
+	if len(ci.Args) == 0 {
+		if old.proxy == nil {
+			return nil, nil, types.ErrNotDelegated
+		}
+		return staked, old, nil
+	}
+
+	if staked.GetAmountBigInt().Sign() == 0 {
+		return nil, nil, types.ErrMustStakeBeforeVote
+	}
+	arg, ok := ci.Args[0].(string)
+	if !ok {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	proxy, err := types.DecodeAddress(arg)
+	if err != nil {
+		return nil, nil, types.ErrTxInvalidPayload
+	}
+	if bytes.Equal(proxy, account) {
+		return nil, nil, types.ErrInvalidProxy
+	}
+	// a delegation is not passed on to another proxy
+	delegated, err := getDelegated(scs, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	if delegated.Sign() != 0 {
+		return nil, nil, types.ErrInvalidProxy
+	}
+	proxyDelegation, err := getDelegation(scs, proxy)
+	if err != nil {
+		return nil, nil, err
+	}
+	if proxyDelegation.proxy != nil {
+		return nil, nil, types.ErrInvalidProxy
+	}
+	return staked, old, nil
+}
+
+// GetVotingPower returns the staking of account, the voting power delegated
+// to account, and the proxy to which account delegates its voting power.
+func GetVotingPower(scs *state.ContractState, account []byte) (*big.Int, *big.Int, []byte, error) {
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	delegated, err := getDelegated(scs, account)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	d, err := getDelegation(scs, account)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return staked.GetAmountBigInt(), delegated, d.proxy, nil
+}
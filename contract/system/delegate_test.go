@@ -0,0 +1,113 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelegate(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	delegator, err := sdb.GetAccountStateV([]byte("delegator"))
+	assert.NoError(t, err, "could not get test address state")
+	delegated := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	sender.AddBalance(types.StakingMinimum)
+	delegator.AddBalance(delegated)
+
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)},
+		sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Amount: delegated.Bytes(), Payload: buildStakingPayload(true)},
+		delegator, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	proxy := types.EncodeAddress(sender.ID())
+	tx := &types.TxBody{Payload: []byte(`{"Name":"v1delegate","Args":["` + proxy + `"]}`)}
+	events, err := ExecuteSystemTx(scs, tx, delegator, receiver, 1)
+	assert.NoError(t, err, "delegation failed")
+	assert.Equal(t, "delegate", events[0].EventName)
+	assert.Equal(t, delegator.Balance(), big.NewInt(0), "no fund is moved")
+
+	direct, power, to, err := GetVotingPower(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, types.StakingMinimum, direct)
+	assert.Equal(t, delegated, power)
+	assert.Nil(t, to)
+	_, _, to, err = GetVotingPower(scs, delegator.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, sender.ID(), to)
+
+	vote := &types.TxBody{Payload: buildVotingPayload(1)}
+	_, err = ExecuteSystemTx(scs, vote, delegator, receiver, 1)
+	assert.Equal(t, types.ErrVoteDelegated, err, "the delegator can not vote")
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, 1)
+	assert.NoError(t, err, "voting failed")
+	result, err := getVoteResult(scs, defaultVoteKey, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Add(types.StakingMinimum, delegated), result.Votes[0].GetAmountBigInt(),
+		"the proxy votes with the delegated power")
+
+	// a delegation is not passed on
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Payload: []byte(`{"Name":"v1delegate","Args":["` +
+		types.EncodeAddress(delegator.ID()) + `"]}`)}, sender, receiver, 1)
+	assert.Equal(t, types.ErrInvalidProxy, err)
+
+	// the delegation follows the staking of the delegator
+	delegator.AddBalance(types.StakingMinimum)
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)},
+		delegator, receiver, 1+StakingDelay)
+	assert.NoError(t, err, "staking failed")
+	_, power, _, err = GetVotingPower(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Add(delegated, types.StakingMinimum), power)
+
+	// the revoked power is taken from the votes of the proxy at their refresh
+	revoke := &types.TxBody{Payload: []byte(`{"Name":"v1delegate"}`)}
+	revokedAt := types.BlockNo(1 + StakingDelay + VotingDelay)
+	_, err = ExecuteSystemTx(scs, revoke, delegator, receiver, revokedAt-1)
+	assert.Equal(t, types.ErrLessTimeHasPassed, err)
+	_, err = ExecuteSystemTx(scs, revoke, delegator, receiver, revokedAt)
+	assert.NoError(t, err, "revocation failed")
+	_, power, _, err = GetVotingPower(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(0), power)
+	result, err = getVoteResult(scs, defaultVoteKey, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Add(types.StakingMinimum, delegated), result.Votes[0].GetAmountBigInt())
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, revokedAt)
+	assert.NoError(t, err, "voting failed")
+	result, err = getVoteResult(scs, defaultVoteKey, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, types.StakingMinimum, result.Votes[0].GetAmountBigInt())
+	_, err = ExecuteSystemTx(scs, revoke, delegator, receiver, revokedAt)
+	assert.Equal(t, types.ErrNotDelegated, err)
+}
+
+func TestDelegateBeforeV2(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.InitHardfork(&types.Hardfork{V2: 10})
+	defer types.InitHardfork(&types.Hardfork{})
+
+	delegator, err := sdb.GetAccountStateV([]byte("delegator"))
+	assert.NoError(t, err, "could not get test address state")
+	delegator.AddBalance(types.StakingMinimum)
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)},
+		delegator, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	proxy := types.EncodeAddress(sender.ID())
+	tx := &types.TxBody{Payload: []byte(`{"Name":"v1delegate","Args":["` + proxy + `"]}`)}
+	_, err = ExecuteSystemTx(scs, tx, delegator, receiver, 9)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "no delegation before the hardfork")
+	_, err = ExecuteSystemTx(scs, tx, delegator, receiver, 10)
+	assert.NoError(t, err, "delegation failed")
+}
@@ -13,13 +13,16 @@ import (
 )
 
 type SystemContext struct {
-	BlockNo  uint64
-	Call     *types.CallInfo
-	Args     []string
-	Staked   *types.Staking
-	Vote     *types.Vote
-	Sender   *state.V
-	Receiver *state.V
+	BlockNo    uint64
+	Call       *types.CallInfo
+	Args       []string
+	Staked     *types.Staking
+	Vote       *types.Vote
+	Reward     *big.Int
+	Delegation *delegation
+	Evidence   *evidence
+	Sender     *state.V
+	Receiver   *state.V
 }
 
 func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
@@ -35,10 +38,20 @@ func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
 	switch context.Call.Name {
 	case types.Stake:
 		event, err = staking(txBody, sender, receiver, scs, blockNo, context)
-	case types.VoteBP:
+	case types.VoteBP,
+		types.VoteGasPrice,
+		types.VoteNumBP,
+		types.VoteNamePrice,
+		types.VoteMinStaking:
 		event, err = voting(txBody, sender, receiver, scs, blockNo, context)
 	case types.Unstake:
 		event, err = unstaking(txBody, sender, receiver, scs, blockNo, context)
+	case types.Delegate:
+		event, err = delegate(txBody, sender, receiver, scs, blockNo, context)
+	case types.SubmitEvidence:
+		event, err = submitEvidence(txBody, sender, receiver, scs, blockNo, context)
+	case types.ClaimReward:
+		event, err = claimReward(txBody, sender, receiver, scs, blockNo, context)
 	default:
 		err = types.ErrTxInvalidPayload
 	}
@@ -47,33 +60,19 @@ func ExecuteSystemTx(scs *state.ContractState, txBody *types.TxBody,
 	}
 	var events []*types.Event
 	events = append(events, event)
-	return events, nil
-}
 
-func GetNamePrice(scs *state.ContractState) *big.Int {
-	votelist, err := getVoteResult(scs, []byte(types.VoteNamePrice[2:]), 1)
-	if err != nil {
-		panic("could not get vote result for min staking")
-	}
-	if len(votelist.Votes) == 0 {
-		return types.NamePrice
-	}
-	return new(big.Int).SetBytes(votelist.Votes[0].GetCandidate())
-}
-
-func GetMinimumStaking(scs *state.ContractState) *big.Int {
-	votelist, err := getVoteResult(scs, []byte(types.VoteMinStaking[2:]), 1)
-	if err != nil {
-		panic("could not get vote result for min staking")
-	}
-	if len(votelist.Votes) == 0 {
-		return types.StakingMinimum
-	}
-	minimumStaking, ok := new(big.Int).SetString(string(votelist.Votes[0].GetCandidate()), 10)
-	if !ok {
-		panic("could not get vote result for min staking")
+	for _, key := range talliedParams(context.Call.Name, blockNo) {
+		event, err = updateParam(scs, key, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		if event != nil {
+			event.ContractAddress = receiver.ID()
+			event.EventIdx = int32(len(events))
+			events = append(events, event)
+		}
 	}
-	return minimumStaking
+	return events, nil
 }
 
 func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
@@ -94,7 +93,20 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 			return nil, err
 		}
 		context.Staked = staked
-	case types.VoteBP:
+	case types.VoteBP,
+		types.VoteGasPrice,
+		types.VoteNumBP,
+		types.VoteNamePrice,
+		types.VoteMinStaking:
+		if isParamVote(ci.Name) {
+			// the votes on the parameters are accepted from the V2 hardfork
+			if !types.IsV2Fork(blockNo) {
+				return nil, types.ErrTxInvalidPayload
+			}
+			if err := validateParamVote(&ci); err != nil {
+				return nil, err
+			}
+		}
 		staked, err := getStaking(scs, account)
 		if err != nil {
 			return nil, err
@@ -102,6 +114,13 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 		if staked.GetAmountBigInt().Cmp(new(big.Int).SetUint64(0)) == 0 {
 			return nil, types.ErrMustStakeBeforeVote
 		}
+		delegating, err := getDelegation(scs, account)
+		if err != nil {
+			return nil, err
+		}
+		if delegating.proxy != nil {
+			return nil, types.ErrVoteDelegated
+		}
 		oldvote, err := GetVote(scs, account, []byte(ci.Name[2:]))
 		if err != nil {
 			return nil, err
@@ -117,6 +136,37 @@ func ValidateSystemTx(account []byte, txBody *types.TxBody, sender *state.V,
 			return nil, err
 		}
 		context.Staked = staked
+	case types.Delegate:
+		// the delegations are accepted from the V2 hardfork
+		if !types.IsV2Fork(blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		staked, delegating, err := validateForDelegation(account, &ci, scs, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		context.Staked = staked
+		context.Delegation = delegating
+	case types.SubmitEvidence:
+		// the evidences are accepted from the V2 hardfork
+		if !types.IsV2Fork(blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		evidence, err := validateForEvidence(&ci, scs, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		context.Evidence = evidence
+	case types.ClaimReward:
+		// the rewards are claimed from the V2 hardfork
+		if !types.IsV2Fork(blockNo) {
+			return nil, types.ErrTxInvalidPayload
+		}
+		reward, err := validateForClaimReward(account, scs)
+		if err != nil {
+			return nil, err
+		}
+		context.Reward = reward
 	default:
 		return nil, types.ErrTxInvalidPayload
 	}
@@ -132,7 +182,7 @@ func validateForStaking(account []byte, txBody *types.TxBody, scs *state.Contrac
 		return nil, types.ErrLessTimeHasPassed
 	}
 	toBe := new(big.Int).Add(staked.GetAmountBigInt(), txBody.GetAmountBigInt())
-	if GetMinimumStaking(scs).Cmp(toBe) > 0 {
+	if GetMinimumStaking(scs, blockNo).Cmp(toBe) > 0 {
 		return nil, types.ErrTooSmallAmount
 	}
 	return staked, nil
@@ -153,7 +203,7 @@ func validateForUnstaking(account []byte, txBody *types.TxBody, scs *state.Contr
 		return nil, types.ErrLessTimeHasPassed
 	}
 	toBe := new(big.Int).Sub(staked.GetAmountBigInt(), txBody.GetAmountBigInt())
-	if toBe.Cmp(big.NewInt(0)) != 0 && GetMinimumStaking(scs).Cmp(toBe) > 0 {
+	if toBe.Cmp(big.NewInt(0)) != 0 && GetMinimumStaking(scs, blockNo).Cmp(toBe) > 0 {
 		return nil, types.ErrTooSmallAmount
 	}
 	return staked, nil
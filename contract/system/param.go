@@ -0,0 +1,276 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+// paramChangeDelaySec is the time in seconds between the block where a new
+// value of a parameter wins the vote and the one where it takes effect.
+const paramChangeDelaySec = 60 * 60 * 24
+
+// ParamChangeDelay returns the number of the blocks produced during
+// paramChangeDelaySec, after which a new value of a parameter takes effect.
+func ParamChangeDelay() types.BlockNo {
+	return types.BlockNo(paramChangeDelaySec / consensus.BlockIntervalSec)
+}
+
+// ParamQuorum is the percentage of the total staking which the votes for a
+// new value of a parameter must exceed.
+const ParamQuorum = 50
+
+var paramKey = []byte("param")
+
+var (
+	aergo = big.NewInt(1000000000000000000)
+	gaer  = big.NewInt(1000000000)
+)
+
+// parameter is a chain parameter decided by the votes of the stakers.
+type parameter struct {
+	name string
+	min  *big.Int
+	max  *big.Int
+	// dflt returns the value before any vote reaches the quorum.
+	dflt func() *big.Int
+}
+
+var params = map[string]*parameter{
+	types.VoteGasPrice[2:]: {
+		name: "gasprice",
+		// the fee is charged by the gas from the V2 hardfork, so that a zero
+		// price would make the transactions free
+		min:  gaer,
+		max:  aergo,
+		dflt: func() *big.Int { return fee.DefaultGasPrice },
+	},
+	types.VoteNumBP[2:]: {
+		name: "numofbp",
+		min:  big.NewInt(1),
+		// the limit of the active BPs of DPoS
+		max:  big.NewInt(100),
+		dflt: func() *big.Int { return big.NewInt(int64(getDefaultBpCount())) },
+	},
+	types.VoteNamePrice[2:]: {
+		name: "nameprice",
+		// the minimum amount of the name txs checked by types.ValidateSystemTx
+		min:  aergo,
+		max:  new(big.Int).Mul(aergo, big.NewInt(1000000)),
+		dflt: func() *big.Int { return types.NamePrice },
+	},
+	types.VoteMinStaking[2:]: {
+		name: "minimumstaking",
+		min:  aergo,
+		max:  types.MaxAER,
+		dflt: func() *big.Int { return types.StakingMinimum },
+	},
+}
+
+func isParamVote(name string) bool {
+	return len(name) > 2 && params[name[2:]] != nil
+}
+
+// talliedParams returns the keys of the parameters whose votes are tallied
+// after the system tx of name. A vote on a parameter tallies the parameter.
+// The txs changing the staking tally all the parameters, since the quorum
+// depends on the total staking and the voting power of the stakers.
+func talliedParams(name string, blockNo types.BlockNo) [][]byte {
+	if isParamVote(name) {
+		return [][]byte{[]byte(name[2:])}
+	}
+	switch name {
+	case types.Stake, types.Unstake, types.Delegate, types.SubmitEvidence:
+		var keys [][]byte
+		for _, vote := range types.GetAllVotes(blockNo) {
+			if isParamVote(vote) {
+				keys = append(keys, []byte(vote[2:]))
+			}
+		}
+		return keys
+	}
+	return nil
+}
+
+// paramState is the value of a parameter with its announced change.
+type paramState struct {
+	value  *big.Int
+	next   *big.Int
+	nextAt types.BlockNo
+}
+
+func (ps *paramState) at(blockNo types.BlockNo) *big.Int {
+	if ps.next != nil && blockNo >= ps.nextAt {
+		return ps.next
+	}
+	return ps.value
+}
+
+func serializeParam(ps *paramState) []byte {
+	data := make([]byte, 16)
+	binary.LittleEndian.PutUint64(data[:8], ps.nextAt)
+	value := ps.value.Bytes()
+	binary.LittleEndian.PutUint64(data[8:], uint64(len(value)))
+	data = append(data, value...)
+	if ps.next != nil {
+		data = append(data, ps.next.Bytes()...)
+	}
+	return data
+}
+
+func deserializeParam(data []byte) *paramState {
+	ps := &paramState{nextAt: binary.LittleEndian.Uint64(data[:8])}
+	size := binary.LittleEndian.Uint64(data[8:16])
+	ps.value = new(big.Int).SetBytes(data[16 : 16+size])
+	if ps.nextAt != 0 {
+		ps.next = new(big.Int).SetBytes(data[16+size:])
+	}
+	return ps
+}
+
+func getParamState(scs *state.ContractState, key []byte) (*paramState, error) {
+	data, err := scs.GetData(append(paramKey, key...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return &paramState{value: params[string(key)].dflt()}, nil
+	}
+	return deserializeParam(data), nil
+}
+
+func setParamState(scs *state.ContractState, key []byte, ps *paramState) error {
+	return scs.SetData(append(paramKey, key...), serializeParam(ps))
+}
+
+// getParam returns the value of the parameter of key, which is in effect at
+// blockNo.
+func getParam(scs *state.ContractState, key []byte, blockNo types.BlockNo) *big.Int {
+	ps, err := getParamState(scs, key)
+	if err != nil {
+		panic("could not get parameter " + params[string(key)].name)
+	}
+	return ps.at(blockNo)
+}
+
+// validateParamVote checks that the vote for a parameter has a single value
+// in the bounds of the parameter.
+func validateParamVote(ci *types.CallInfo) error {
+	p := params[ci.Name[2:]]
+	if len(ci.Args) != 1 {
+		return types.ErrTxInvalidPayload
+	}
+	arg, ok := ci.Args[0].(string)
+	if !ok {
+		return types.ErrTxInvalidPayload
+	}
+	value, ok := new(big.Int).SetString(arg, 10)
+	if !ok || value.String() != arg {
+		return types.ErrTxInvalidPayload
+	}
+	if value.Cmp(p.min) < 0 || value.Cmp(p.max) > 0 {
+		return fmt.Errorf("%s must be between %s and %s", p.name, p.min, p.max)
+	}
+	return nil
+}
+
+// updateParam announces a change of the parameter of key, if the value voted
+// most has the votes over the quorum and differs from the value which will be
+// in effect. The change takes effect ParamChangeDelay blocks later. The
+// announced change is cancelled, if its votes no longer reach the quorum. It
+// returns the event of the announcement, or nil if there's no change.
+func updateParam(scs *state.ContractState, key []byte, blockNo types.BlockNo) (*types.Event, error) {
+	ps, err := getParamState(scs, key)
+	if err != nil {
+		return nil, err
+	}
+	if ps.next != nil && blockNo >= ps.nextAt {
+		ps.value, ps.next, ps.nextAt = ps.next, nil, 0
+	}
+	current := ps.value
+	if ps.next != nil {
+		current = ps.next
+	}
+
+	// the value in effect is kept unless a value has the votes over the quorum
+	value := ps.value
+	top, err := getVoteResult(scs, key, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(top.Votes) != 0 {
+		total, err := getStakingTotal(scs)
+		if err != nil {
+			return nil, err
+		}
+		votes := new(big.Int).Mul(top.Votes[0].GetAmountBigInt(), big.NewInt(100))
+		if votes.Cmp(new(big.Int).Mul(total, big.NewInt(ParamQuorum))) > 0 {
+			var ok bool
+			if value, ok = new(big.Int).SetString(string(top.Votes[0].GetCandidate()), 10); !ok {
+				return nil, types.ErrTxInvalidPayload
+			}
+		}
+	}
+	if value.Cmp(current) == 0 {
+		return nil, nil
+	}
+	effectiveAt := blockNo
+	if value.Cmp(ps.value) == 0 {
+		// the announced change is cancelled
+		ps.next, ps.nextAt = nil, 0
+	} else {
+		ps.next, ps.nextAt = value, blockNo+ParamChangeDelay()
+		effectiveAt = ps.nextAt
+	}
+	if err := setParamState(scs, key, ps); err != nil {
+		return nil, err
+	}
+
+	args, err := json.Marshal(map[string]interface{}{
+		"param":   params[string(key)].name,
+		"value":   value.String(),
+		"blockNo": effectiveAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		EventName: "changeParam",
+		JsonArgs:  string(args),
+	}, nil
+}
+
+// GetGasPrice returns the minimum gas price of the transactions in the block
+// of blockNo. The gas price is not charged before the V2 hardfork.
+func GetGasPrice(scs *state.ContractState, blockNo types.BlockNo) *big.Int {
+	if !types.IsV2Fork(blockNo) {
+		return big.NewInt(0)
+	}
+	return getParam(scs, []byte(types.VoteGasPrice[2:]), blockNo)
+}
+
+// GetBpCount returns the number of the BPs elected at blockNo.
+func GetBpCount(scs *state.ContractState, blockNo types.BlockNo) int {
+	return int(getParam(scs, []byte(types.VoteNumBP[2:]), blockNo).Int64())
+}
+
+// GetNamePrice returns the price of creating or updating a name at blockNo.
+func GetNamePrice(scs *state.ContractState, blockNo types.BlockNo) *big.Int {
+	return getParam(scs, []byte(types.VoteNamePrice[2:]), blockNo)
+}
+
+// GetMinimumStaking returns the minimum amount of staking at blockNo.
+func GetMinimumStaking(scs *state.ContractState, blockNo types.BlockNo) *big.Int {
+	return getParam(scs, []byte(types.VoteMinStaking[2:]), blockNo)
+}
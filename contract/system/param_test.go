@@ -0,0 +1,134 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/fee"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamVote(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	other, err := sdb.GetAccountStateV([]byte("other"))
+	assert.NoError(t, err, "could not get test address state")
+	sender.AddBalance(types.StakingMinimum)
+	other.AddBalance(types.StakingMinimum)
+
+	stake := &types.TxBody{
+		Amount:  types.StakingMinimum.Bytes(),
+		Payload: buildStakingPayload(true),
+	}
+	_, err = ExecuteSystemTx(scs, stake, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+
+	dflt := GetBpCount(scs, 0)
+
+	vote := &types.TxBody{Payload: []byte(`{"Name":"v1voteNumBP","Args":["101"]}`)}
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, 1)
+	assert.Error(t, err, "out of the bounds")
+	vote.Payload = []byte(`{"Name":"v1voteNumBP","Args":["5","6"]}`)
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, 1)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "multiple values")
+
+	// the only staker makes the quorum
+	var blockNo types.BlockNo = 1
+	delay := ParamChangeDelay()
+	vote.Payload = []byte(`{"Name":"v1voteNumBP","Args":["5"]}`)
+	events, err := ExecuteSystemTx(scs, vote, sender, receiver, blockNo)
+	assert.NoError(t, err, "voting failed")
+	assert.Len(t, events, 2, "change is announced")
+	assert.Equal(t, "changeParam", events[1].EventName)
+	assert.Equal(t, dflt, GetBpCount(scs, blockNo+delay-1), "not changed before the announced block")
+	assert.Equal(t, 5, GetBpCount(scs, blockNo+delay), "changed at the announced block")
+
+	// the announced change is cancelled when the staking of another staker
+	// makes its votes fall to the half of the staking
+	events, err = ExecuteSystemTx(scs, stake, other, receiver, blockNo)
+	assert.NoError(t, err, "staking failed")
+	assert.Len(t, events, 2, "change is cancelled")
+	assert.Equal(t, "changeParam", events[1].EventName)
+	assert.Equal(t, dflt, GetBpCount(scs, blockNo+delay))
+
+	// the votes of the half of the staking do not make the quorum
+	vote.Payload = []byte(`{"Name":"v1voteNumBP","Args":["7"]}`)
+	events, err = ExecuteSystemTx(scs, vote, other, receiver, blockNo+1)
+	assert.NoError(t, err, "voting failed")
+	assert.Len(t, events, 1, "no change")
+	assert.Equal(t, dflt, GetBpCount(scs, blockNo+delay))
+
+	// the votes of both stakers make the quorum
+	blockNo += VotingDelay
+	vote.Payload = []byte(`{"Name":"v1voteNumBP","Args":["7"]}`)
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, blockNo)
+	assert.NoError(t, err, "voting failed")
+	assert.Equal(t, 7, GetBpCount(scs, blockNo+delay))
+	assert.Equal(t, dflt, GetBpCount(scs, blockNo+delay-1))
+
+	// the minimum staking follows the vote
+	minStaking := new(big.Int).Mul(types.StakingMinimum, big.NewInt(2))
+	vote.Payload = []byte(`{"Name":"v1voteMinStaking","Args":["` + minStaking.String() + `"]}`)
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, blockNo)
+	assert.NoError(t, err, "voting failed")
+	_, err = ExecuteSystemTx(scs, vote, other, receiver, blockNo)
+	assert.NoError(t, err, "voting failed")
+	assert.Equal(t, types.StakingMinimum, GetMinimumStaking(scs, blockNo))
+	assert.Equal(t, minStaking, GetMinimumStaking(scs, blockNo+delay))
+
+	other.AddBalance(types.StakingMinimum)
+	_, err = ExecuteSystemTx(scs, stake, other, receiver, blockNo+delay)
+	assert.NoError(t, err, "staking up to the new minimum")
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Amount: big.NewInt(1).Bytes(), Payload: buildStakingPayload(false)},
+		other, receiver, blockNo+delay+StakingDelay)
+	assert.Equal(t, types.ErrTooSmallAmount, err, "staking under the new minimum")
+}
+
+func TestParamVoteBeforeV2(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.InitHardfork(&types.Hardfork{V2: 10})
+	defer types.InitHardfork(&types.Hardfork{})
+
+	sender.AddBalance(types.StakingMinimum)
+	stake := &types.TxBody{
+		Amount:  types.StakingMinimum.Bytes(),
+		Payload: buildStakingPayload(true),
+	}
+	events, err := ExecuteSystemTx(scs, stake, sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	assert.Len(t, events, 1, "no parameter is tallied")
+
+	vote := &types.TxBody{Payload: []byte(`{"Name":"v1voteNumBP","Args":["5"]}`)}
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, 1)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "parameter vote before the hardfork")
+}
+
+func TestGasPrice(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	types.InitHardfork(&types.Hardfork{V2: 10})
+	defer types.InitHardfork(&types.Hardfork{})
+
+	assert.Equal(t, big.NewInt(0), GetGasPrice(scs, 9), "not charged before V2")
+	assert.Equal(t, fee.DefaultGasPrice, GetGasPrice(scs, 10), "default from V2")
+	assert.True(t, GetGasPrice(scs, 10).Sign() > 0)
+
+	sender.AddBalance(types.StakingMinimum)
+	stake := &types.TxBody{
+		Amount:  types.StakingMinimum.Bytes(),
+		Payload: buildStakingPayload(true),
+	}
+	_, err := ExecuteSystemTx(scs, stake, sender, receiver, 10)
+	assert.NoError(t, err, "staking failed")
+	vote := &types.TxBody{Payload: []byte(`{"Name":"v1voteGasPrice","Args":["0"]}`)}
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, 11)
+	assert.Error(t, err, "zero gas price")
+}
@@ -0,0 +1,231 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+)
+
+var rewardKey = []byte("reward")
+var rewardPerStakeKey = []byte("rewardperstake")
+var votedTotalKey = []byte("votedtotal")
+
+// rewardPrecision scales the accumulated reward per voted stake, so that a
+// small reward shared by a large stake is not rounded down to zero.
+var rewardPrecision = new(big.Int).Exp(big.NewInt(10), big.NewInt(36), nil)
+
+// issuance is the schedule of the coins minted in each block, which is nil if
+// the chain mints no coin.
+var issuance *types.Issuance
+
+// InitIssuance sets the issuance of the chain from its genesis.
+func InitIssuance(i *types.Issuance) {
+	issuance = i
+}
+
+// isRewarded reports whether the voters are rewarded at blockNo, which is the
+// case from the V2 hardfork on the chains minting coins.
+func isRewarded(blockNo types.BlockNo) bool {
+	return issuance != nil && types.IsV2Fork(blockNo)
+}
+
+// voterReward is the reward accrued by a voter until the last change of its
+// voted stake, with the accumulated reward per voted stake at that time. The
+// reward earned since then is computed from the current accumulated reward per
+// voted stake, so that minting a block does not iterate over the voters. The
+// voted stake is the one counted in the voted total. The votes cast before
+// the voters are rewarded are not counted until the voter votes again.
+type voterReward struct {
+	accrued  *big.Int
+	voted    *big.Int
+	perStake *big.Int
+}
+
+func (r *voterReward) pending(perStake *big.Int) *big.Int {
+	earned := new(big.Int).Sub(perStake, r.perStake)
+	earned.Mul(earned, r.voted)
+	earned.Div(earned, rewardPrecision)
+	return earned.Add(earned, r.accrued)
+}
+
+func serializeVoterReward(r *voterReward) []byte {
+	data := make([]byte, 0, 16)
+	for _, v := range []*big.Int{r.accrued, r.voted} {
+		b := v.Bytes()
+		data = append(data, make([]byte, 8)...)
+		binary.LittleEndian.PutUint64(data[len(data)-8:], uint64(len(b)))
+		data = append(data, b...)
+	}
+	return append(data, r.perStake.Bytes()...)
+}
+
+func deserializeVoterReward(data []byte) *voterReward {
+	next := func() *big.Int {
+		size := binary.LittleEndian.Uint64(data[:8])
+		v := new(big.Int).SetBytes(data[8 : 8+size])
+		data = data[8+size:]
+		return v
+	}
+	r := &voterReward{accrued: next(), voted: next()}
+	r.perStake = new(big.Int).SetBytes(data)
+	return r
+}
+
+func getVoterReward(scs *state.ContractState, voter []byte) (*voterReward, error) {
+	data, err := scs.GetData(append(rewardKey, voter...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return &voterReward{accrued: big.NewInt(0), voted: big.NewInt(0), perStake: big.NewInt(0)}, nil
+	}
+	return deserializeVoterReward(data), nil
+}
+
+func setVoterReward(scs *state.ContractState, voter []byte, r *voterReward) error {
+	return scs.SetData(append(rewardKey, voter...), serializeVoterReward(r))
+}
+
+func getRewardPerStake(scs *state.ContractState) (*big.Int, error) {
+	data, err := scs.GetData(rewardPerStakeKey)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func getVotedTotal(scs *state.ContractState) (*big.Int, error) {
+	data, err := scs.GetData(votedTotalKey)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// votedStake returns the stake of the BP vote, which is rewarded. A vote
+// without any candidate is not rewarded.
+func votedStake(vote *types.Vote) *big.Int {
+	if len(vote.GetCandidate()) == 0 {
+		return big.NewInt(0)
+	}
+	return vote.GetAmountBigInt()
+}
+
+// updateVotedStake settles the reward of voter before its voted stake changes
+// to voted. The first vote of a voter after the voters are rewarded creates
+// its record, which counts its voted stake in the voted total from then on.
+func updateVotedStake(scs *state.ContractState, voter []byte, voted *big.Int) error {
+	perStake, err := getRewardPerStake(scs)
+	if err != nil {
+		return err
+	}
+	r, err := getVoterReward(scs, voter)
+	if err != nil {
+		return err
+	}
+	before := r.voted
+	r.accrued = r.pending(perStake)
+	r.voted = voted
+	r.perStake = perStake
+	if err := setVoterReward(scs, voter, r); err != nil {
+		return err
+	}
+
+	if before.Cmp(voted) == 0 {
+		return nil
+	}
+	total, err := getVotedTotal(scs)
+	if err != nil {
+		return err
+	}
+	total.Add(total, voted)
+	total.Sub(total, before)
+	return scs.SetData(votedTotalKey, total.Bytes())
+}
+
+func getReward(scs *state.ContractState, voter []byte) (*big.Int, error) {
+	perStake, err := getRewardPerStake(scs)
+	if err != nil {
+		return nil, err
+	}
+	r, err := getVoterReward(scs, voter)
+	if err != nil {
+		return nil, err
+	}
+	return r.pending(perStake), nil
+}
+
+// MintReward mints the coins of the block of blockNo according to issuance.
+// The share of the voters is added to the balance of the system account and
+// to the accumulated reward per voted stake, from which each voter claims its
+// reward. It returns the share of the BP producing the block.
+func MintReward(scs *state.ContractState, issuance *types.Issuance, blockNo types.BlockNo) (*big.Int, error) {
+	bpReward := issuance.BpRewardAt(blockNo)
+	pool := new(big.Int).Sub(issuance.RewardAt(blockNo), bpReward)
+	if pool.Sign() == 0 {
+		return bpReward, nil
+	}
+	total, err := getVotedTotal(scs)
+	if err != nil {
+		return nil, err
+	}
+	if total.Sign() == 0 {
+		// no voter to be rewarded, so the share of the voters is not minted
+		return bpReward, nil
+	}
+	perStake, err := getRewardPerStake(scs)
+	if err != nil {
+		return nil, err
+	}
+	earned := new(big.Int).Mul(pool, rewardPrecision)
+	perStake.Add(perStake, earned.Div(earned, total))
+	if err := scs.SetData(rewardPerStakeKey, perStake.Bytes()); err != nil {
+		return nil, err
+	}
+	scs.SetBalance(new(big.Int).Add(scs.GetBalance(), pool))
+	return bpReward, nil
+}
+
+func claimReward(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	perStake, err := getRewardPerStake(scs)
+	if err != nil {
+		return nil, err
+	}
+	r, err := getVoterReward(scs, sender.ID())
+	if err != nil {
+		return nil, err
+	}
+	r.accrued, r.perStake = big.NewInt(0), perStake
+	if err := setVoterReward(scs, sender.ID(), r); err != nil {
+		return nil, err
+	}
+	sender.AddBalance(context.Reward)
+	receiver.SubBalance(context.Reward)
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "claimReward",
+		JsonArgs: `{"who":"` +
+			types.EncodeAddress(sender.ID()) +
+			`", "amount":"` + context.Reward.String() + `"}`,
+	}, nil
+}
+
+func validateForClaimReward(account []byte, scs *state.ContractState) (*big.Int, error) {
+	reward, err := getReward(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	if reward.Sign() == 0 {
+		return nil, types.ErrNoReward
+	}
+	return reward, nil
+}
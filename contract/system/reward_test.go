@@ -0,0 +1,139 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimReward(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+
+	issuance := &types.Issuance{Reward: "1000", BpShare: 20, HalvingInterval: 10}
+	InitIssuance(issuance)
+	defer InitIssuance(nil)
+	assert.Equal(t, big.NewInt(1000), issuance.RewardAt(9))
+	assert.Equal(t, big.NewInt(500), issuance.RewardAt(10))
+
+	// no voter to be rewarded yet
+	bpReward, err := MintReward(scs, issuance, 1)
+	assert.NoError(t, err, "minting failed")
+	assert.Equal(t, big.NewInt(200), bpReward)
+	assert.Equal(t, big.NewInt(0), scs.GetBalance(), "the share of the voters is not minted")
+
+	other, err := sdb.GetAccountStateV([]byte("other"))
+	assert.NoError(t, err, "could not get test address state")
+	otherStaking := new(big.Int).Mul(types.StakingMinimum, big.NewInt(3))
+	sender.AddBalance(types.StakingMinimum)
+	other.AddBalance(otherStaking)
+
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)},
+		sender, receiver, 1)
+	assert.NoError(t, err, "staking failed")
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Amount: otherStaking.Bytes(), Payload: buildStakingPayload(true)},
+		other, receiver, 1)
+	assert.NoError(t, err, "staking failed")
+
+	// the stake without a vote is not rewarded
+	_, err = MintReward(scs, issuance, 2)
+	assert.NoError(t, err, "minting failed")
+	claim := &types.TxBody{Payload: []byte(`{"Name":"v1claimReward"}`)}
+	_, err = ExecuteSystemTx(scs, claim, sender, receiver, 2)
+	assert.Equal(t, types.ErrNoReward, err)
+
+	vote := &types.TxBody{Payload: buildVotingPayload(1)}
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, 2)
+	assert.NoError(t, err, "voting failed")
+	_, err = ExecuteSystemTx(scs, vote, other, receiver, 2)
+	assert.NoError(t, err, "voting failed")
+
+	_, err = MintReward(scs, issuance, 3)
+	assert.NoError(t, err, "minting failed")
+	assert.Equal(t, big.NewInt(800), scs.GetBalance())
+	staking, err := GetStaking(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(200), staking.GetRewardBigInt())
+	staking, err = GetStaking(scs, other.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(600), staking.GetRewardBigInt())
+
+	// the reward accrued before a change of the voted stake is kept
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(false)},
+		other, receiver, 2+StakingDelay)
+	assert.NoError(t, err, "unstaking failed")
+	_, err = MintReward(scs, issuance, 4)
+	assert.NoError(t, err, "minting failed")
+	staking, err = GetStaking(scs, other.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(600+533), staking.GetRewardBigInt())
+
+	balance := sender.Balance()
+	events, err := ExecuteSystemTx(scs, claim, sender, receiver, 5)
+	assert.NoError(t, err, "claiming failed")
+	assert.Equal(t, "claimReward", events[0].EventName)
+	assert.Equal(t, new(big.Int).Add(balance, big.NewInt(200+266)), sender.Balance())
+	_, err = ExecuteSystemTx(scs, claim, sender, receiver, 5)
+	assert.Equal(t, types.ErrNoReward, err, "already claimed")
+}
+
+func TestRewardVotesBeforeV2(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	types.InitHardfork(&types.Hardfork{V2: 10})
+	defer types.InitHardfork(&types.Hardfork{})
+	issuance := &types.Issuance{Reward: "1000", BpShare: 20}
+	InitIssuance(issuance)
+	defer InitIssuance(nil)
+
+	other, err := sdb.GetAccountStateV([]byte("other"))
+	assert.NoError(t, err, "could not get test address state")
+	sender.AddBalance(types.StakingMinimum)
+	other.AddBalance(types.StakingMinimum)
+	stake := &types.TxBody{Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	_, err = ExecuteSystemTx(scs, stake, sender, receiver, 1)
+	assert.NoError(t, err, "staking failed")
+	_, err = ExecuteSystemTx(scs, stake, other, receiver, 1)
+	assert.NoError(t, err, "staking failed")
+
+	// the vote before the hardfork is not counted in the voted total
+	vote := &types.TxBody{Payload: buildVotingPayload(1)}
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, 2)
+	assert.NoError(t, err, "voting failed")
+	claim := &types.TxBody{Payload: []byte(`{"Name":"v1claimReward"}`)}
+	_, err = ExecuteSystemTx(scs, claim, sender, receiver, 2)
+	assert.Equal(t, types.ErrTxInvalidPayload, err, "no claim before the hardfork")
+	total, err := getVotedTotal(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(0), total)
+
+	// nor rewarded after it
+	_, err = ExecuteSystemTx(scs, vote, other, receiver, 10)
+	assert.NoError(t, err, "voting failed")
+	_, err = MintReward(scs, issuance, 11)
+	assert.NoError(t, err, "minting failed")
+	staking, err := GetStaking(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(0), staking.GetRewardBigInt())
+	staking, err = GetStaking(scs, other.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(800), staking.GetRewardBigInt())
+
+	// until the voter votes again
+	_, err = ExecuteSystemTx(scs, vote, sender, receiver, 2+VotingDelay)
+	assert.NoError(t, err, "voting failed")
+	total, err = getVotedTotal(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Mul(types.StakingMinimum, big.NewInt(2)), total)
+	_, err = MintReward(scs, issuance, 3+VotingDelay)
+	assert.NoError(t, err, "minting failed")
+	staking, err = GetStaking(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(400), staking.GetRewardBigInt())
+}
@@ -0,0 +1,453 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package system
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+)
+
+// SlashRate is the percentage of the staking of a BP taken away for signing
+// two different blocks for the same slot.
+const SlashRate = 10
+
+// jailPeriodSec is the time in seconds during which a jailed BP is not
+// elected.
+const jailPeriodSec = 60 * 60 * 24
+
+// JailPeriod returns the number of the blocks produced during jailPeriodSec.
+func JailPeriod() types.BlockNo {
+	return types.BlockNo(jailPeriodSec / consensus.BlockIntervalSec)
+}
+
+// MaxMissedSlots is the number of the consecutive slots which a BP may miss
+// before it is jailed.
+const MaxMissedSlots = 50
+
+var penaltyKey = []byte("penalty")
+var penalizedKey = []byte("penalized")
+var evidenceKey = []byte("evidence")
+var electedKey = []byte("elected")
+var prevElectedKey = []byte("prevelected")
+
+var chainID []byte
+
+var (
+	errInvalidEvidence = errors.New("invalid evidence of double signing")
+	errEvidenceUsed    = errors.New("evidence already submitted")
+)
+
+// InitChainID sets the chain ID, to which the block headers of an evidence
+// must belong.
+func InitChainID(id []byte) {
+	chainID = id
+}
+
+// Penalty shows the penalties given to a BP.
+type Penalty struct {
+	BPID        string
+	MissedSlots uint64
+	JailedUntil types.BlockNo
+	Slashed     string
+}
+
+// penalty is the record of the penalties given to a BP.
+type penalty struct {
+	missed      uint64
+	jailedUntil types.BlockNo
+	slashed     *big.Int
+}
+
+func serializePenalty(p *penalty) []byte {
+	data := make([]byte, 16)
+	binary.LittleEndian.PutUint64(data[:8], p.missed)
+	binary.LittleEndian.PutUint64(data[8:], p.jailedUntil)
+	return append(data, p.slashed.Bytes()...)
+}
+
+func deserializePenalty(data []byte) *penalty {
+	return &penalty{
+		missed:      binary.LittleEndian.Uint64(data[:8]),
+		jailedUntil: binary.LittleEndian.Uint64(data[8:16]),
+		slashed:     new(big.Int).SetBytes(data[16:]),
+	}
+}
+
+func getPenalty(scs *state.ContractState, bp []byte) (*penalty, error) {
+	data, err := scs.GetData(append(penaltyKey, bp...))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return &penalty{slashed: big.NewInt(0)}, nil
+	}
+	return deserializePenalty(data), nil
+}
+
+func setPenalty(scs *state.ContractState, bp []byte, p *penalty) error {
+	key := append(penaltyKey, bp...)
+	if !scs.HasKey(key) {
+		if err := addPenalized(scs, bp); err != nil {
+			return err
+		}
+	}
+	return scs.SetData(key, serializePenalty(p))
+}
+
+func serializeIDs(ids []types.PeerID) []byte {
+	var data []byte
+	for _, id := range ids {
+		data = append(append(data, byte(len(id))), id...)
+	}
+	return data
+}
+
+func deserializeIDs(data []byte) []types.PeerID {
+	var ids []types.PeerID
+	for offset := 0; offset < len(data); {
+		size := int(data[offset])
+		ids = append(ids, types.PeerID(data[offset+1:offset+1+size]))
+		offset += 1 + size
+	}
+	return ids
+}
+
+// getPenalized returns the IDs of the BPs which have ever been penalized. Only
+// the elected BPs are penalized, which bounds their number.
+func getPenalized(scs *state.ContractState) ([]types.PeerID, error) {
+	data, err := scs.GetData(penalizedKey)
+	if err != nil {
+		return nil, err
+	}
+	return deserializeIDs(data), nil
+}
+
+func addPenalized(scs *state.ContractState, bp []byte) error {
+	data, err := scs.GetData(penalizedKey)
+	if err != nil {
+		return err
+	}
+	data = append(data, serializeIDs([]types.PeerID{types.PeerID(bp)})...)
+	return scs.SetData(penalizedKey, data)
+}
+
+// updateElected records bps, the BPs elected for the block of blockNo, if
+// they differ from the ones of the last election. The BPs of the last two
+// elections are kept, against which the evidences are checked.
+func updateElected(scs *state.ContractState, bps []types.PeerID, blockNo types.BlockNo) error {
+	data, err := scs.GetData(electedKey)
+	if err != nil {
+		return err
+	}
+	ids := serializeIDs(bps)
+	if len(data) != 0 && bytes.Equal(data[8:], ids) {
+		return nil
+	}
+	if len(data) != 0 {
+		if err := scs.SetData(prevElectedKey, data); err != nil {
+			return err
+		}
+	}
+	since := make([]byte, 8)
+	binary.LittleEndian.PutUint64(since, blockNo)
+	return scs.SetData(electedKey, append(since, ids...))
+}
+
+// isElected reports whether bp was elected for the block of blockNo. It is
+// false if the election of the block is older than the last two ones.
+func isElected(scs *state.ContractState, bp types.PeerID, blockNo types.BlockNo) (bool, error) {
+	for _, key := range [][]byte{electedKey, prevElectedKey} {
+		data, err := scs.GetData(key)
+		if err != nil {
+			return false, err
+		}
+		if len(data) == 0 {
+			return false, nil
+		}
+		if binary.LittleEndian.Uint64(data[:8]) > blockNo {
+			continue
+		}
+		for _, id := range deserializeIDs(data[8:]) {
+			if id == bp {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return false, nil
+}
+
+func isJailed(scs *state.ContractState, bp []byte, blockNo types.BlockNo) (bool, error) {
+	p, err := getPenalty(scs, bp)
+	if err != nil {
+		return false, err
+	}
+	return p.jailedUntil > blockNo, nil
+}
+
+// RecordSlots resets the count of the missed slots of producer, which
+// produced the block of blockNo, and counts a missed slot for each BP in
+// missed. A BP which missed MaxMissedSlots consecutive slots is jailed. The
+// BPs elected for the block, bps, are recorded as well.
+func RecordSlots(scs *state.ContractState, bps []types.PeerID, producer types.PeerID, missed []types.PeerID,
+	blockNo types.BlockNo) error {
+	if err := updateElected(scs, bps, blockNo); err != nil {
+		return err
+	}
+	p, err := getPenalty(scs, []byte(producer))
+	if err != nil {
+		return err
+	}
+	if p.missed != 0 {
+		p.missed = 0
+		if err := setPenalty(scs, []byte(producer), p); err != nil {
+			return err
+		}
+	}
+	for _, id := range missed {
+		p, err := getPenalty(scs, []byte(id))
+		if err != nil {
+			return err
+		}
+		p.missed++
+		if p.missed >= MaxMissedSlots {
+			p.missed = 0
+			p.jailedUntil = blockNo + JailPeriod()
+		}
+		if err := setPenalty(scs, []byte(id), p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// slotIndex returns the index of the slot of a block produced at ts.
+func slotIndex(ts int64) int64 {
+	ms := ts / 1000000
+	intervalMs := consensus.BlockIntervalSec * 1000
+	return (ms-1)/intervalMs + 1
+}
+
+func decodeEvidence(arg interface{}) (*types.BlockHeader, error) {
+	encoded, ok := arg.(string)
+	if !ok {
+		return nil, types.ErrTxInvalidPayload
+	}
+	raw, err := enc.ToBytes(encoded)
+	if err != nil {
+		return nil, types.ErrTxInvalidPayload
+	}
+	header := &types.BlockHeader{}
+	if err := proto.Unmarshal(raw, header); err != nil {
+		return nil, types.ErrTxInvalidPayload
+	}
+	return header, nil
+}
+
+// evidence is two different block headers signed by the same BP for the same
+// slot. The account is the one of the key of the BP, whose staking is slashed.
+type evidence struct {
+	bp      types.PeerID
+	account []byte
+	slot    int64
+	headers [2]*types.BlockHeader
+}
+
+func (e *evidence) key() []byte {
+	slot := make([]byte, 8)
+	binary.LittleEndian.PutUint64(slot, uint64(e.slot))
+	return append(append(evidenceKey, e.bp...), slot...)
+}
+
+// bpAccount returns the account of the key which signed header.
+func bpAccount(header *types.BlockHeader) ([]byte, error) {
+	pubKey, err := crypto.UnmarshalPublicKey(header.GetPubKey())
+	if err != nil {
+		return nil, err
+	}
+	account, err := pubKey.Raw()
+	if err != nil {
+		return nil, err
+	}
+	if len(account) != types.AddressLength {
+		return nil, errInvalidEvidence
+	}
+	return account, nil
+}
+
+func validateForEvidence(ci *types.CallInfo, scs *state.ContractState, blockNo types.BlockNo) (*evidence, error) {
+	if len(ci.Args) != 2 {
+		return nil, types.ErrTxInvalidPayload
+	}
+	e := &evidence{}
+	for i, arg := range ci.Args {
+		header, err := decodeEvidence(arg)
+		if err != nil {
+			return nil, err
+		}
+		if len(chainID) != 0 && !bytes.Equal(header.GetChainID(), chainID) {
+			return nil, errInvalidEvidence
+		}
+		if header.GetBlockNo() >= blockNo {
+			return nil, errInvalidEvidence
+		}
+		block := &types.Block{Header: header}
+		if valid, err := block.VerifySign(); !valid || err != nil {
+			return nil, errInvalidEvidence
+		}
+		id, err := block.BPID()
+		if err != nil {
+			return nil, errInvalidEvidence
+		}
+		if i == 0 {
+			e.bp, e.slot = id, slotIndex(header.GetTimestamp())
+		} else if id != e.bp || slotIndex(header.GetTimestamp()) != e.slot {
+			return nil, errInvalidEvidence
+		}
+		// only a BP elected for the block can sign it
+		if elected, err := isElected(scs, id, header.GetBlockNo()); err != nil {
+			return nil, err
+		} else if !elected {
+			return nil, errInvalidEvidence
+		}
+		e.headers[i] = header
+	}
+	h0 := (&types.Block{Header: e.headers[0]}).BlockHash()
+	h1 := (&types.Block{Header: e.headers[1]}).BlockHash()
+	if bytes.Equal(h0, h1) {
+		return nil, errInvalidEvidence
+	}
+	account, err := bpAccount(e.headers[0])
+	if err != nil {
+		return nil, errInvalidEvidence
+	}
+	e.account = account
+	data, err := scs.GetData(e.key())
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 0 {
+		return nil, errEvidenceUsed
+	}
+	return e, nil
+}
+
+// slashStaking takes SlashRate percent of the staking of account away. The
+// slashed coins are burnt. It returns the slashed amount.
+func slashStaking(scs *state.ContractState, account []byte, receiver *state.V, blockNo types.BlockNo) (*big.Int, error) {
+	staked, err := getStaking(scs, account)
+	if err != nil {
+		return nil, err
+	}
+	slashed := new(big.Int).Mul(staked.GetAmountBigInt(), big.NewInt(SlashRate))
+	slashed.Div(slashed, big.NewInt(100))
+	if slashed.Sign() == 0 {
+		return slashed, nil
+	}
+	staked.Amount = new(big.Int).Sub(staked.GetAmountBigInt(), slashed).Bytes()
+	if err := setStaking(scs, account, staked); err != nil {
+		return nil, err
+	}
+	if err := subTotal(scs, slashed); err != nil {
+		return nil, err
+	}
+	if err := refreshDelegation(scs, account, staked, blockNo); err != nil {
+		return nil, err
+	}
+	power, err := votingPower(scs, account, staked)
+	if err != nil {
+		return nil, err
+	}
+	if err := refreshVotes(scs, account, power, blockNo); err != nil {
+		return nil, err
+	}
+	receiver.SubBalance(slashed)
+	return slashed, nil
+}
+
+// submitEvidence slashes the staking of the account of the key of the BP which
+// signed the block headers in the evidence, and jails the BP.
+func submitEvidence(txBody *types.TxBody, sender, receiver *state.V, scs *state.ContractState,
+	blockNo types.BlockNo, context *SystemContext) (*types.Event, error) {
+	e := context.Evidence
+	if err := scs.SetData(e.key(), []byte{1}); err != nil {
+		return nil, err
+	}
+
+	total, err := slashStaking(scs, e.account, receiver, blockNo)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := getPenalty(scs, []byte(e.bp))
+	if err != nil {
+		return nil, err
+	}
+	p.jailedUntil = blockNo + JailPeriod()
+	p.slashed.Add(p.slashed, total)
+	if err := setPenalty(scs, []byte(e.bp), p); err != nil {
+		return nil, err
+	}
+
+	args, err := json.Marshal(map[string]interface{}{
+		"bp":          types.IDB58Encode(e.bp),
+		"slashed":     total.String(),
+		"jailedUntil": p.jailedUntil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Event{
+		ContractAddress: receiver.ID(),
+		EventIdx:        0,
+		EventName:       "slash",
+		JsonArgs:        string(args),
+	}, nil
+}
+
+// GetPenalties returns the penalties of all the BPs which have ever been
+// penalized.
+func GetPenalties(scs *state.ContractState) ([]*Penalty, error) {
+	ids, err := getPenalized(scs)
+	if err != nil {
+		return nil, err
+	}
+	penalties := make([]*Penalty, 0, len(ids))
+	for _, id := range ids {
+		p, err := getPenalty(scs, []byte(id))
+		if err != nil {
+			return nil, err
+		}
+		penalties = append(penalties, &Penalty{
+			BPID:        types.IDB58Encode(id),
+			MissedSlots: p.missed,
+			JailedUntil: p.jailedUntil,
+			Slashed:     p.slashed.String(),
+		})
+	}
+	return penalties, nil
+}
+
+// GetPenalty returns the number of the consecutive slots missed by the BP of
+// id, the block number until which it is jailed, and the total amount slashed
+// for it.
+func GetPenalty(scs *state.ContractState, id types.PeerID) (uint64, types.BlockNo, *big.Int, error) {
+	p, err := getPenalty(scs, []byte(id))
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	return p.missed, p.jailedUntil, p.slashed, nil
+}
@@ -0,0 +1,208 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package system
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/consensus"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+type testStateReader struct {
+	scs *state.ContractState
+}
+
+func (r *testStateReader) GetSystemAccountState() (*state.ContractState, error) {
+	return r.scs, nil
+}
+
+func signedHeader(t *testing.T, privKey crypto.PrivKey, blockNo types.BlockNo, ts int64, coinbase []byte) string {
+	block := &types.Block{Header: &types.BlockHeader{
+		BlockNo:         blockNo,
+		Timestamp:       ts,
+		CoinbaseAccount: coinbase,
+	}}
+	assert.NoError(t, block.Sign(privKey), "signing failed")
+	raw, err := proto.Marshal(block.GetHeader())
+	assert.NoError(t, err)
+	return enc.ToString(raw)
+}
+
+func TestSubmitEvidence(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	InitDefaultBpCount(3)
+
+	privKey, pubKey, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	assert.NoError(t, err)
+	bp, err := types.IDFromPublicKey(pubKey)
+	assert.NoError(t, err)
+	raw, err := pubKey.Raw()
+	assert.NoError(t, err)
+	bpAccount, err := sdb.GetAccountStateV(raw)
+	assert.NoError(t, err, "could not get test address state")
+
+	stake := &types.TxBody{Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)}
+	for _, staker := range []*state.V{sender, bpAccount} {
+		staker.AddBalance(types.StakingMinimum)
+		_, err = ExecuteSystemTx(scs, stake, staker, receiver, 0)
+		assert.NoError(t, err, "staking failed")
+	}
+	vote := &types.TxBody{Payload: []byte(`{"Name":"v1voteBP","Args":["` + types.IDB58Encode(bp) + `"]}`)}
+	_, err = ExecuteSystemTx(scs, vote, bpAccount, receiver, 0)
+	assert.NoError(t, err, "voting failed")
+
+	reader := &testStateReader{scs: scs}
+	rankers, err := GetRankers(reader, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{enc.ToString([]byte(bp))}, rankers)
+
+	ts := int64(1000) * 1000000
+	// the coinbase account of the headers is not slashed
+	first := signedHeader(t, privKey, 10, ts, sender.ID())
+	second := signedHeader(t, privKey, 11, ts, sender.ID())
+	submit := func(args ...string) *types.TxBody {
+		payload, _ := json.Marshal(map[string]interface{}{"Name": types.SubmitEvidence, "Args": args})
+		return &types.TxBody{Payload: payload}
+	}
+
+	_, err = ExecuteSystemTx(scs, submit(first, second), sender, receiver, 12)
+	assert.Equal(t, errInvalidEvidence, err, "the signer is not known as an elected BP")
+	assert.NoError(t, RecordSlots(scs, []types.PeerID{bp}, bp, nil, 1))
+
+	_, err = ExecuteSystemTx(scs, submit(first, first), sender, receiver, 12)
+	assert.Equal(t, errInvalidEvidence, err, "the same block is not an evidence")
+	_, err = ExecuteSystemTx(scs, submit(first, signedHeader(t, privKey, 11, ts+2000000000, sender.ID())),
+		sender, receiver, 12)
+	assert.Equal(t, errInvalidEvidence, err, "the blocks of different slots are not an evidence")
+	_, err = ExecuteSystemTx(scs, submit(first, second), sender, receiver, 11)
+	assert.Equal(t, errInvalidEvidence, err, "the blocks must precede the evidence")
+
+	otherKey, _, err := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	assert.NoError(t, err)
+	_, err = ExecuteSystemTx(scs, submit(signedHeader(t, otherKey, 10, ts, sender.ID()),
+		signedHeader(t, otherKey, 11, ts, sender.ID())), sender, receiver, 12)
+	assert.Equal(t, errInvalidEvidence, err, "the signer is not an elected BP")
+
+	events, err := ExecuteSystemTx(scs, submit(first, second), sender, receiver, 12)
+	assert.NoError(t, err, "submitting evidence failed")
+	assert.Equal(t, "slash", events[0].EventName)
+
+	slashed := new(big.Int).Div(new(big.Int).Mul(types.StakingMinimum, big.NewInt(SlashRate)), big.NewInt(100))
+	staking, err := GetStaking(scs, bpAccount.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Sub(types.StakingMinimum, slashed), staking.GetAmountBigInt())
+	staking, err = GetStaking(scs, sender.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, types.StakingMinimum, staking.GetAmountBigInt())
+	_, jailedUntil, total, err := GetPenalty(scs, bp)
+	assert.NoError(t, err)
+	assert.Equal(t, types.BlockNo(12+JailPeriod()), jailedUntil)
+	assert.Equal(t, slashed, total)
+
+	_, err = ExecuteSystemTx(scs, submit(second, first), sender, receiver, 13)
+	assert.Equal(t, errEvidenceUsed, err)
+
+	penalties, err := GetPenalties(scs)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(penalties))
+	assert.Equal(t, types.IDB58Encode(bp), penalties[0].BPID)
+}
+
+func TestElected(t *testing.T) {
+	scs, _, _ := initTest(t)
+	defer deinitTest()
+
+	bps := []types.PeerID{"bp1", "bp2"}
+	assert.NoError(t, updateElected(scs, bps, 10))
+	assert.NoError(t, updateElected(scs, bps, 20))
+	assert.NoError(t, updateElected(scs, []types.PeerID{"bp2", "bp3"}, 30))
+	assert.NoError(t, updateElected(scs, []types.PeerID{"bp3"}, 40))
+
+	for _, c := range []struct {
+		bp      types.PeerID
+		blockNo types.BlockNo
+		elected bool
+	}{
+		{"bp1", 29, false}, // older than the last two elections
+		{"bp2", 30, true},
+		{"bp2", 39, true},
+		{"bp2", 40, false},
+		{"bp3", 40, true},
+	} {
+		elected, err := isElected(scs, c.bp, c.blockNo)
+		assert.NoError(t, err)
+		assert.Equal(t, c.elected, elected, "%s at %d", c.bp, c.blockNo)
+	}
+}
+
+func TestRecordSlots(t *testing.T) {
+	scs, sender, receiver := initTest(t)
+	defer deinitTest()
+	InitDefaultBpCount(3)
+
+	sender.AddBalance(types.StakingMinimum)
+	_, err := ExecuteSystemTx(scs, &types.TxBody{Amount: types.StakingMinimum.Bytes(), Payload: buildStakingPayload(true)},
+		sender, receiver, 0)
+	assert.NoError(t, err, "staking failed")
+	_, err = ExecuteSystemTx(scs, &types.TxBody{Payload: buildVotingPayload(2)}, sender, receiver, 0)
+	assert.NoError(t, err, "voting failed")
+
+	reader := &testStateReader{scs: scs}
+	rankers, err := GetRankers(reader, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rankers))
+
+	result, err := getVoteResult(scs, defaultVoteKey, 2)
+	assert.NoError(t, err)
+	producer := types.PeerID(result.Votes[0].Candidate)
+	absent := types.PeerID(result.Votes[1].Candidate)
+	bps := []types.PeerID{producer, absent}
+
+	for i := 1; i < MaxMissedSlots; i++ {
+		assert.NoError(t, RecordSlots(scs, bps, producer, []types.PeerID{absent}, types.BlockNo(i)))
+	}
+	missed, jailedUntil, _, err := GetPenalty(scs, absent)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(MaxMissedSlots-1), missed)
+	assert.Equal(t, types.BlockNo(0), jailedUntil)
+
+	// producing a block resets the count
+	assert.NoError(t, RecordSlots(scs, bps, absent, nil, MaxMissedSlots))
+	missed, _, _, err = GetPenalty(scs, absent)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), missed)
+
+	for i := 1; i <= MaxMissedSlots; i++ {
+		assert.NoError(t, RecordSlots(scs, bps, producer, []types.PeerID{absent}, types.BlockNo(MaxMissedSlots+i)))
+	}
+	_, jailedUntil, _, err = GetPenalty(scs, absent)
+	assert.NoError(t, err)
+	assert.Equal(t, types.BlockNo(2*MaxMissedSlots+JailPeriod()), jailedUntil)
+
+	rankers, err = GetRankers(reader, 2*MaxMissedSlots+1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{enc.ToString([]byte(producer))}, rankers, "the jailed BP is not elected")
+	rankers, err = GetRankers(reader, 2*MaxMissedSlots+JailPeriod())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rankers), "the BP is elected again after the jail period")
+}
+
+func TestJailPeriod(t *testing.T) {
+	defer func(interval int64) { consensus.BlockIntervalSec = interval }(consensus.BlockIntervalSec)
+
+	consensus.BlockIntervalSec = 1
+	assert.Equal(t, types.BlockNo(60*60*24), JailPeriod())
+	consensus.BlockIntervalSec = 4
+	assert.Equal(t, types.BlockNo(60*60*6), JailPeriod())
+}
@@ -40,6 +40,9 @@ func staking(txBody *types.TxBody, sender, receiver *state.V,
 	if err := setStaking(scs, sender.ID(), staked); err != nil {
 		return nil, err
 	}
+	if err := refreshDelegation(scs, sender.ID(), staked, blockNo); err != nil {
+		return nil, err
+	}
 	if err := addTotal(scs, amount); err != nil {
 		return nil, err
 	}
@@ -112,7 +115,16 @@ func getStaking(scs *state.ContractState, who []byte) (*types.Staking, error) {
 
 func GetStaking(scs *state.ContractState, address []byte) (*types.Staking, error) {
 	if address != nil {
-		return getStaking(scs, address)
+		staking, err := getStaking(scs, address)
+		if err != nil {
+			return nil, err
+		}
+		reward, err := getReward(scs, address)
+		if err != nil {
+			return nil, err
+		}
+		staking.Reward = reward.Bytes()
+		return staking, nil
 	}
 	return nil, errors.New("invalid argument: address should not be nil")
 }
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"math"
 	"math/big"
 
 	"github.com/aergoio/aergo/internal/enc"
@@ -54,7 +55,11 @@ func voting(txBody *types.TxBody, sender, receiver *state.V, scs *state.Contract
 	if staked.GetAmountBigInt().Cmp(new(big.Int).SetUint64(0)) == 0 {
 		return nil, types.ErrMustStakeBeforeVote
 	}
-	vote := &types.Vote{Amount: staked.GetAmount()}
+	power, err := votingPower(scs, sender.ID(), staked)
+	if err != nil {
+		return nil, err
+	}
+	vote := &types.Vote{Amount: power.Bytes()}
 	args, err := json.Marshal(context.Call.Args)
 	if err != nil {
 		return nil, err
@@ -70,6 +75,12 @@ func voting(txBody *types.TxBody, sender, receiver *state.V, scs *state.Contract
 		vote.Candidate = args
 	}
 
+	if bytes.Equal(key, defaultVoteKey) && isRewarded(blockNo) {
+		err = updateVotedStake(scs, sender.ID(), votedStake(vote))
+		if err != nil {
+			return nil, err
+		}
+	}
 	err = setVote(scs, key, sender.ID(), vote)
 	if err != nil {
 		return nil, err
@@ -97,15 +108,27 @@ func refreshAllVote(txBody *types.TxBody, scs *state.ContractState,
 	context *SystemContext) error {
 	account := context.Sender.ID()
 	staked := context.Staked
-	stakedAmount := new(big.Int).SetBytes(staked.Amount)
-	for _, keystr := range types.AllVotes {
+	if err := refreshDelegation(scs, account, staked, context.BlockNo); err != nil {
+		return err
+	}
+	power, err := votingPower(scs, account, staked)
+	if err != nil {
+		return err
+	}
+	return refreshVotes(scs, account, power, context.BlockNo)
+}
+
+// refreshVotes lowers the amount of each vote of account accepted at blockNo
+// to its voting power.
+func refreshVotes(scs *state.ContractState, account []byte, power *big.Int, blockNo types.BlockNo) error {
+	for _, keystr := range types.GetAllVotes(blockNo) {
 		key := []byte(keystr[2:])
 		oldvote, err := getVote(scs, key, account)
 		if err != nil {
 			return err
 		}
 		if oldvote.Amount == nil ||
-			new(big.Int).SetBytes(oldvote.Amount).Cmp(stakedAmount) <= 0 {
+			new(big.Int).SetBytes(oldvote.Amount).Cmp(power) <= 0 {
 			continue
 		}
 		voteResult, err := loadVoteResult(scs, key)
@@ -115,7 +138,12 @@ func refreshAllVote(txBody *types.TxBody, scs *state.ContractState,
 		if err = voteResult.SubVote(oldvote); err != nil {
 			return err
 		}
-		oldvote.Amount = staked.GetAmount()
+		oldvote.Amount = power.Bytes()
+		if bytes.Equal(key, defaultVoteKey) && isRewarded(blockNo) {
+			if err = updateVotedStake(scs, account, votedStake(oldvote)); err != nil {
+				return err
+			}
+		}
 		if err = setVote(scs, key, account, oldvote); err != nil {
 			return err
 		}
@@ -205,18 +233,41 @@ func getDefaultBpCount() int {
 	return defaultBpCount
 }
 
-// GetRankers returns the IDs of the top n rankers.
-func GetRankers(ar AccountStateReader) ([]string, error) {
-	n := getDefaultBpCount()
+// GetRankers returns the IDs of the top rankers, who are elected as the BPs
+// at blockNo.
+func GetRankers(ar AccountStateReader, blockNo types.BlockNo) ([]string, error) {
+	scs, err := ar.GetSystemAccountState()
+	if err != nil {
+		return nil, err
+	}
+	n := GetBpCount(scs, blockNo)
 
-	vl, err := GetVoteResult(ar, defaultVoteKey, n)
+	vl, err := getVoteResult(scs, defaultVoteKey, math.MaxInt32)
 	if err != nil {
 		return nil, err
 	}
 
+	// the jailed BPs are skipped unless all of them are jailed
 	bps := make([]string, 0, n)
 	for _, v := range vl.Votes {
-		bps = append(bps, enc.ToString(v.Candidate))
+		if len(bps) == n {
+			break
+		}
+		jailed, err := isJailed(scs, v.Candidate, blockNo)
+		if err != nil {
+			return nil, err
+		}
+		if !jailed {
+			bps = append(bps, enc.ToString(v.Candidate))
+		}
+	}
+	if len(bps) == 0 {
+		for _, v := range vl.Votes {
+			if len(bps) == n {
+				break
+			}
+			bps = append(bps, enc.ToString(v.Candidate))
+		}
 	}
 
 	return bps, nil
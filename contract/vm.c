@@ -51,12 +51,19 @@ static void setLuaExecContext(lua_State *L, int *service)
 	lua_setglobal(L, luaExecContext);
 }
 
-const int *getLuaExecContext(lua_State *L)
+int *vm_exec_context(lua_State *L)
 {
 	int *service;
 	lua_getglobal(L, luaExecContext);
 	service = (int *)lua_touserdata(L, -1);
 	lua_pop(L, 1);
+
+	return service;
+}
+
+const int *getLuaExecContext(lua_State *L)
+{
+	int *service = vm_exec_context(L);
 	if (*service == -1)
 	    luaL_error(L, "not permitted state referencing at global scope");
 
@@ -82,6 +89,12 @@ lua_State *vm_newstate()
 	return L;
 }
 
+void vm_open_v2_fork(lua_State *L)
+{
+	luaopen_state_v2(L);
+	lua_pop(L, 1);
+}
+
 static int pcall(lua_State *L, int narg, int nret, int maxinstcount)
 {
     int err;
@@ -47,8 +47,14 @@ const (
 	queryMaxInstLimit = callMaxInstLimit * C.int(10)
 	dbUpdateMaxLimit  = fee.StateDbMaxUpdateSize
 	maxCallDepth      = 5
+	migrateName       = "migrate"
+	checkDelegation   = "check_delegation"
 )
 
+// checkDelegationInstLimit bounds check_delegation, which is run for free to
+// validate the txs of fee delegation.
+const checkDelegationInstLimit = callMaxInstLimit / C.int(10)
+
 var (
 	ctrLog         *log.Logger
 	curStateSet    [maxStateSet]*StateSet
@@ -93,6 +99,8 @@ type StateSet struct {
 	callState         map[types.AccountID]*CallState
 	lastRecoveryEntry *recoveryEntry
 	dbUpdateTotalSize int64
+	gasLimit          uint64
+	gasUsed           uint64
 	seed              *rand.Rand
 	events            []*types.Event
 	eventCount        int32
@@ -202,6 +210,18 @@ func NewContextQuery(blockState *state.BlockState, cdb ChainAccessor, receiverId
 	return stateSet
 }
 
+// isV2Fork reports whether the contract runs under the rules of the V2
+// hardfork. A query runs under the rules of the best block.
+func (s *StateSet) isV2Fork() bool {
+	blockNo := s.blockHeight
+	if s.isQuery && blockNo == 0 && s.cdb != nil {
+		if bestBlock, err := s.cdb.GetBestBlock(); err == nil {
+			blockNo = bestBlock.GetHeader().GetBlockNo()
+		}
+	}
+	return types.IsV2Fork(blockNo)
+}
+
 func (s *StateSet) usedFee() *big.Int {
 	if fee.IsZeroFee() {
 		return zeroFee
@@ -272,6 +292,9 @@ func newExecutor(
 		ctrLog.Error().Err(ce.err).Str("contract", types.EncodeAddress(contractId)).Msg("new AergoLua executor")
 		return ce
 	}
+	if stateSet.isV2Fork() {
+		C.vm_open_v2_fork(ce.L)
+	}
 	backupService := stateSet.service
 	stateSet.service = -1
 	hexId := C.CString(hex.EncodeToString(contractId))
@@ -291,7 +314,19 @@ func newExecutor(
 	stateSet.service = backupService
 
 	if isCreate {
-		f, err := resolveFunction(ctrState, "constructor", isCreate)
+		// an upgrade runs migrate if the new code has one, else the constructor
+		entry := "constructor"
+		if ci.Name == migrateName {
+			cName := C.CString(migrateName)
+			C.vm_getfield(ce.L, cName)
+			C.free(unsafe.Pointer(cName))
+			if C.vm_isnil(ce.L, C.int(-1)) == 1 {
+				C.lua_settop(ce.L, -2)
+			} else {
+				entry = migrateName
+			}
+		}
+		f, err := resolveFunction(ctrState, entry, isCreate)
 		if err != nil {
 			ce.err = err
 			ctrLog.Error().Err(ce.err).Str("contract", types.EncodeAddress(contractId)).Msg("not found function")
@@ -299,7 +334,7 @@ func newExecutor(
 		}
 		if f == nil {
 			f = &types.Function{
-				Name:    "constructor",
+				Name:    entry,
 				Payable: false,
 			}
 		}
@@ -310,7 +345,9 @@ func newExecutor(
 			return ce
 		}
 		ce.isView = f.View
-		C.vm_get_constructor(ce.L)
+		if entry != migrateName {
+			C.vm_get_constructor(ce.L)
+		}
 		if C.vm_isnil(ce.L, C.int(-1)) == 1 {
 			ce.close()
 			return nil
@@ -642,9 +679,11 @@ func Call(contractState *state.ContractState, code, contractAddress []byte,
 	curStateSet[stateSet.service] = stateSet
 	ce := newExecutor(contract, contractAddress, stateSet, &ci, stateSet.curContract.amount, false, contractState)
 	defer ce.close()
-	ce.setCountHook(callMaxInstLimit)
+	limit := stateSet.instLimit()
+	ce.setCountHook(limit)
 
 	ce.call(nil)
+	stateSet.setGasUsed(ce, limit)
 	err = ce.err
 	if err != nil {
 		if dbErr := ce.rollbackToSavepoint(); dbErr != nil {
@@ -732,6 +771,7 @@ func PreCall(ce *Executor, bs *state.BlockState, sender *state.V, contractState
 	}
 	curStateSet[stateSet.service] = stateSet
 	ce.call(nil)
+	stateSet.setGasUsed(ce, stateSet.instLimit())
 	err = ce.err
 	if err == nil {
 		err = ce.commitCalledContract()
@@ -802,7 +842,7 @@ func PreloadEx(bs *state.BlockState, contractState *state.ContractState, contrac
 		ctrLog.Debug().Str("abi", string(code)).Str("contract", types.EncodeAddress(contractAddress)).Msg("preload")
 	}
 	ce := newExecutor(contractCode, contractAddress, stateSet, &ci, stateSet.curContract.amount, false, contractState)
-	ce.setCountHook(callMaxInstLimit)
+	ce.setCountHook(stateSet.instLimit())
 
 	return ce, nil
 
@@ -841,22 +881,43 @@ func setContract(contractState *state.ContractState, contractAddress, code []byt
 
 func Create(contractState *state.ContractState, code, contractAddress []byte,
 	stateSet *StateSet) (string, []*types.Event, *big.Int, error) {
+	return create(contractState, code, contractAddress, stateSet, false)
+}
+
+// Upgrade replaces the code of a deployed contract, keeping its state. The
+// migrate function of the new code, if any, runs instead of the constructor
+// with the arguments in code.
+func Upgrade(contractState *state.ContractState, code, contractAddress []byte,
+	stateSet *StateSet) (string, []*types.Event, *big.Int, error) {
+	return create(contractState, code, contractAddress, stateSet, true)
+}
+
+func create(contractState *state.ContractState, code, contractAddress []byte,
+	stateSet *StateSet, upgrade bool) (string, []*types.Event, *big.Int, error) {
 	if len(code) == 0 {
 		return "", nil, stateSet.usedFee(), errors.New("contract code is required")
 	}
 
 	if ctrLog.IsDebugEnabled() {
-		ctrLog.Debug().Str("contract", types.EncodeAddress(contractAddress)).Msg("deploy")
+		ctrLog.Debug().Str("contract", types.EncodeAddress(contractAddress)).Bool("upgrade", upgrade).Msg("deploy")
 	}
+	prevCodeHash := contractState.State.GetCodeHash()
 	contract, codeLen, err := setContract(contractState, contractAddress, code)
 	if err != nil {
 		return "", nil, stateSet.usedFee(), err
 	}
-	err = contractState.SetData(creatorMetaKey, []byte(types.EncodeAddress(stateSet.curContract.sender)))
+	if upgrade {
+		err = contractState.SetData(prevCodeHashMetaKey, prevCodeHash)
+	} else {
+		err = contractState.SetData(creatorMetaKey, []byte(types.EncodeAddress(stateSet.curContract.sender)))
+	}
 	if err != nil {
 		return "", nil, stateSet.usedFee(), err
 	}
 	var ci types.CallInfo
+	if upgrade {
+		ci.Name = migrateName
+	}
 	if len(code) != int(codeLen) {
 		err = getCallInfo(&ci.Args, code[codeLen:], contractAddress)
 		if err != nil {
@@ -879,9 +940,11 @@ func Create(contractState *state.ContractState, code, contractAddress []byte,
 		return "", nil, stateSet.usedFee(), nil
 	}
 	defer ce.close()
-	ce.setCountHook(callMaxInstLimit)
+	limit := stateSet.instLimit()
+	ce.setCountHook(limit)
 
 	ce.call(nil)
+	stateSet.setGasUsed(ce, limit)
 	err = ce.err
 	if err != nil {
 		logger.Warn().Msg("constructor is failed")
@@ -956,6 +1019,11 @@ func setQueryContext(stateSet *StateSet) {
 }
 
 func Query(contractAddress []byte, bs *state.BlockState, cdb ChainAccessor, contractState *state.ContractState, queryInfo []byte) (res []byte, err error) {
+	return query(contractAddress, bs, cdb, contractState, queryInfo, queryMaxInstLimit)
+}
+
+func query(contractAddress []byte, bs *state.BlockState, cdb ChainAccessor, contractState *state.ContractState,
+	queryInfo []byte, instLimit C.int) (res []byte, err error) {
 	var ci types.CallInfo
 	contract := getContract(contractState, nil)
 	if contract != nil {
@@ -983,13 +1051,46 @@ func Query(contractAddress []byte, bs *state.BlockState, cdb ChainAccessor, cont
 			err = dbErr
 		}
 	}()
-	ce.setCountHook(queryMaxInstLimit)
+	ce.setCountHook(instLimit)
 	ce.call(nil)
 
 	curStateSet[stateSet.service] = nil
 	return []byte(ce.jsonRet), ce.err
 }
 
+// CheckFeeDelegation asks the contract whether it pays the fee of the call in
+// payload from sender. The check_delegation function of the contract is run
+// as a query with the sender address, the function name and the arguments of
+// the call, and the contract pays if it returns true. The check is bounded by
+// checkDelegationInstLimit instructions.
+func CheckFeeDelegation(contractAddress []byte, bs *state.BlockState, cdb ChainAccessor,
+	contractState *state.ContractState, payload, sender []byte) error {
+	var call types.CallInfo
+	if err := getCallInfo(&call, payload, contractAddress); err != nil {
+		return err
+	}
+	args := call.Args
+	if args == nil {
+		args = []interface{}{}
+	}
+	queryInfo, err := json.Marshal(&types.CallInfo{
+		Name: checkDelegation,
+		Args: []interface{}{types.EncodeAddress(sender), call.Name, args},
+	})
+	if err != nil {
+		return err
+	}
+	ret, err := query(contractAddress, bs, cdb, contractState, queryInfo, checkDelegationInstLimit)
+	if err != nil {
+		ctrLog.Debug().Err(err).Str("contract", types.EncodeAddress(contractAddress)).Msg("check fee delegation")
+		return types.ErrNotAllowedFeeDelegation
+	}
+	if string(ret) != "true" {
+		return types.ErrNotAllowedFeeDelegation
+	}
+	return nil
+}
+
 func getContract(contractState *state.ContractState, code []byte) []byte {
 	var val []byte
 	val = code
@@ -9,6 +9,7 @@
 extern const char *construct_name;
 
 lua_State *vm_newstate();
+void vm_open_v2_fork(lua_State *L);
 int vm_isnil(lua_State *L, int idx);
 void vm_getfield(lua_State *L, const char *name);
 void vm_get_constructor(lua_State *L);
@@ -18,6 +19,7 @@ const char *vm_pcall(lua_State *L, int argc, int* nresult);
 const char *vm_get_json_ret(lua_State *L, int nresult, int *err);
 const char *vm_copy_result(lua_State *L, lua_State *target, int cnt);
 sqlite3 *vm_get_db(lua_State *L);
+int *vm_exec_context(lua_State *L);
 void vm_get_abi_function(lua_State *L, char *fname);
 int vm_is_payable_function(lua_State *L, char *fname);
 char *vm_resolve_function(lua_State *L, char *fname, int *viewflag, int *payflag);
@@ -17,6 +17,7 @@ struct proof {
 import "C"
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -40,6 +41,9 @@ import (
 var (
 	mulAergo, mulGaer, zeroBig *big.Int
 	creatorMetaKey             = []byte("Creator")
+	prevCodeHashMetaKey        = []byte("PrevCodeHash")
+	sqlHashMetaKey             = []byte("SqlHash")
+	sqlTableHashMetaKey        = []byte("SqlTableHash")
 )
 
 const (
@@ -73,6 +77,7 @@ func LuaSetDB(L *LState, service *C.int, key unsafe.Pointer, keyLen C.int, value
 		return C.CString("[System.LuaSetDB] set not permitted in query")
 	}
 	val := []byte(C.GoString(value))
+	useGas(L, stateSet, gasStateWrite+gasStateWriteByte*(int(keyLen)+len(val)))
 	if err := stateSet.curContract.callState.ctrState.SetData(C.GoBytes(key, keyLen), val); err != nil {
 		return C.CString(err.Error())
 	}
@@ -96,6 +101,7 @@ func LuaGetDB(L *LState, service *C.int, key unsafe.Pointer, keyLen C.int, blkno
 	if stateSet == nil {
 		return nil, C.CString("[System.LuaGetDB] contract state not found")
 	}
+	useGas(L, stateSet, gasStateRead)
 	if blkno != nil {
 		bigNo, _ := new(big.Int).SetString(strings.TrimSpace(C.GoString(blkno)), 10)
 		if bigNo == nil || bigNo.Sign() < 0 {
@@ -103,6 +109,9 @@ func LuaGetDB(L *LState, service *C.int, key unsafe.Pointer, keyLen C.int, blkno
 		}
 		blkNo := bigNo.Uint64()
 
+		if stateSet.cdb == nil {
+			return nil, C.CString("[System.LuaGetDB] the state of past blocks is not available")
+		}
 		chainBlockHeight := stateSet.blockHeight
 		if chainBlockHeight == 0 {
 			bestBlock, err := stateSet.cdb.GetBestBlock()
@@ -156,6 +165,7 @@ func LuaDelDB(L *LState, service *C.int, key unsafe.Pointer, keyLen C.int) *C.ch
 	if stateSet.isQuery {
 		return C.CString("[System.LuaDelDB] delete not permitted in query")
 	}
+	useGas(L, stateSet, gasStateDelete)
 	if err := stateSet.curContract.callState.ctrState.DeleteData(C.GoBytes(key, keyLen)); err != nil {
 		return C.CString(err.Error())
 	}
@@ -171,6 +181,96 @@ func LuaDelDB(L *LState, service *C.int, key unsafe.Pointer, keyLen C.int) *C.ch
 	return nil
 }
 
+//export LuaMapIndexUpdate
+func LuaMapIndexUpdate(L *LState, service *C.int, index *C.char, key unsafe.Pointer, keyLen C.int, del C.int) *C.char {
+	stateSet := curStateSet[*service]
+	if stateSet == nil {
+		return C.CString("[System.LuaMapIndexUpdate] contract state not found")
+	}
+	if !stateSet.isV2Fork() {
+		return C.CString("[System.LuaMapIndexUpdate] iterable map is not supported before the V2 hardfork")
+	}
+	if stateSet.isQuery {
+		return C.CString("[System.LuaMapIndexUpdate] update not permitted in query")
+	}
+	ctrState := stateSet.curContract.callState.ctrState
+	idx := []byte(C.GoString(index))
+	k := C.GoBytes(key, keyLen)
+	var updated bool
+	var err error
+	if del != 0 {
+		updated, err = ctrState.DeleteIndexKey(idx, k)
+	} else {
+		updated, err = ctrState.AddIndexKey(idx, k)
+	}
+	if err != nil {
+		C.luaL_setsyserror(L)
+		return C.CString("[System.LuaMapIndexUpdate] database error: " + err.Error())
+	}
+	if !updated {
+		return nil
+	}
+	useGas(L, stateSet, gasStateWrite+gasStateWriteByte*len(k))
+	if err := addUpdateSize(stateSet, int64(types.HashIDLength+len(k))); err != nil {
+		C.luaL_setuncatchablerror(L)
+		return C.CString(err.Error())
+	}
+	return nil
+}
+
+func optBytes(p unsafe.Pointer, l C.int) []byte {
+	if l < 0 {
+		return nil
+	}
+	return C.GoBytes(p, l)
+}
+
+// LuaMapKeys returns the keys of a map index selected by the bounds. The keys
+// are encoded as 4 byte little endian lengths followed by the keys.
+//
+//export LuaMapKeys
+func LuaMapKeys(L *LState, service *C.int, index *C.char,
+	prefix unsafe.Pointer, prefixLen C.int, from unsafe.Pointer, fromLen C.int,
+	to unsafe.Pointer, toLen C.int, after unsafe.Pointer, afterLen C.int,
+	limit C.int) (unsafe.Pointer, C.int, C.int, *C.char) {
+	stateSet := curStateSet[*service]
+	if stateSet == nil {
+		return nil, 0, 0, C.CString("[System.LuaMapKeys] contract state not found")
+	}
+	if !stateSet.isV2Fork() {
+		return nil, 0, 0, C.CString("[System.LuaMapKeys] iterable map is not supported before the V2 hardfork")
+	}
+	q := &state.KeyQuery{
+		Prefix: optBytes(prefix, prefixLen),
+		From:   optBytes(from, fromLen),
+		To:     optBytes(to, toLen),
+		After:  optBytes(after, afterLen),
+		Limit:  int(limit),
+	}
+	keys, more, err := stateSet.curContract.callState.ctrState.IndexKeys([]byte(C.GoString(index)), q)
+	if err != nil {
+		C.luaL_setsyserror(L)
+		return nil, 0, 0, C.CString("[System.LuaMapKeys] database error: " + err.Error())
+	}
+	useGas(L, stateSet, gasStateRead+gasIndexKey*len(keys))
+
+	var b bytes.Buffer
+	l := make([]byte, 4)
+	for _, k := range keys {
+		binary.LittleEndian.PutUint32(l, uint32(len(k)))
+		b.Write(l)
+		b.Write(k)
+	}
+	var hasMore C.int
+	if more {
+		hasMore = 1
+	}
+	if b.Len() == 0 {
+		return nil, 0, hasMore, nil
+	}
+	return C.CBytes(b.Bytes()), C.int(b.Len()), hasMore, nil
+}
+
 func getCallState(stateSet *StateSet, aid types.AccountID) (*CallState, error) {
 	callState := stateSet.callState[aid]
 	if callState == nil {
@@ -227,7 +327,7 @@ func LuaCallContract(L *LState, service *C.int, contractId *C.char, fname *C.cha
 		return -1, C.CString("[Contract.LuaCallContract] contract state not found")
 	}
 	contractAddress := C.GoString(contractId)
-	cid, err := getAddressNameResolved(contractAddress, stateSet.bs)
+	cid, err := getAddressNameResolved(contractAddress, stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaCallContract] invalid contractId: " + err.Error())
 	}
@@ -290,8 +390,9 @@ func LuaCallContract(L *LState, service *C.int, contractId *C.char, fname *C.cha
 	defer func() {
 		stateSet.curContract = prevContractInfo
 	}()
-	ce.setCountHook(minusCallCount(C.luaL_instcount(L), luaCallCountDeduc))
-	defer setInstCount(L, ce.L)
+	limit := callInstLimit(L, stateSet, gas)
+	ce.setCountHook(limit)
+	defer setCallInstCount(L, ce.L, limit, stateSet)
 
 	ret := ce.call(L)
 	if ce.err != nil {
@@ -332,7 +433,7 @@ func LuaDelegateCallContract(L *LState, service *C.int, contractId *C.char,
 	if stateSet == nil {
 		return -1, C.CString("[Contract.LuaDelegateCallContract] contract state not found")
 	}
-	cid, err := getAddressNameResolved(contractIdStr, stateSet.bs)
+	cid, err := getAddressNameResolved(contractIdStr, stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaDelegateCallContract] invalid contractId: " + err.Error())
 	}
@@ -369,8 +470,9 @@ func LuaDelegateCallContract(L *LState, service *C.int, contractId *C.char,
 		_, _ = stateSet.traceFile.WriteString(fmt.Sprintf("[DELEGATECALL Contract %v %v]\n", contractIdStr, fnameStr))
 		_, _ = stateSet.traceFile.WriteString(fmt.Sprintf("snapshot set %d\n", seq))
 	}
-	ce.setCountHook(minusCallCount(C.luaL_instcount(L), luaCallCountDeduc))
-	defer setInstCount(L, ce.L)
+	limit := callInstLimit(L, stateSet, gas)
+	ce.setCountHook(limit)
+	defer setCallInstCount(L, ce.L, limit, stateSet)
 
 	ret := ce.call(L)
 	if ce.err != nil {
@@ -392,12 +494,14 @@ func LuaDelegateCallContract(L *LState, service *C.int, contractId *C.char,
 	return ret, nil
 }
 
-func getAddressNameResolved(account string, bs *state.BlockState) ([]byte, error) {
+func getAddressNameResolved(account string, bs *state.BlockState, blockNo types.BlockNo) ([]byte, error) {
 	accountLen := len(account)
 	if accountLen == types.EncodedAddressLength {
 		return types.DecodeAddress(account)
-	} else if accountLen == types.NameLength {
-		cid := name.Resolve(bs, []byte(account))
+	} else if accountLen == types.NameLength ||
+		(types.IsV2Fork(blockNo) && types.IsNameAddress([]byte(account))) {
+		// the subdomains are resolved from the V2 hardfork
+		cid := name.Resolve(bs, []byte(account), blockNo)
 		if cid == nil {
 			return nil, errors.New("name not founded :" + account)
 		}
@@ -419,7 +523,7 @@ func LuaSendAmount(L *LState, service *C.int, contractId *C.char, amount *C.char
 	if stateSet.isQuery == true && amountBig.Cmp(zeroBig) > 0 {
 		return C.CString("[Contract.LuaSendAmount] send not permitted in query")
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return C.CString("[Contract.LuaSendAmount] invalid contractId: " + err.Error())
 	}
@@ -474,8 +578,9 @@ func LuaSendAmount(L *LState, service *C.int, contractId *C.char, amount *C.char
 		defer func() {
 			stateSet.curContract = prevContractInfo
 		}()
-		ce.setCountHook(minusCallCount(C.luaL_instcount(L), luaCallCountDeduc))
-		defer setInstCount(L, ce.L)
+		limit := callInstLimit(L, stateSet, 0)
+		ce.setCountHook(limit)
+		defer setCallInstCount(L, ce.L, limit, stateSet)
 
 		ce.call(L)
 		if ce.err != nil {
@@ -640,7 +745,7 @@ func LuaGetBalance(L *LState, service *C.int, contractId *C.char) (*C.char, *C.c
 	if contractId == nil {
 		return C.CString(stateSet.curContract.callState.ctrState.GetBalanceBigInt().String()), nil
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return nil, C.CString("[Contract.LuaGetBalance] invalid contractId: " + err.Error())
 	}
@@ -749,7 +854,7 @@ func checkHexString(data string) bool {
 }
 
 //export LuaCryptoSha256
-func LuaCryptoSha256(L *LState, arg unsafe.Pointer, argLen C.int) (*C.char, *C.char) {
+func LuaCryptoSha256(L *LState, service *C.int, arg unsafe.Pointer, argLen C.int) (*C.char, *C.char) {
 	data := C.GoBytes(arg, argLen)
 	if checkHexString(string(data)) {
 		dataStr := data[2:]
@@ -759,6 +864,7 @@ func LuaCryptoSha256(L *LState, arg unsafe.Pointer, argLen C.int) (*C.char, *C.c
 			return nil, C.CString("[Contract.LuaCryptoSha256] hex decoding error: " + err.Error())
 		}
 	}
+	useGas(L, execStateSet(service), gasHash+gasHashByte*len(data))
 	h := sha256.New()
 	h.Write(data)
 	resultHash := h.Sum(nil)
@@ -863,6 +969,7 @@ func luaCryptoToBytes(data unsafe.Pointer, dataLen C.int) ([]byte, bool) {
 
 //export LuaCryptoVerifyProof
 func LuaCryptoVerifyProof(
+	L *LState, service *C.int,
 	key unsafe.Pointer, keyLen C.int,
 	value unsafe.Pointer, valueLen C.int,
 	hash unsafe.Pointer, hashLen C.int,
@@ -871,6 +978,7 @@ func LuaCryptoVerifyProof(
 	k, _ := luaCryptoToBytes(key, keyLen)
 	v, _ := luaCryptoToBytes(value, valueLen)
 	h, _ := luaCryptoToBytes(hash, hashLen)
+	useGas(L, execStateSet(service), gasProofNode*int(nProof))
 	cProof := (*[1 << 30]C.struct_proof)(proof)[:nProof:nProof]
 	bProof := make([][]byte, int(nProof))
 	for i, p := range cProof {
@@ -883,8 +991,9 @@ func LuaCryptoVerifyProof(
 }
 
 //export LuaCryptoKeccak256
-func LuaCryptoKeccak256(data unsafe.Pointer, dataLen C.int) (unsafe.Pointer, int) {
+func LuaCryptoKeccak256(L *LState, service *C.int, data unsafe.Pointer, dataLen C.int) (unsafe.Pointer, int) {
 	d, isHex := luaCryptoToBytes(data, dataLen)
+	useGas(L, execStateSet(service), gasHash+gasHashByte*len(d))
 	h := keccak256(d)
 	if isHex {
 		hex := []byte("0x" + hex.EncodeToString(h))
@@ -987,7 +1096,7 @@ func LuaDeployContract(
 	// get code
 	var code []byte
 
-	cid, err := getAddressNameResolved(contractStr, bs)
+	cid, err := getAddressNameResolved(contractStr, bs, stateSet.blockHeight)
 	if err == nil {
 		aid := types.ToAccountID(cid)
 		contractState, err := getOnlyContractState(stateSet, aid)
@@ -1099,8 +1208,9 @@ func LuaDeployContract(
 	addr := C.CString(types.EncodeAddress(newContract.ID()))
 	ret := C.int(1)
 	if ce != nil {
-		ce.setCountHook(minusCallCount(C.luaL_instcount(L), luaCallCountDeduc))
-		defer setInstCount(L, ce.L)
+		limit := callInstLimit(L, stateSet, 0)
+		ce.setCountHook(limit)
+		defer setCallInstCount(L, ce.L, limit, stateSet)
 
 		ret += ce.call(L)
 		if ce.err != nil {
@@ -1156,6 +1266,7 @@ func LuaEvent(L *LState, service *C.int, eventName *C.char, args *C.char) *C.cha
 	if len(C.GoString(args)) > maxEventArgSize {
 		return C.CString(fmt.Sprintf("[Contract.Event] exceeded the maximum length of event args(%d)", maxEventArgSize))
 	}
+	useGas(L, stateSet, gasEvent+gasEventByte*(len(C.GoString(eventName))+len(C.GoString(args))))
 	stateSet.events = append(
 		stateSet.events,
 		&types.Event{
@@ -1175,7 +1286,7 @@ func LuaIsContract(L *LState, service *C.int, contractId *C.char) (C.int, *C.cha
 	if stateSet == nil {
 		return -1, C.CString("[Contract.LuaIsContract] contract state not found")
 	}
-	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs)
+	cid, err := getAddressNameResolved(C.GoString(contractId), stateSet.bs, stateSet.blockHeight)
 	if err != nil {
 		return -1, C.CString("[Contract.LuaIsContract] invalid contractId: " + err.Error())
 	}
@@ -244,7 +244,8 @@ type luaTxCommon struct {
 
 type luaTxDef struct {
 	luaTxCommon
-	cErr error
+	cErr     error
+	redeploy bool
 }
 
 func NewLuaTxDef(sender, contract string, amount uint64, code string) *luaTxDef {
@@ -359,6 +360,12 @@ func (l *luaTxDef) Constructor(args string) *luaTxDef {
 	return l
 }
 
+// Redeploy makes l upgrade the code of an existing contract.
+func (l *luaTxDef) Redeploy() *luaTxDef {
+	l.redeploy = true
+	return l
+}
+
 func contractFrame(l *luaTxCommon, bs *state.BlockState,
 	run func(s, c *state.V, id types.AccountID, cs *state.ContractState) error) error {
 
@@ -413,7 +420,17 @@ func (l *luaTxDef) run(bs *state.BlockState, bc *DummyChain, blockNo uint64, ts
 				defer stateSet.traceFile.Close()
 			}
 
-			_, _, _, err := Create(eContractState, l.code, l.contract, stateSet)
+			var err error
+			if l.redeploy {
+				if err = checkRedeploy(sender, contract, eContractState); err != nil {
+					return err
+				}
+			}
+			if l.redeploy && types.IsV2Fork(blockNo) {
+				_, _, _, err = Upgrade(eContractState, l.code, l.contract, stateSet)
+			} else {
+				_, _, _, err = Create(eContractState, l.code, l.contract, stateSet)
+			}
 			if err != nil {
 				return err
 			}
@@ -525,7 +542,7 @@ func (bc *DummyChain) ConnectBlock(txs ...luaTx) error {
 			return err
 		}
 	}
-	err := SaveRecoveryPoint(blockState)
+	err := SaveRecoveryPoint(blockState, bc.cBlock.Header.BlockNo)
 	if err != nil {
 		return err
 	}
@@ -748,6 +748,135 @@ abi.register(createAndInsert, insertRollbackData, query, count, all)`
 	}
 }
 
+func TestSqlDatabaseHash(t *testing.T) {
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	definition := `
+function insert(v)
+    db.exec("create table if not exists t(v)")
+    local stmt = db.prepare("insert into t values (?)")
+    stmt:exec(v)
+end
+
+function move(v)
+    db.exec("create table if not exists u(id integer primary key autoincrement, v)")
+    db.exec("delete from t")
+    local stmt = db.prepare("insert into u(v) values (?)")
+    stmt:exec(v)
+end
+
+abi.register(insert, move)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "sqlhash", 0, definition),
+		NewLuaTxCall("ktlee", "sqlhash", 0, `{"Name": "insert", "Args":[1]}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aid := types.ToAccountID(strHash("sqlhash"))
+	prevState, err := bc.sdb.GetStateDB().OpenContractStateAccount(aid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prevHash, err := prevState.GetData(sqlHashMetaKey)
+	if err != nil || len(prevHash) == 0 {
+		t.Fatalf("no hash of database: %v", err)
+	}
+	if err := VerifyDatabase(aid.String(), prevState); err != nil {
+		t.Error(err)
+	}
+
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "sqlhash", 0, `{"Name": "insert", "Args":[2]}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	curState, err := bc.sdb.GetStateDB().OpenContractStateAccount(aid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	curHash, _ := curState.GetData(sqlHashMetaKey)
+	if bytes.Equal(prevHash, curHash) {
+		t.Error("hash of database is not updated")
+	}
+	if err := VerifyDatabase(aid.String(), curState); err != nil {
+		t.Error(err)
+	}
+	// the previous state is verified against its own recovery point
+	if err := VerifyDatabase(aid.String(), prevState); err != nil {
+		t.Error(err)
+	}
+
+	// the hashes updated for the written tables match the whole content
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "sqlhash", 0, `{"Name": "move", "Args":[3]}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "sqlhash", 0, `{"Name": "insert", "Args":[4]}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	curState, err = bc.sdb.GetStateDB().OpenContractStateAccount(aid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyDatabase(aid.String(), curState); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSqlDatabaseHashBeforeV2(t *testing.T) {
+	types.InitHardfork(&types.Hardfork{V2: 1000})
+	defer types.InitHardfork(&types.Hardfork{})
+
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	definition := `
+function insert(v)
+    db.exec("create table if not exists t(v)")
+    local stmt = db.prepare("insert into t values (?)")
+    stmt:exec(v)
+end
+
+abi.register(insert)`
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "sqlhash", 0, definition),
+		NewLuaTxCall("ktlee", "sqlhash", 0, `{"Name": "insert", "Args":[1]}`),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aid := types.ToAccountID(strHash("sqlhash"))
+	st, err := bc.sdb.GetStateDB().OpenContractStateAccount(aid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, _ := st.GetData(sqlHashMetaKey); len(h) != 0 {
+		t.Error("database is hashed before the V2 hardfork")
+	}
+	// a database without a hash is accepted
+	if err := VerifyDatabase(aid.String(), st); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestSqlVmFail(t *testing.T) {
 	bc, err := LoadDummyChain()
 	if err != nil {
@@ -2540,6 +2669,327 @@ func TestMapKey(t *testing.T) {
 	}
 }
 
+func TestMapIterate(t *testing.T) {
+	definition := `
+	state.var{
+		counts = state.map(1, {iterable = true}),
+		scores = state.map(1, {iterable = true}),
+		plain = state.map()
+	}
+	function setCount(key, value)
+		counts[key] = value
+	end
+	function delCount(key)
+		counts:delete(key)
+	end
+	function setAndFail(key, value)
+		counts[key] = value
+		error("set failed")
+	end
+	function list(opts)
+		local r = {}
+		for k, v in state.pairs(counts, opts) do
+			table.insert(r, k .. "=" .. v)
+		end
+		return r
+	end
+	function page(opts)
+		return state.keys(counts, opts)
+	end
+	function plainKeys()
+		return state.keys(plain)
+	end
+	function setScore(key, value)
+		scores[key] = value
+	end
+	function listScores(opts)
+		local r = {}
+		for k, v in state.pairs(scores, opts) do
+			table.insert(r, k .. "=" .. v)
+		end
+		return r
+	end
+	abi.register(setCount, delCount, setAndFail, setScore)
+	abi.register_view(list, page, plainKeys, listScores)
+`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "a", 0, definition),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setCount", "Args":["b", 2]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setCount", "Args":["a", 1]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setCount", "Args":["ca", 3]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setCount", "Args":["cb", 4]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setCount", "Args":["a", 5]}`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"list"}`, "", `["a=5","b=2","ca=3","cb=4"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"list", "Args":[{"prefix":"c"}]}`, "", `["ca=3","cb=4"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"list", "Args":[{"from":"b", "to":"cb"}]}`, "", `["b=2","ca=3"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"page", "Args":[{"limit":2}]}`, "", `[["a","b"],"b"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"page", "Args":[{"limit":2, "cursor":"b"}]}`, "", `[["ca","cb"]]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"plainKeys"}`, "state.map is not iterable", "")
+	if err != nil {
+		t.Error(err)
+	}
+
+	// a failed call rolls the index back
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"delCount", "Args":["b"]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setAndFail", "Args":["d", 6]}`).Fail("set failed"),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"list"}`, "", `["a=5","ca=3","cb=4"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	// number keys are listed in the numeric order and nil removes a key
+	err = bc.ConnectBlock(
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setScore", "Args":[9, 1]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setScore", "Args":[10, 2]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setScore", "Args":[-1, 3]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setScore", "Args":[2.5, 4]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setScore", "Args":[100, 5]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setScore", "Args":[100, null]}`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"listScores"}`, "", `["-1=3","2.5=4","9=1","10=2"]`)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"listScores", "Args":[{"from":0, "to":10}]}`, "", `["2.5=4","9=1"]`)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMapIterateBeforeV2(t *testing.T) {
+	types.InitHardfork(&types.Hardfork{V2: 1000})
+	defer types.InitHardfork(&types.Hardfork{})
+
+	definition := `
+	state.var{
+		counts = state.map(1, {iterable = true})
+	}
+	function setCount(key, value)
+		counts[key] = value
+	end
+	function get(key)
+		return counts[key]
+	end
+	function page()
+		return state.keys(counts)
+	end
+	abi.register(setCount)
+	abi.register_view(get, page)
+`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	// the options of a map are ignored before the V2 hardfork
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "a", 0, definition),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"setCount", "Args":["b", 2]}`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	errMsg := "integer expected, got nil"
+	err = bc.ConnectBlock(
+		NewLuaTxDef("ktlee", "b", 0, `state.var{ m = state.map(nil) }`),
+	)
+	if err == nil {
+		t.Errorf("expected: %s, but got: nil", errMsg)
+	} else if !strings.Contains(err.Error(), errMsg) {
+		t.Errorf("expected: %s, but got: %s", errMsg, err.Error())
+	}
+	err = bc.Query("a", `{"Name":"get", "Args":["b"]}`, "", "2")
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"page"}`, "a nil value", "")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestContractUpgrade(t *testing.T) {
+	v1 := `
+	state.var{
+		counts = state.map(),
+		version = state.value()
+	}
+	function constructor()
+		version:set(1)
+	end
+	function inc(key)
+		counts[key] = (counts[key] or 0) + 1
+	end
+	function get(key)
+		return counts[key], version:get()
+	end
+	abi.register(inc)
+	abi.register_view(get)
+`
+	v2 := `
+	state.var{
+		counts = state.map(),
+		version = state.value()
+	}
+	function constructor()
+		version:set(100)
+	end
+	function migrate(factor)
+		counts["a"] = counts["a"] * factor
+		version:set(2)
+	end
+	function inc(key)
+		counts[key] = (counts[key] or 0) + 10
+	end
+	function get(key)
+		return counts[key], version:get()
+	end
+	abi.register(inc)
+	abi.register_view(get)
+`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxAccount("other", 100),
+		NewLuaTxDef("ktlee", "a", 0, v1),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"inc", "Args":["a"]}`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"inc", "Args":["a"]}`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"get", "Args":["a"]}`, "", `[2,1]`)
+	if err != nil {
+		t.Error(err)
+	}
+
+	// only the creator can upgrade a contract
+	err = bc.ConnectBlock(
+		NewLuaTxDef("other", "a", 0, v2).Redeploy().Constructor(`[3]`),
+	)
+	if err == nil || !strings.Contains(err.Error(), types.ErrCreatorNotMatch.Error()) {
+		t.Errorf("expected %v, got %v", types.ErrCreatorNotMatch, err)
+	}
+
+	// migrate runs instead of the constructor and the state is kept
+	err = bc.ConnectBlock(
+		NewLuaTxDef("ktlee", "a", 0, v2).Redeploy().Constructor(`[3]`),
+		NewLuaTxCall("ktlee", "a", 0, `{"Name":"inc", "Args":["a"]}`),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"get", "Args":["a"]}`, "", `[16,2]`)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestContractRedeployBeforeV2(t *testing.T) {
+	types.InitHardfork(&types.Hardfork{V2: 1000})
+	defer types.InitHardfork(&types.Hardfork{})
+
+	v1 := `
+	state.var{
+		version = state.value()
+	}
+	function constructor()
+		version:set(1)
+	end
+	function get()
+		return version:get()
+	end
+	abi.register_view(get)
+`
+	v2 := `
+	state.var{
+		version = state.value()
+	}
+	function constructor()
+		version:set(100)
+	end
+	function migrate()
+		version:set(2)
+	end
+	function get()
+		return version:get()
+	end
+	abi.register_view(get)
+`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxAccount("other", 100),
+		NewLuaTxDef("ktlee", "a", 0, v1),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.ConnectBlock(
+		NewLuaTxDef("other", "a", 0, v2).Redeploy(),
+	)
+	if err == nil || !strings.Contains(err.Error(), types.ErrCreatorNotMatch.Error()) {
+		t.Errorf("expected %v, got %v", types.ErrCreatorNotMatch, err)
+	}
+
+	// a redeploy before the V2 hardfork runs the constructor
+	err = bc.ConnectBlock(
+		NewLuaTxDef("ktlee", "a", 0, v2).Redeploy(),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	err = bc.Query("a", `{"Name":"get"}`, "", `100`)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestStateVarFieldUpdate(t *testing.T) {
 	src := `
 state.var{
@@ -5063,3 +5513,40 @@ abi.register(testall)
 }
 
 // end of test-cases
+
+func TestSendToDottedNameBeforeV2(t *testing.T) {
+	types.InitHardfork(&types.Hardfork{V2: 1000})
+	defer types.InitHardfork(&types.Hardfork{})
+
+	definition := `
+	function send(to)
+		contract.send(to, 1)
+	end
+	abi.register(send)
+`
+	bc, err := LoadDummyChain()
+	if err != nil {
+		t.Errorf("failed to create test database: %v", err)
+	}
+	defer bc.Release()
+
+	err = bc.ConnectBlock(
+		NewLuaTxAccount("ktlee", 100),
+		NewLuaTxDef("ktlee", "a", 0, definition),
+	)
+	if err != nil {
+		t.Error(err)
+	}
+	// only a name of NameLength is resolved before the V2 hardfork
+	errMsg := "invalid account length"
+	for _, to := range []string{types.AergoName, "pay.myorgabcdef"} {
+		err = bc.ConnectBlock(
+			NewLuaTxCall("ktlee", "a", 0, `{"Name":"send", "Args":["`+to+`"]}`),
+		)
+		if err == nil {
+			t.Errorf("expected: %s, but got: nil", errMsg)
+		} else if !strings.Contains(err.Error(), errMsg) {
+			t.Errorf("expected: %s, but got: %s", errMsg, err.Error())
+		}
+	}
+}
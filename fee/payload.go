@@ -6,6 +6,7 @@ import (
 
 const (
 	baseTxFee            = "2000000000000000" // 0.002 AERGO
+	defaultGasPrice      = 50000000000        // 50 GAER
 	aerPerByte           = 5000000000000      // 5,000 GAER, feePerBytes * PayloadMaxBytes = 1 AERGO
 	payloadMaxSize       = 200 * 1024
 	StateDbMaxUpdateSize = payloadMaxSize
@@ -18,12 +19,16 @@ var (
 	stateDbMaxFee *big.Int
 	zero          *big.Int
 	AerPerByte    *big.Int
+	// DefaultGasPrice is the minimum gas price from the V2 hardfork until the
+	// stakers vote on another one.
+	DefaultGasPrice *big.Int
 )
 
 func init() {
 	baseTxAergo, _ = new(big.Int).SetString(baseTxFee, 10)
 	zeroFee = false
 	AerPerByte = big.NewInt(aerPerByte)
+	DefaultGasPrice = big.NewInt(defaultGasPrice)
 	stateDbMaxFee = new(big.Int).Mul(AerPerByte, big.NewInt(StateDbMaxUpdateSize-freeByteSize))
 	zero = big.NewInt(0)
 }
@@ -36,6 +41,12 @@ func IsZeroFee() bool {
 	return zeroFee
 }
 
+// IsEnoughGasPrice reports whether the gas price of a transaction meets the
+// minimum gas price decided by the votes. Any price is enough in zero fee mode.
+func IsEnoughGasPrice(gasPrice, minGasPrice *big.Int) bool {
+	return IsZeroFee() || gasPrice.Cmp(minGasPrice) >= 0
+}
+
 func PayloadTxFee(payloadSize int) *big.Int {
 	if IsZeroFee() {
 		return zero
@@ -1,8 +1,10 @@
 package merkle
 
 import (
-	"github.com/minio/sha256-simd"
+	"bytes"
 	"hash"
+
+	"github.com/minio/sha256-simd"
 )
 
 type MerkleEntry interface {
@@ -88,3 +90,46 @@ func CalculateMerkleTree(entries []MerkleEntry) [][]byte {
 
 	return merkles
 }
+
+// CalculateMerkleProof returns the audit path of the entry at idx, ordered
+// from the leaf level up to the children of the root. It returns nil if idx
+// is out of range.
+func CalculateMerkleProof(entries []MerkleEntry, idx int) [][]byte {
+	if idx < 0 || idx >= len(entries) {
+		return nil
+	}
+	merkles := CalculateMerkleTree(entries)
+
+	var path [][]byte
+	base, width := 0, (len(merkles)+1)/2
+	for width > 1 {
+		path = append(path, merkles[base+(idx^1)])
+		base += width
+		width /= 2
+		idx /= 2
+	}
+	return path
+}
+
+// VerifyMerkleProof checks that leaf is the entry at idx of the merkle tree
+// whose root is root, using the audit path made by CalculateMerkleProof.
+func VerifyMerkleProof(root []byte, leaf []byte, idx int, path [][]byte) bool {
+	if idx < 0 || idx >= 1<<uint(len(path)) {
+		return false
+	}
+	hasher := sha256.New()
+	h := leaf
+	for _, sibling := range path {
+		hasher.Reset()
+		if idx&1 == 0 {
+			hasher.Write(h)
+			hasher.Write(sibling)
+		} else {
+			hasher.Write(sibling)
+			hasher.Write(h)
+		}
+		h = hasher.Sum(nil)
+		idx >>= 1
+	}
+	return bytes.Equal(root, h)
+}
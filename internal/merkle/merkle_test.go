@@ -167,6 +167,28 @@ func TestMerkle32Tx(t *testing.T) {
 	assert.NotNil(t, merkleRoot)
 }
 
+func TestMerkleProof(t *testing.T) {
+	for _, count := range []int{1, 2, 3, 10, 16} {
+		entries := make([]MerkleEntry, count)
+		for i := range entries {
+			h := sha256.Sum256([]byte{byte(i)})
+			entries[i] = &testME{hash: h[:]}
+		}
+		root := CalculateMerkleRoot(entries)
+
+		for i, entry := range entries {
+			path := CalculateMerkleProof(entries, i)
+			assert.True(t, VerifyMerkleProof(root, entry.GetHash(), i, path), "count=%d idx=%d", count, i)
+			if count > 1 {
+				other := entries[(i+1)%count].GetHash()
+				assert.False(t, VerifyMerkleProof(root, other, i, path), "count=%d idx=%d", count, i)
+				assert.False(t, VerifyMerkleProof(root, entry.GetHash(), (i+1)%count, path), "count=%d idx=%d", count, i)
+			}
+		}
+		assert.Nil(t, CalculateMerkleProof(entries, count))
+	}
+}
+
 func BenchmarkMerkle10000Tx(b *testing.B) {
 	b.Log("BenchmarkMerkle10000Tx")
 	beforeTest(10000)
@@ -0,0 +1,187 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package mempool
+
+import (
+	"container/heap"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// evictCounts is the number of transactions evicted from mempool, by reason
+type evictCounts struct {
+	capacity uint64 // mempool was full
+	account  uint64 // account had too many transactions
+	orphan   uint64 // too many orphans
+	fadeout  uint64 // stayed too long
+}
+
+// overCapacity reports whether mempool holds more transactions or bytes than
+// configured
+func (mp *MemPool) overCapacity() bool {
+	conf := mp.cfg.Mempool
+	return (conf.MaxTxs > 0 && mp.length > conf.MaxTxs) ||
+		(conf.MaxBytes > 0 && mp.bytes > conf.MaxBytes)
+}
+
+func (mp *MemPool) overOrphans() bool {
+	return mp.cfg.Mempool.MaxOrphans > 0 && mp.orphan > mp.cfg.Mempool.MaxOrphans
+}
+
+// enforceLimits evicts transactions until mempool is within the configured
+// limits after tx was put in list. Only the last transaction of an account is
+// evicted, so that the remaining ones stay executable, and the one of the
+// lowest gas price goes first. It returns an error if tx itself is
+// evicted.
+func (mp *MemPool) enforceLimits(list *TxList, tx types.Transaction) error {
+	var err error
+	evicted := func(victim types.Transaction, e error) {
+		if victim == tx {
+			err = e
+		}
+	}
+
+	if slots := mp.cfg.Mempool.AccountTxs; slots > 0 && list.len() > slots {
+		evicted(mp.evictLast(list), types.ErrTxAccountPoolFull)
+		mp.evicted.account++
+	}
+	for err == nil && mp.overOrphans() {
+		victim := mp.lowestLast(list, true)
+		if victim == nil {
+			break
+		}
+		evicted(mp.evictLast(victim), types.ErrTxPoolFull)
+		mp.evicted.orphan++
+	}
+	for err == nil && mp.overCapacity() {
+		victim := mp.lowestLast(list, false)
+		if victim == nil {
+			break
+		}
+		evicted(mp.evictLast(victim), types.ErrTxPoolFull)
+		mp.evicted.capacity++
+	}
+	return err
+}
+
+// lowestLast returns the list whose last transaction offers the lowest gas
+// price. If orphan is set, only the lists having orphans are considered. On a
+// tie, prefer is returned, so that a new transaction does not push out the
+// ones of the same price.
+func (mp *MemPool) lowestLast(prefer *TxList, orphan bool) *TxList {
+	h := &mp.tails
+	if orphan {
+		h = &mp.orphanTails
+	}
+	if h.Len() == 0 {
+		return nil
+	}
+	lowest := h.lists[0]
+	if *h.index(prefer) >= 0 && prefer.tailPrice.Cmp(lowest.tailPrice) == 0 {
+		return prefer
+	}
+	return lowest
+}
+
+// updateTail puts list at its place in the heaps of the last transactions
+// after list changed. An empty list is removed from them.
+func (mp *MemPool) updateTail(list *TxList) {
+	last := list.last()
+	if last != nil {
+		list.tailPrice = last.GetBody().GetGasPriceBigInt()
+	}
+	mp.tails.update(list, last != nil)
+	mp.orphanTails.update(list, last != nil && list.hasOrphan())
+}
+
+// removeTail removes list from the heaps of the last transactions
+func (mp *MemPool) removeTail(list *TxList) {
+	mp.tails.update(list, false)
+	mp.orphanTails.update(list, false)
+}
+
+// tailHeap is a min heap of the lists of accounts ordered by the gas price of
+// their last transactions, which are evicted first.
+type tailHeap struct {
+	lists  []*TxList
+	orphan bool // only the lists having orphans
+}
+
+func (h *tailHeap) Len() int { return len(h.lists) }
+func (h *tailHeap) Less(i, j int) bool {
+	return h.lists[i].tailPrice.Cmp(h.lists[j].tailPrice) < 0
+}
+func (h *tailHeap) Swap(i, j int) {
+	h.lists[i], h.lists[j] = h.lists[j], h.lists[i]
+	*h.index(h.lists[i]) = i
+	*h.index(h.lists[j]) = j
+}
+func (h *tailHeap) Push(x interface{}) {
+	list := x.(*TxList)
+	*h.index(list) = len(h.lists)
+	h.lists = append(h.lists, list)
+}
+func (h *tailHeap) Pop() interface{} {
+	n := len(h.lists)
+	list := h.lists[n-1]
+	h.lists = h.lists[:n-1]
+	*h.index(list) = -1
+	return list
+}
+
+// index returns the position of list in h, which is -1 if list is not in h
+func (h *tailHeap) index(list *TxList) *int {
+	if h.orphan {
+		return &list.orphanIndex
+	}
+	return &list.tailIndex
+}
+
+// update puts list at its place in h if in is set, else removes it from h
+func (h *tailHeap) update(list *TxList, in bool) {
+	i := *h.index(list)
+	switch {
+	case in && i < 0:
+		heap.Push(h, list)
+	case in:
+		heap.Fix(h, i)
+	case i >= 0:
+		heap.Remove(h, i)
+	}
+}
+
+// evictLast removes the last transaction of list from mempool and returns it
+func (mp *MemPool) evictLast(list *TxList) types.Transaction {
+	tx, orphan := list.RemoveLast()
+	if tx == nil {
+		return nil
+	}
+	if orphan {
+		mp.orphan--
+	}
+	mp.length--
+	mp.bytes -= proto.Size(tx.GetTx())
+	mp.cache.Delete(types.ToTxID(tx.GetHash()))
+	mp.releaseMemPoolList(list)
+	return tx
+}
+
+// metric returns the capacity and eviction metric of mempool
+func (mp *MemPool) metric() *types.MempoolMetric {
+	mp.RLock()
+	defer mp.RUnlock()
+	return &types.MempoolMetric{
+		Total:           int32(mp.length),
+		Orphan:          int32(mp.orphan),
+		Bytes:           uint64(mp.bytes),
+		Accounts:        int32(len(mp.pool)),
+		EvictedCapacity: mp.evicted.capacity,
+		EvictedAccount:  mp.evicted.account,
+		EvictedOrphan:   mp.evicted.orphan,
+		EvictedFadeout:  mp.evicted.fadeout,
+	}
+}
@@ -0,0 +1,60 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package mempool
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// content returns the pending and queued transactions of account. For an
+// account without transactions in mempool, the next nonce is the one after
+// its nonce in the state.
+func (mp *MemPool) content(account []byte) (*types.MempoolAccount, error) {
+	mp.RLock()
+	defer mp.RUnlock()
+
+	ret := &types.MempoolAccount{Account: account}
+	if list := mp.getMemPoolList(account); list != nil {
+		ret.NextNonce, ret.Pending, ret.Queued = list.Content()
+		return ret, nil
+	}
+	st, err := mp.getAccountState(account)
+	if err != nil {
+		return nil, err
+	}
+	ret.NextNonce = st.GetNonce() + 1
+	return ret, nil
+}
+
+// summary returns the number of pending and queued transactions of every
+// account in mempool, in the order of account address
+func (mp *MemPool) summary() *types.MempoolSummary {
+	mp.RLock()
+	defer mp.RUnlock()
+
+	ret := &types.MempoolSummary{
+		Total:    int32(mp.length),
+		Orphan:   int32(mp.orphan),
+		Bytes:    uint64(mp.bytes),
+		Accounts: make([]*types.MempoolAccountSummary, 0, len(mp.pool)),
+	}
+	for _, list := range mp.pool {
+		nextNonce, pending, queued := list.Content()
+		ret.Accounts = append(ret.Accounts, &types.MempoolAccountSummary{
+			Account:   list.GetAccount(),
+			NextNonce: nextNonce,
+			Pending:   int32(len(pending)),
+			Queued:    int32(len(queued)),
+		})
+	}
+	sort.Slice(ret.Accounts, func(i, j int) bool {
+		return bytes.Compare(ret.Accounts[i].Account, ret.Accounts[j].Account) < 0
+	})
+	return ret
+}
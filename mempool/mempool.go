@@ -12,6 +12,7 @@ import (
 	"io"
 	"math/big"
 	"os"
+	"runtime"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/aergoio/aergo/account/key"
 	"github.com/aergoio/aergo/chain"
 	cfg "github.com/aergoio/aergo/config"
+	"github.com/aergoio/aergo/contract"
 	"github.com/aergoio/aergo/contract/enterprise"
 	"github.com/aergoio/aergo/contract/name"
 	"github.com/aergoio/aergo/contract/system"
@@ -63,12 +65,17 @@ type MemPool struct {
 	//cache       map[types.TxID]types.Transaction
 	cache       sync.Map
 	length      int
+	bytes       int
+	evicted     evictCounts
 	pool        map[types.AccountID]*TxList
+	tails       tailHeap // lists by the price of the last tx
+	orphanTails tailHeap // lists having orphans by the price of the last tx
 	dumpPath    string
 	status      int32
 	coinbasefee *big.Int
 	chainIdHash []byte
 	isPublic    bool
+	priceBump   uint64
 	whitelist   *whitelistConf
 	// followings are for test
 	testConfig bool
@@ -100,7 +107,11 @@ func NewMemPoolService(cfg *cfg.Config, cs *chain.ChainService) *MemPool {
 		quit:     make(chan bool),
 	}
 	actor.BaseComponent = component.NewBaseComponent(message.MemPoolSvc, actor, log.NewLogger("mempool"))
+	actor.orphanTails.orphan = true
 
+	if cfg.Mempool.PriceBump > 0 {
+		actor.priceBump = uint64(cfg.Mempool.PriceBump)
+	}
 	if cfg.Mempool.FadeoutPeriod > 0 {
 		evictPeriod = time.Duration(cfg.Mempool.FadeoutPeriod) * time.Hour
 	}
@@ -196,11 +207,14 @@ func (mp *MemPool) evictTransactions() {
 		for _, tx := range txs {
 			mp.cache.Delete(types.ToTxID(tx.GetHash()))
 			mp.length--
+			mp.bytes -= proto.Size(tx.GetTx())
 		}
 
 		mp.orphan -= orphan
+		mp.removeTail(list)
 		delete(mp.pool, acc)
 	}
+	mp.evicted.fadeout += uint64(total)
 	if total > 0 {
 		mp.Info().Int("num", total).Msg("evict transactions")
 	}
@@ -243,6 +257,21 @@ func (mp *MemPool) Receive(context actor.Context) {
 		txs := mp.existEx(bucketHash)
 		context.Respond(&message.MemPoolExistExRsp{Txs: txs})
 
+	case *message.MemPoolGetContent:
+		content, err := mp.content(msg.Account)
+		context.Respond(&message.MemPoolGetContentRsp{
+			Content: content,
+			Err:     err,
+		})
+	case *message.MemPoolGetSummary:
+		context.Respond(&message.MemPoolGetSummaryRsp{
+			Summary: mp.summary(),
+		})
+	case *message.MemPoolGetMetric:
+		context.Respond(&message.MemPoolGetMetricRsp{
+			Metric: mp.metric(),
+		})
+
 	case *message.MemPoolSetWhitelist:
 		mp.whitelist.SetWhitelist(msg.Accounts)
 	case *message.MemPoolEnableWhitelist:
@@ -261,6 +290,13 @@ func (mp *MemPool) Statistics() *map[string]interface{} {
 		"total":  mp.length,
 		"orphan": mp.orphan,
 		"dead":   mp.deadtx,
+		"bytes":  mp.bytes,
+		"evicted": map[string]uint64{
+			"capacity": mp.evicted.capacity,
+			"account":  mp.evicted.account,
+			"orphan":   mp.evicted.orphan,
+			"fadeout":  mp.evicted.fadeout,
+		},
 		"config": mp.cfg.Mempool,
 	}
 	if !mp.isPublic {
@@ -277,15 +313,19 @@ func (mp *MemPool) get(maxBlockBodySize uint32) ([]types.Transaction, error) {
 	count := 0
 	size := 0
 	txs := make([]types.Transaction, 0)
-Gather:
-	for _, list := range mp.pool {
-		for _, tx := range list.Get() {
-			if size += proto.Size(tx.GetTx()); uint32(size) > maxBlockBodySize {
-				break Gather
-			}
-			txs = append(txs, tx)
-			count++
+	// take the transactions of the highest gas price first, keeping the
+	// nonce order of each account. an account whose next transaction does
+	// not fit is skipped, since its following ones can not be executed.
+	heads := newTxHeads(mp.pool)
+	for ptx := heads.peek(); ptx != nil; ptx = heads.peek() {
+		if uint32(size+ptx.size) > maxBlockBodySize {
+			heads.drop()
+			continue
 		}
+		size += ptx.size
+		txs = append(txs, ptx.tx)
+		count++
+		heads.advance()
 	}
 	elapsed := time.Since(start)
 	mp.Debug().Str("elapsed", elapsed.String()).Int("len", mp.length).Int("orphan", mp.orphan).Int("count", count).Msg("total tx returned")
@@ -324,6 +364,9 @@ func (mp *MemPool) put(tx types.Transaction) error {
 	}
 	defer mp.releaseMemPoolList(list)
 	diff, err := list.Put(tx)
+	if err == types.ErrSameNonceAlreadyInMempool {
+		return mp.replace(list, tx)
+	}
 	if err != nil {
 		mp.Error().Err(err).Msg("fail to put at a mempool list")
 		return err
@@ -332,6 +375,11 @@ func (mp *MemPool) put(tx types.Transaction) error {
 	mp.orphan -= diff
 	mp.cache.Store(id, tx)
 	mp.length++
+	mp.bytes += proto.Size(tx.GetTx())
+	mp.updateTail(list)
+	if err := mp.enforceLimits(list, tx); err != nil {
+		return err
+	}
 	//mp.Debug().Str("tx_hash", enc.ToString(tx.GetHash())).Msgf("tx add-ed size(%d, %d)", len(mp.cache), mp.orphan)
 
 	if !mp.testConfig {
@@ -339,6 +387,30 @@ func (mp *MemPool) put(tx types.Transaction) error {
 	}
 	return nil
 }
+
+// replace puts tx in place of the pending tx with the same nonce in list, if
+// tx raises the gas price enough.
+func (mp *MemPool) replace(list *TxList, tx types.Transaction) error {
+	old, err := list.Replace(tx, mp.priceBump)
+	if err != nil {
+		return err
+	}
+	mp.cache.Delete(types.ToTxID(old.GetHash()))
+	mp.cache.Store(types.ToTxID(tx.GetHash()), tx)
+	mp.bytes += proto.Size(tx.GetTx()) - proto.Size(old.GetTx())
+	mp.updateTail(list)
+	mp.Debug().Str("old", enc.ToString(old.GetHash())).Str("new", enc.ToString(tx.GetHash())).Msg("tx replaced")
+	// a larger tx may push the pool over its size
+	if err := mp.enforceLimits(list, tx); err != nil {
+		return err
+	}
+
+	if !mp.testConfig {
+		mp.notifyNewTx(tx)
+	}
+	return nil
+}
+
 func (mp *MemPool) puts(txs ...types.Transaction) []error {
 	errs := make([]error, len(txs))
 	for i, tx := range txs {
@@ -439,6 +511,7 @@ func (mp *MemPool) removeOnBlockArrival(block *types.Block) error {
 		for _, tx := range delTxs {
 			mp.cache.Delete(types.ToTxID(tx.GetHash()))
 			mp.length--
+			mp.bytes -= proto.Size(tx.GetTx())
 		}
 		mp.releaseMemPoolList(list)
 		check++
@@ -459,8 +532,11 @@ func (mp *MemPool) verifyTx(tx types.Transaction) error {
 	if err != nil {
 		return err
 	}
+	mp.RLock()
+	blockNo := mp.bestBlockNo + 1
+	mp.RUnlock()
 	if !tx.GetTx().NeedNameVerify() {
-		err = key.VerifyTx(tx.GetTx())
+		err = key.VerifyTxOfBlock(tx.GetTx(), tx.GetBody().GetAccount(), blockNo)
 		if err != nil {
 			return err
 		}
@@ -468,7 +544,7 @@ func (mp *MemPool) verifyTx(tx types.Transaction) error {
 		mp.RLock()
 		account := mp.getAddress(tx.GetBody().GetAccount())
 		mp.RUnlock()
-		err = key.VerifyTxWithAddress(tx.GetTx(), account)
+		err = key.VerifyTxOfBlock(tx.GetTx(), account, blockNo)
 		if err != nil {
 			return err
 		}
@@ -517,9 +593,19 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 	//because err should be ErrNonceToohigh if following validation has passed
 	//this will be refactored soon
 
+	if tx.GetBody().GetType() != types.TxType_GOVERNANCE {
+		systemcs, serr := mp.stateDB.GetSystemAccountState()
+		if serr != nil {
+			return serr
+		}
+		if !fee.IsEnoughGasPrice(tx.GetBody().GetGasPriceBigInt(), system.GetGasPrice(systemcs, mp.bestBlockNo+1)) {
+			return types.ErrTxInvalidPrice
+		}
+	}
+
 	switch tx.GetBody().GetType() {
 	case types.TxType_REDEPLOY:
-		if chain.IsPublic() {
+		if mp.isPublic && !types.IsV2Fork(mp.bestBlockNo+1) {
 			return types.ErrTxInvalidType
 		}
 		if tx.GetBody().GetRecipient() == nil {
@@ -534,6 +620,13 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 				return types.ErrTxInvalidRecipient
 			}
 		}
+	case types.TxType_FEEDELEGATION:
+		if !types.IsV2Fork(mp.bestBlockNo + 1) {
+			return types.ErrTxInvalidType
+		}
+		if err := mp.validateFeeDelegation(tx, account); err != nil {
+			return err
+		}
 	case types.TxType_GOVERNANCE:
 		aergoState, err := mp.getAccountState(tx.GetBody().GetRecipient())
 		if err != nil {
@@ -563,7 +656,7 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 			if err != nil {
 				return err
 			}
-			if _, err := name.ValidateNameTx(tx.GetBody(), sender, scs, systemcs); err != nil {
+			if _, err := name.ValidateNameTx(tx.GetBody(), sender, scs, systemcs, mp.bestBlockNo+1); err != nil {
 				return err
 			}
 		case types.AergoEnterprise:
@@ -584,6 +677,42 @@ func (mp *MemPool) validateTx(tx types.Transaction, account types.Address) error
 	return err
 }
 
+// validateFeeDelegation checks that the recipient contract of tx can pay its
+// fee and agrees to pay it for account.
+func (mp *MemPool) validateFeeDelegation(tx types.Transaction, account types.Address) error {
+	recipient := tx.GetBody().GetRecipient()
+	if tx.GetTx().HasNameRecipient() {
+		recipient = mp.getAddress(recipient)
+		if recipient == nil {
+			return types.ErrTxInvalidRecipient
+		}
+	}
+	if mp.testConfig {
+		return nil
+	}
+	// a fresh block state keeps the query of the contract away from the
+	// state shared with the other validations
+	bs := state.NewBlockState(mp.sdb.OpenNewStateDB(mp.stateDB.GetRoot()))
+	receiver, err := bs.GetAccountStateV(recipient)
+	if err != nil {
+		return err
+	}
+	if len(receiver.State().GetCodeHash()) == 0 {
+		return types.ErrNotAllowedFeeDelegation
+	}
+	if receiver.Balance().Cmp(tx.GetMaxFee()) < 0 {
+		return types.ErrInsufficientBalance
+	}
+	contractState, err := bs.OpenContractState(receiver.AccountID(), receiver.State())
+	if err != nil {
+		return err
+	}
+	// the Lua VM must stay on a thread while running the contract
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return contract.CheckFeeDelegation(recipient, bs, nil, contractState, tx.GetBody().GetPayload(), account)
+}
+
 func (mp *MemPool) exist(hash []byte) *types.Tx {
 	v := make([]types.TxHash, 1)
 	v[0] = hash
@@ -622,6 +751,7 @@ func (mp *MemPool) acquireMemPoolList(acc []byte) (*TxList, error) {
 }
 
 func (mp *MemPool) releaseMemPoolList(list *TxList) {
+	mp.updateTail(list)
 	if list.Empty() {
 		id := types.ToAccountID(list.account)
 		delete(mp.pool, id)
@@ -666,6 +796,7 @@ func (mp *MemPool) notifyNewTx(tx types.Transaction) {
 	mp.RequestTo(message.P2PSvc, &message.NotifyNewTransactions{
 		Txs: []*types.Tx{tx.GetTx()},
 	})
+	mp.TellTo(message.RPCSvc, tx.GetTx())
 }
 
 func (mp *MemPool) isRunning() bool {
@@ -602,3 +602,115 @@ func TestDeleteInvokePriceFilterOut(t *testing.T) {
 	simulateBlockGen(txs[1:2]...)
 	checkRemainder(0, 0)
 }
+
+func TestEvictOnCapacity(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	pool.cfg.Mempool.AccountTxs = 2
+	pool.cfg.Mempool.MaxTxs = 3
+	pool.cfg.Mempool.MaxOrphans = 1
+
+	// every tx pays the same, so a new tx does not push out the pending ones
+	assert.NoError(t, pool.put(genTx(0, 0, 1, 0)))
+	assert.NoError(t, pool.put(genTx(0, 0, 2, 0)))
+	assert.Equal(t, types.ErrTxAccountPoolFull, pool.put(genTx(0, 0, 3, 0)))
+	assert.NoError(t, pool.put(genTx(1, 0, 1, 0)))
+	assert.Equal(t, types.ErrTxPoolFull, pool.put(genTx(2, 0, 1, 0)))
+
+	pool.cfg.Mempool.MaxTxs = 10
+	assert.NoError(t, pool.put(genTx(1, 0, 3, 0)))
+	assert.Equal(t, types.ErrTxPoolFull, pool.put(genTx(2, 0, 5, 0)))
+
+	total, orphan := pool.Size()
+	assert.Equal(t, 4, total)
+	assert.Equal(t, 1, orphan)
+
+	m := pool.metric()
+	assert.Equal(t, int32(2), m.Accounts)
+	assert.Equal(t, uint64(1), m.EvictedAccount)
+	assert.Equal(t, uint64(1), m.EvictedCapacity)
+	assert.Equal(t, uint64(1), m.EvictedOrphan)
+	assert.True(t, m.Bytes > 0)
+
+	txs, err := pool.get(maxBlockBodySize)
+	assert.NoError(t, err)
+	assert.Len(t, txs, 3)
+}
+
+func TestEvictLowestPrice(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	pool.cfg.Mempool.MaxTxs = 3
+
+	genPriced := func(acc int, nonce uint64, price int64) types.Transaction {
+		tx := genTx(acc, 0, nonce, 0).GetTx()
+		tx.Body.GasPrice = big.NewInt(price).Bytes()
+		tx.Hash = tx.CalculateTxHash()
+		return types.NewTransaction(tx)
+	}
+	assert.NoError(t, pool.put(genPriced(0, 1, 3)))
+	assert.NoError(t, pool.put(genPriced(1, 1, 1)))
+	assert.NoError(t, pool.put(genPriced(2, 1, 2)))
+
+	// the last tx of the lowest price goes first
+	assert.NoError(t, pool.put(genPriced(3, 1, 4)))
+	assert.Nil(t, pool.getMemPoolList(accs[1]))
+	assert.Equal(t, types.ErrTxPoolFull, pool.put(genPriced(4, 1, 1)))
+
+	// a replaced tx takes the new price
+	assert.NoError(t, pool.put(genPriced(0, 1, 10)))
+	assert.NoError(t, pool.put(genPriced(4, 1, 3)))
+	assert.Nil(t, pool.getMemPoolList(accs[2]))
+	assert.NotNil(t, pool.getMemPoolList(accs[0]))
+	assert.Equal(t, 3, pool.tails.Len())
+}
+
+func TestEvictOnReplace(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	genPriced := func(acc int, nonce uint64, price int64, payload []byte) types.Transaction {
+		tx := genTx(acc, 0, nonce, 0).GetTx()
+		tx.Body.GasPrice = big.NewInt(price).Bytes()
+		tx.Body.Payload = payload
+		tx.Hash = tx.CalculateTxHash()
+		return types.NewTransaction(tx)
+	}
+	assert.NoError(t, pool.put(genPriced(0, 1, 1, nil)))
+	assert.NoError(t, pool.put(genPriced(1, 1, 5, nil)))
+	pool.cfg.Mempool.MaxBytes = pool.bytes + 10
+
+	// the larger replacement pushes out the tx of the lowest price
+	assert.NoError(t, pool.put(genPriced(0, 1, 10, make([]byte, 100))))
+	assert.Nil(t, pool.getMemPoolList(accs[1]))
+	assert.NotNil(t, pool.getMemPoolList(accs[0]))
+	assert.True(t, pool.bytes <= pool.cfg.Mempool.MaxBytes)
+	assert.Equal(t, uint64(1), pool.metric().EvictedCapacity)
+}
+
+func TestContentAndSummary(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	for _, n := range []uint64{1, 2, 4} {
+		assert.NoError(t, pool.put(genTx(0, 0, n, 0)))
+	}
+	assert.NoError(t, pool.put(genTx(1, 0, 1, 0)))
+
+	content, err := pool.content(accs[0])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), content.NextNonce)
+	assert.Len(t, content.Pending, 2)
+	assert.Len(t, content.Queued, 1)
+	assert.Equal(t, uint64(4), content.Queued[0].GetBody().GetNonce())
+
+	content, err = pool.content(accs[2])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), content.NextNonce)
+	assert.Empty(t, content.Pending)
+
+	summary := pool.summary()
+	assert.Equal(t, int32(4), summary.Total)
+	assert.Equal(t, int32(1), summary.Orphan)
+	assert.Len(t, summary.Accounts, 2)
+}
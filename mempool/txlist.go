@@ -6,6 +6,7 @@
 package mempool
 
 import (
+	"math/big"
 	"sort"
 	"sync"
 	"time"
@@ -21,13 +22,21 @@ type TxList struct {
 	account  []byte
 	ready    int
 	list     []types.Transaction // nonce-ordered tx list
+
+	// the gas price of the last transaction and the positions of TxList in
+	// the heaps of mempool, which pick the transactions to evict
+	tailPrice   *big.Int
+	tailIndex   int
+	orphanIndex int
 }
 
 // NewTxList creates new TxList with given State
 func NewTxList(acc []byte, st *types.State) *TxList {
 	return &TxList{
-		base:    st,
-		account: acc,
+		base:        st,
+		account:     acc,
+		tailIndex:   -1,
+		orphanIndex: -1,
 	}
 }
 
@@ -121,6 +130,62 @@ func (tl *TxList) Put(tx types.Transaction) (int, error) {
 	return oldCnt - newCnt, nil
 }
 
+// Replace puts tx in place of the transaction with the same nonce, if the gas
+// price of tx is at least bump percent higher. It returns the replaced one.
+func (tl *TxList) Replace(tx types.Transaction, bump uint64) (types.Transaction, error) {
+	tl.Lock()
+	defer tl.Unlock()
+
+	index, found := tl.search(tx)
+	if !found {
+		return nil, types.ErrTxNotFound
+	}
+	old := tl.list[index]
+	if !enoughBump(old, tx, bump) {
+		return nil, types.ErrTxReplacementUnderpriced
+	}
+	tl.list[index] = tx
+
+	tl.lastTime = time.Now()
+	return old, nil
+}
+
+// last returns the transaction of the highest nonce, or nil if empty
+func (tl *TxList) last() types.Transaction {
+	tl.RLock()
+	defer tl.RUnlock()
+	if len(tl.list) == 0 {
+		return nil
+	}
+	return tl.list[len(tl.list)-1]
+}
+
+// hasOrphan reports whether TxList has transactions which are not ready
+func (tl *TxList) hasOrphan() bool {
+	tl.RLock()
+	defer tl.RUnlock()
+	return len(tl.list) > tl.ready
+}
+
+// RemoveLast removes the transaction of the highest nonce, which the other
+// transactions do not depend on. It also returns whether the removed one was
+// an orphan.
+func (tl *TxList) RemoveLast() (types.Transaction, bool) {
+	tl.Lock()
+	defer tl.Unlock()
+	n := len(tl.list)
+	if n == 0 {
+		return nil, false
+	}
+	tx := tl.list[n-1]
+	tl.list = tl.list[:n-1]
+	orphan := n > tl.ready
+	if !orphan {
+		tl.ready--
+	}
+	return tx, orphan
+}
+
 // SetMinNonce sets new minimum nonce for TxList
 // evict on some transactions is possible due to minimum nonce
 func (tl *TxList) FilterByState(st *types.State) (int, []types.Transaction) {
@@ -185,6 +250,21 @@ func (tl *TxList) Get() []types.Transaction {
 	return tl.list[:tl.ready]
 }
 
+// Content returns the nonce which the next transaction should have to be
+// processible, the processible transactions and the orphans
+func (tl *TxList) Content() (uint64, []*types.Tx, []*types.Tx) {
+	tl.RLock()
+	defer tl.RUnlock()
+	toTxs := func(list []types.Transaction) []*types.Tx {
+		txs := make([]*types.Tx, len(list))
+		for i, tx := range list {
+			txs[i] = tx.GetTx()
+		}
+		return txs
+	}
+	return tl.base.Nonce + uint64(tl.ready) + 1, toTxs(tl.list[:tl.ready]), toTxs(tl.list[tl.ready:])
+}
+
 // GetAll returns all transactions including orphans
 func (tl *TxList) GetAll() []types.Transaction {
 	tl.Lock()
@@ -84,6 +84,38 @@ func TestListPutErrors(t *testing.T) {
 	}
 
 }
+func TestListReplace(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	mpl := NewTxList(nil, NewState(0, 0))
+
+	genPriced := func(nonce uint64, price int64) types.Transaction {
+		tx := genTx(0, 0, nonce, 0).GetTx()
+		tx.Body.GasPrice = big.NewInt(price).Bytes()
+		tx.Hash = tx.CalculateTxHash()
+		return types.NewTransaction(tx)
+	}
+	pending := genPriced(1, 100)
+	if _, err := mpl.Put(pending); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := mpl.Replace(genPriced(2, 200), 10); err != types.ErrTxNotFound {
+		t.Errorf("replace should be failed with ErrTxNotFound, but %s", err)
+	}
+	if _, err := mpl.Replace(genPriced(1, 109), 10); err != types.ErrTxReplacementUnderpriced {
+		t.Errorf("replace should be failed with ErrTxReplacementUnderpriced, but %s", err)
+	}
+	bumped := genPriced(1, 110)
+	old, err := mpl.Replace(bumped, 10)
+	if err != nil || old != pending {
+		t.Errorf("replace should be not failed, but %s", err)
+	}
+	if ret := mpl.Get(); len(ret) != 1 || ret[0] != bumped {
+		t.Error("replaced tx is not in list")
+	}
+}
+
 func TestListDel(t *testing.T) {
 	initTest(t)
 	defer deinitTest()
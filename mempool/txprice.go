@@ -0,0 +1,112 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package mempool
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// pricedTx is a transaction with the gas price used to rank it and its size
+type pricedTx struct {
+	tx    types.Transaction
+	price *big.Int
+	size  int
+}
+
+func newPricedTx(tx types.Transaction) *pricedTx {
+	return &pricedTx{
+		tx:    tx,
+		price: tx.GetBody().GetGasPriceBigInt(),
+		size:  proto.Size(tx.GetTx()),
+	}
+}
+
+// higherPrice reports whether a offers a higher gas price than b. The gas
+// price is what a transaction pays per unit of the work it causes, unlike its
+// maximum fee, which only bounds the fee.
+func (a *pricedTx) higherPrice(b *pricedTx) bool {
+	return a.price.Cmp(b.price) > 0
+}
+
+// txHead is the next ready transaction of an account and the rest of them
+type txHead struct {
+	*pricedTx
+	rest []types.Transaction
+}
+
+// txHeads is a max heap of accounts ordered by the gas price of their next
+// transaction. Taking one transaction at a time from the top keeps the nonce
+// order of each account, while the accounts compete by price.
+type txHeads []*txHead
+
+func (h txHeads) Len() int            { return len(h) }
+func (h txHeads) Less(i, j int) bool  { return h[i].higherPrice(h[j].pricedTx) }
+func (h txHeads) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *txHeads) Push(x interface{}) { *h = append(*h, x.(*txHead)) }
+func (h *txHeads) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// newTxHeads returns the heap of the ready transactions of lists
+func newTxHeads(lists map[types.AccountID]*TxList) *txHeads {
+	h := make(txHeads, 0, len(lists))
+	for _, list := range lists {
+		if ready := list.Get(); len(ready) > 0 {
+			h = append(h, &txHead{pricedTx: newPricedTx(ready[0]), rest: ready[1:]})
+		}
+	}
+	heap.Init(&h)
+	return &h
+}
+
+// peek returns the transaction with the highest gas price among the
+// accounts, or nil if no transaction is left.
+func (h *txHeads) peek() *pricedTx {
+	if h.Len() == 0 {
+		return nil
+	}
+	return (*h)[0].pricedTx
+}
+
+// advance moves the account of the top transaction to its following
+// transaction.
+func (h *txHeads) advance() {
+	top := (*h)[0]
+	if len(top.rest) == 0 {
+		heap.Pop(h)
+		return
+	}
+	top.pricedTx = newPricedTx(top.rest[0])
+	top.rest = top.rest[1:]
+	heap.Fix(h, 0)
+}
+
+// drop removes the account of the top transaction, whose following
+// transactions can not be taken either.
+func (h *txHeads) drop() {
+	heap.Pop(h)
+}
+
+// enoughBump reports whether the gas price of tx is at least bump percent
+// higher than the one of old, so that tx can replace old.
+func enoughBump(old, tx types.Transaction, bump uint64) bool {
+	oldPrice := old.GetBody().GetGasPriceBigInt()
+	newPrice := tx.GetBody().GetGasPriceBigInt()
+	if newPrice.Cmp(oldPrice) <= 0 {
+		return false
+	}
+	l := new(big.Int).Mul(newPrice, big.NewInt(100))
+	r := new(big.Int).Mul(oldPrice, new(big.Int).SetUint64(100+bump))
+	return l.Cmp(r) >= 0
+}
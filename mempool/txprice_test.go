@@ -0,0 +1,51 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+package mempool
+
+import (
+	"container/heap"
+	"math/big"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxHeadsOrder(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	// account 0 pays the most for its first tx, but its second tx pays
+	// nothing and must wait for the others
+	h := &txHeads{
+		{pricedTx: &pricedTx{tx: genTx(0, 0, 1, 0), price: big.NewInt(3), size: 100},
+			rest: []types.Transaction{genTx(0, 0, 2, 0)}},
+		{pricedTx: &pricedTx{tx: genTx(1, 0, 1, 0), price: big.NewInt(1), size: 50}},
+		{pricedTx: &pricedTx{tx: genTx(2, 0, 1, 0), price: big.NewInt(2), size: 100}},
+	}
+	heap.Init(h)
+
+	var got []types.Transaction
+	for ptx := h.peek(); ptx != nil; ptx = h.peek() {
+		got = append(got, ptx.tx)
+		h.advance()
+	}
+	assert.Len(t, got, 4)
+	assert.Equal(t, accs[0], got[0].GetBody().GetAccount())
+	assert.Equal(t, accs[2], got[1].GetBody().GetAccount())
+	assert.Equal(t, accs[1], got[2].GetBody().GetAccount())
+	assert.Equal(t, uint64(2), got[3].GetBody().GetNonce())
+}
+
+func TestEnoughBump(t *testing.T) {
+	genPriced := func(price int64) types.Transaction {
+		return types.NewTransaction(&types.Tx{Body: &types.TxBody{GasPrice: big.NewInt(price).Bytes()}})
+	}
+	assert.False(t, enoughBump(genPriced(0), genPriced(0), 0))
+	assert.True(t, enoughBump(genPriced(0), genPriced(1), 10))
+	assert.False(t, enoughBump(genPriced(1000), genPriced(1099), 10))
+	assert.True(t, enoughBump(genPriced(1000), genPriced(1100), 10))
+	assert.True(t, enoughBump(genPriced(1000), genPriced(1001), 0))
+}
@@ -45,7 +45,10 @@ type AddBlockRsp struct {
 	Err       error
 }
 type GetState struct {
-	Account []byte
+	Account    []byte
+	BlockNo    types.BlockNo
+	HasBlockNo bool
+	BlockHash  []byte
 }
 type GetStateRsp struct {
 	Account []byte
@@ -56,6 +59,9 @@ type GetStateAndProof struct {
 	Account    []byte
 	Root       []byte
 	Compressed bool
+	BlockNo    types.BlockNo
+	HasBlockNo bool
+	BlockHash  []byte
 }
 type GetStateAndProofRsp struct {
 	StateProof *types.AccountProof
@@ -78,6 +84,86 @@ type GetReceiptRsp struct {
 	Err     error
 }
 
+// GetReceiptProof requests the receipt of TxHash with its merkle proof. If
+// TxHash is empty, the proof of the bloom filter of block BlockHash is
+// requested instead.
+type GetReceiptProof struct {
+	TxHash    []byte
+	BlockHash []byte
+}
+type GetReceiptProofRsp struct {
+	Proof *types.ReceiptProof
+	Err   error
+}
+
+// GetStateRange requests up to Limit leaves of the state trie or the contract
+// storage trie of Root from Start, with the data blobs of the leaves.
+type GetStateRange struct {
+	Root  []byte
+	Start []byte
+	Limit uint32
+}
+type GetStateRangeRsp struct {
+	Keys    [][]byte
+	Values  [][]byte
+	Data    [][]byte
+	HasNext bool
+	Err     error
+}
+
+// GetStateData requests the data blobs of Hashes, such as contract codes.
+type GetStateData struct {
+	Hashes [][]byte
+}
+type GetStateDataRsp struct {
+	Data [][]byte
+	Err  error
+}
+
+// GetContractDB requests a part of the copy of the sql database file of the
+// contract, which is identified by its AccountID, the key of its state. The
+// copy contains the commits up to RecoveryPoint at least.
+type GetContractDB struct {
+	AccountID     []byte
+	RecoveryPoint uint64
+	Offset        uint64
+	Size          uint32
+}
+type GetContractDBRsp struct {
+	Data  []byte
+	Total uint64
+	Err   error
+}
+
+// AddSnapshotBlocks stores Blocks before the pivot of snapshot sync without
+// executing them.
+type AddSnapshotBlocks struct {
+	Blocks []*types.Block
+}
+type AddSnapshotBlocksRsp struct {
+	Err error
+}
+
+// ImportSnapshot makes Block the best block of the chain, whose state was
+// downloaded by snapshot sync instead of executing the blocks before it.
+type ImportSnapshot struct {
+	Block *types.Block
+}
+type ImportSnapshotRsp struct {
+	Err error
+}
+
+type GetAccountTxs struct {
+	Account []byte
+	Offset  uint32
+	Size    uint32
+	Asc     bool
+}
+type GetAccountTxsRsp struct {
+	Txs []*types.AccountTx
+	Err error
+}
+
 type GetABI struct {
 	Contract []byte
 }
@@ -87,8 +173,11 @@ type GetABIRsp struct {
 }
 
 type GetQuery struct {
-	Contract  []byte
-	Queryinfo []byte
+	Contract   []byte
+	Queryinfo  []byte
+	BlockNo    types.BlockNo
+	HasBlockNo bool
+	BlockHash  []byte
 }
 type GetQueryRsp struct {
 	Result []byte
@@ -99,12 +188,25 @@ type GetStateQuery struct {
 	StorageKeys     [][]byte
 	Root            []byte
 	Compressed      bool
+	BlockNo         types.BlockNo
+	HasBlockNo      bool
+	BlockHash       []byte
 }
 type GetStateQueryRsp struct {
 	Result *types.StateQueryProof
 	Err    error
 }
 
+// SimulateTx is request to execute a tx on top of the best block without
+// committing the result.
+type SimulateTx struct {
+	Tx *types.Tx
+}
+type SimulateTxRsp struct {
+	Result *types.SimulateResult
+	Err    error
+}
+
 // SyncBlockState is request to sync from remote peer. It returns sync result.
 type SyncBlockState struct {
 	PeerID    types.PeerID
@@ -145,6 +247,8 @@ type GetStakingRsp struct {
 type GetNameInfo struct {
 	Name    string
 	BlockNo types.BlockNo
+	// Reverse requests the primary name of the address in Name
+	Reverse bool
 }
 
 type GetNameInfoRsp struct {
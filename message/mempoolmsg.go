@@ -71,3 +71,33 @@ type MemPoolDel struct {
 type MemPoolDelRsp struct {
 	Err error
 }
+
+// MemPoolGetContent is interface of MemPool service for getting the pending
+// and queued transactions of an account
+type MemPoolGetContent struct {
+	Account []byte
+}
+
+// MemPoolGetContentRsp defines struct of result for MemPoolGetContent
+type MemPoolGetContentRsp struct {
+	Content *types.MempoolAccount
+	Err     error
+}
+
+// MemPoolGetSummary is interface of MemPool service for getting the number
+// of transactions of each account
+type MemPoolGetSummary struct{}
+
+// MemPoolGetSummaryRsp defines struct of result for MemPoolGetSummary
+type MemPoolGetSummaryRsp struct {
+	Summary *types.MempoolSummary
+}
+
+// MemPoolGetMetric is interface of MemPool service for getting the capacity
+// and eviction metric of mempool
+type MemPoolGetMetric struct{}
+
+// MemPoolGetMetricRsp defines struct of result for MemPoolGetMetric
+type MemPoolGetMetricRsp struct {
+	Metric *types.MempoolMetric
+}
@@ -23,6 +23,7 @@ var (
 	TooManyBlocksError   = fmt.Errorf("too many blocks received that expected")
 	TooBigBlockError     = fmt.Errorf("block size limit exceeded")
 	InvalidArgumentError = fmt.Errorf("invalid argument")
+	UnsupportedError     = fmt.Errorf("remote peer does not support the request")
 )
 
 // PingMsg send types.Ping to each peer.
@@ -131,6 +132,9 @@ type PeerInfo struct {
 	LastBlockNumber uint64
 	State           types.PeerState
 	Self            bool
+	// Latency is the average response latency of the peer. It is zero if
+	// unknown.
+	Latency time.Duration
 }
 
 // GetPeersRsp contains peer meta information and current states.
@@ -154,6 +158,61 @@ type GetSyncAncestorRsp struct {
 	Ancestor *types.BlockInfo
 }
 
+// GetSyncStateRange is sent by the syncer to request up to Limit leaves of
+// the state trie or a contract storage trie of Root from Start, with their
+// data blobs, to ToWhom.
+type GetSyncStateRange struct {
+	Seq    uint64
+	ToWhom types.PeerID
+	Root   []byte
+	Start  []byte
+	Limit  uint32
+}
+
+// GetSyncStateRangeRsp is data from other peer, as a response of types.GetStateRangeRequest
+type GetSyncStateRangeRsp struct {
+	Seq     uint64
+	Keys    [][]byte
+	Values  [][]byte
+	Data    [][]byte
+	HasNext bool
+	Err     error
+}
+
+// GetSyncStateData is sent by the syncer to request the data blobs of Hashes,
+// such as contract codes, to ToWhom.
+type GetSyncStateData struct {
+	Seq    uint64
+	ToWhom types.PeerID
+	Hashes [][]byte
+}
+
+// GetSyncStateDataRsp is data from other peer, as a response of types.GetStateDataRequest
+type GetSyncStateDataRsp struct {
+	Seq  uint64
+	Data [][]byte
+	Err  error
+}
+
+// GetSyncContractDB is sent by the syncer to request a part of the sql
+// database file of the contract of AccountID at RecoveryPoint to ToWhom.
+type GetSyncContractDB struct {
+	Seq           uint64
+	ToWhom        types.PeerID
+	AccountID     []byte
+	RecoveryPoint uint64
+	Offset        uint64
+	Size          uint32
+}
+
+// GetSyncContractDBRsp is data from other peer, as a response of types.GetContractDBRequest
+type GetSyncContractDBRsp struct {
+	Seq   uint64
+	Data  []byte
+	Total uint64
+	Err   error
+}
+
 type GetHashes struct {
 	Seq      uint64
 	ToWhom   types.PeerID
@@ -169,6 +228,23 @@ type GetHashesRsp struct {
 	Err      error
 }
 
+// GetSyncHeaders is sent by the syncer to request Count block headers of the
+// main chain of ToWhom from StartNo.
+type GetSyncHeaders struct {
+	Seq     uint64
+	ToWhom  types.PeerID
+	StartNo types.BlockNo
+	Count   uint64
+}
+
+// GetSyncHeadersRsp is data from other peer, as a response of types.GetLightHeadersRequest
+type GetSyncHeadersRsp struct {
+	Seq     uint64
+	StartNo types.BlockNo
+	Headers []*types.BlockHeader
+	Err     error
+}
+
 type GetHashByNo struct {
 	Seq     uint64
 	ToWhom  types.PeerID
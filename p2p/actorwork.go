@@ -122,6 +122,32 @@ func (p2ps *P2P) GetBlockHashByNo(context actor.Context, msg *message.GetHashByN
 	receiver.StartGet()
 }
 
+// GetSyncHeaders request remote peer block headers of its main chain for sync
+func (p2ps *P2P) GetSyncHeaders(context actor.Context, msg *message.GetSyncHeaders) {
+	peerID := msg.ToWhom
+	remotePeer, exists := p2ps.pm.GetPeer(peerID)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Str(p2putil.LogProtoID, p2pcommon.GetLightHeadersRequest.String()).Msg("Invalid peerID")
+		context.Respond(&message.GetSyncHeadersRsp{Seq: msg.Seq, StartNo: msg.StartNo, Err: message.PeerNotFoundError})
+		return
+	}
+	if !remotePeer.Meta().SyncHeaders {
+		// the peers of older versions close the connection on unknown
+		// protocols, so the syncer falls back to the block hashes
+		context.Respond(&message.GetSyncHeadersRsp{Seq: msg.Seq, StartNo: msg.StartNo, Err: message.UnsupportedError})
+		return
+	}
+	req := &types.GetLightHeadersRequest{StartNo: msg.StartNo, Size: uint32(msg.Count)}
+	receiver := NewSyncReceiver(p2ps, remotePeer, p2pcommon.GetLightHeadersRequest, req, func(body p2pcommon.MessageBody) interface{} {
+		resp := body.(*types.GetLightHeadersResponse)
+		if resp.Status != types.ResultStatus_OK {
+			return &message.GetSyncHeadersRsp{Seq: msg.Seq, StartNo: msg.StartNo, Err: message.RemotePeerFailError}
+		}
+		return &message.GetSyncHeadersRsp{Seq: msg.Seq, StartNo: msg.StartNo, Headers: resp.Headers}
+	}, fetchTimeOut)
+	receiver.StartGet()
+}
+
 // NotifyNewBlock send notice message of new block to a peer
 func (p2ps *P2P) NotifyNewBlock(newBlock message.NotifyNewBlock) bool {
 	req := &types.NewBlockNotice{
@@ -228,6 +254,66 @@ func (p2ps *P2P) GetSyncAncestor(context actor.Context, msg *message.GetSyncAnce
 	return
 }
 
+// GetSyncStateRange request remote peer a range of state trie for snapshot sync
+func (p2ps *P2P) GetSyncStateRange(context actor.Context, msg *message.GetSyncStateRange) {
+	peerID := msg.ToWhom
+	remotePeer, exists := p2ps.pm.GetPeer(peerID)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Str(p2putil.LogProtoID, p2pcommon.GetStateRangeRequest.String()).Msg("Invalid peerID")
+		context.Respond(&message.GetSyncStateRangeRsp{Seq: msg.Seq, Err: message.PeerNotFoundError})
+		return
+	}
+	req := &types.GetStateRangeRequest{Root: msg.Root, Start: msg.Start, Limit: msg.Limit}
+	receiver := NewSyncReceiver(p2ps, remotePeer, p2pcommon.GetStateRangeRequest, req, func(body p2pcommon.MessageBody) interface{} {
+		resp := body.(*types.GetStateRangeResponse)
+		if resp.Status != types.ResultStatus_OK {
+			return &message.GetSyncStateRangeRsp{Seq: msg.Seq, Err: message.RemotePeerFailError}
+		}
+		return &message.GetSyncStateRangeRsp{Seq: msg.Seq, Keys: resp.Keys, Values: resp.Values, Data: resp.Data, HasNext: resp.HasNext}
+	}, fetchTimeOut)
+	receiver.StartGet()
+}
+
+// GetSyncStateData request remote peer data blobs of state for snapshot sync
+func (p2ps *P2P) GetSyncStateData(context actor.Context, msg *message.GetSyncStateData) {
+	peerID := msg.ToWhom
+	remotePeer, exists := p2ps.pm.GetPeer(peerID)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Str(p2putil.LogProtoID, p2pcommon.GetStateDataRequest.String()).Msg("Invalid peerID")
+		context.Respond(&message.GetSyncStateDataRsp{Seq: msg.Seq, Err: message.PeerNotFoundError})
+		return
+	}
+	req := &types.GetStateDataRequest{Hashes: msg.Hashes}
+	receiver := NewSyncReceiver(p2ps, remotePeer, p2pcommon.GetStateDataRequest, req, func(body p2pcommon.MessageBody) interface{} {
+		resp := body.(*types.GetStateDataResponse)
+		if resp.Status != types.ResultStatus_OK {
+			return &message.GetSyncStateDataRsp{Seq: msg.Seq, Err: message.RemotePeerFailError}
+		}
+		return &message.GetSyncStateDataRsp{Seq: msg.Seq, Data: resp.Data}
+	}, fetchTimeOut)
+	receiver.StartGet()
+}
+
+// GetSyncContractDB request remote peer a part of contract database for snapshot sync
+func (p2ps *P2P) GetSyncContractDB(context actor.Context, msg *message.GetSyncContractDB) {
+	peerID := msg.ToWhom
+	remotePeer, exists := p2ps.pm.GetPeer(peerID)
+	if !exists {
+		p2ps.Warn().Str(p2putil.LogPeerID, p2putil.ShortForm(peerID)).Str(p2putil.LogProtoID, p2pcommon.GetContractDBRequest.String()).Msg("Invalid peerID")
+		context.Respond(&message.GetSyncContractDBRsp{Seq: msg.Seq, Err: message.PeerNotFoundError})
+		return
+	}
+	req := &types.GetContractDBRequest{AccountID: msg.AccountID, RecoveryPoint: msg.RecoveryPoint, Offset: msg.Offset, Size: msg.Size}
+	receiver := NewSyncReceiver(p2ps, remotePeer, p2pcommon.GetContractDBRequest, req, func(body p2pcommon.MessageBody) interface{} {
+		resp := body.(*types.GetContractDBResponse)
+		if resp.Status != types.ResultStatus_OK {
+			return &message.GetSyncContractDBRsp{Seq: msg.Seq, Err: message.RemotePeerFailError}
+		}
+		return &message.GetSyncContractDBRsp{Seq: msg.Seq, Data: resp.Data, Total: resp.Total}
+	}, fetchTimeOut)
+	receiver.StartGet()
+}
+
 func (p2ps *P2P) SendRaftMessage(context actor.Context, msg *message.SendRaft) {
 	body, ok := msg.Body.(raftpb.Message)
 	if !ok {
@@ -0,0 +1,208 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+// Package light is a reference light client. It keeps only the block headers
+// synced from a full node, and verifies receipts and states served by the
+// light client subprotocols against the roots in those headers.
+package light
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+
+	"github.com/aergoio/aergo/types"
+)
+
+// DefaultHeaderBatch is the number of headers requested at once while syncing.
+const DefaultHeaderBatch = 1000
+
+var (
+	ErrBrokenChain   = errors.New("header is not linked to the previous header")
+	ErrInvalidSign   = errors.New("invalid block signature")
+	ErrUnknownBP     = errors.New("block is not signed by a block producer")
+	ErrUnknownBlock  = errors.New("block header is not synced")
+	ErrInvalidProof  = errors.New("invalid merkle proof")
+	ErrProofMismatch = errors.New("proof does not match the request")
+)
+
+// Fetcher requests light client data from a full node. Fetchers only carry
+// data; nothing they return is trusted before the Client verifies it.
+type Fetcher interface {
+	// GetHeaders returns up to size headers from startNo in ascending order.
+	// It returns an empty list if the node has no block of startNo.
+	GetHeaders(startNo types.BlockNo, size uint32) ([]*types.BlockHeader, error)
+	// GetReceiptProof returns the receipt of txHash with its merkle proof.
+	GetReceiptProof(txHash []byte) (*types.ReceiptProof, error)
+	// GetBloomProof returns the bloom filter of the block with its merkle
+	// proof.
+	GetBloomProof(blockHash []byte) (*types.ReceiptProof, error)
+	// GetStateProof returns the account state and the contract variables of
+	// storageKeys at the block, with their merkle proofs.
+	GetStateProof(blockHash []byte, account []byte, storageKeys [][]byte) (*types.StateQueryProof, error)
+}
+
+// BPList returns the block producers of the election period which a block
+// belongs to. The light client trusts it as it trusts the checkpoint, so it
+// must not be served by the full node being verified.
+type BPList interface {
+	BPs(blockNo types.BlockNo) ([]types.PeerID, error)
+}
+
+// StaticBPs is the BPList of a chain whose block producers never change.
+type StaticBPs []types.PeerID
+
+// BPs returns s for every block.
+func (s StaticBPs) BPs(types.BlockNo) ([]types.PeerID, error) {
+	return s, nil
+}
+
+// Client syncs block headers from a trusted checkpoint and verifies the data
+// of the full node against them.
+type Client struct {
+	mutex   sync.RWMutex
+	fetcher Fetcher
+	bps     BPList
+	batch   uint32
+
+	headers []*types.Block
+	noByID  map[types.BlockID]types.BlockNo
+}
+
+// NewClient creates a light client which trusts checkpoint, usually the
+// genesis block header, and syncs the headers after it using fetcher. Every
+// synced header must be signed by one of the block producers in bps.
+func NewClient(fetcher Fetcher, checkpoint *types.BlockHeader, bps BPList) *Client {
+	c := &Client{
+		fetcher: fetcher,
+		bps:     bps,
+		batch:   DefaultHeaderBatch,
+		noByID:  make(map[types.BlockID]types.BlockNo),
+	}
+	c.append(&types.Block{Header: checkpoint})
+	return c
+}
+
+// SetBatch sets the number of headers requested at once.
+func (c *Client) SetBatch(batch uint32) {
+	c.batch = batch
+}
+
+// Best returns the header of the last synced block.
+func (c *Client) Best() *types.BlockHeader {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.headers[len(c.headers)-1].Header
+}
+
+// Header returns the synced header of blockNo, or nil if it is not synced.
+func (c *Client) Header(blockNo types.BlockNo) *types.BlockHeader {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if blk := c.blockByNo(blockNo); blk != nil {
+		return blk.Header
+	}
+	return nil
+}
+
+// HeaderByHash returns the synced header of blockHash, or nil if it is not
+// synced.
+func (c *Client) HeaderByHash(blockHash []byte) *types.BlockHeader {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if blk := c.blockByHash(blockHash); blk != nil {
+		return blk.Header
+	}
+	return nil
+}
+
+// Sync fetches the headers after the best synced one until the full node
+// has no more. It returns the number of headers added. If a fetched header
+// does not link to the best one, ErrBrokenChain is returned and the caller
+// may Rewind to get over a reorganization of the full node.
+func (c *Client) Sync() (int, error) {
+	synced := 0
+	for {
+		best := c.Best()
+		headers, err := c.fetcher.GetHeaders(best.BlockNo+1, c.batch)
+		if err != nil {
+			return synced, err
+		}
+		for _, h := range headers {
+			if err := c.add(h); err != nil {
+				return synced, err
+			}
+			synced++
+		}
+		if uint32(len(headers)) < c.batch {
+			return synced, nil
+		}
+	}
+}
+
+// Rewind drops the last n synced headers. The checkpoint is never dropped.
+func (c *Client) Rewind(n int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if n >= len(c.headers) {
+		n = len(c.headers) - 1
+	}
+	for _, blk := range c.headers[len(c.headers)-n:] {
+		delete(c.noByID, blk.BlockID())
+	}
+	c.headers = c.headers[:len(c.headers)-n]
+}
+
+func (c *Client) add(h *types.BlockHeader) error {
+	blk := &types.Block{Header: h}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	parent := c.headers[len(c.headers)-1]
+	if h.BlockNo != parent.Header.BlockNo+1 ||
+		!bytes.Equal(h.PrevBlockHash, parent.BlockHash()) ||
+		!blk.ValidChildOf(parent) {
+		return ErrBrokenChain
+	}
+	if valid, err := blk.VerifySign(); err != nil || !valid {
+		return ErrInvalidSign
+	}
+	id, err := blk.BPID()
+	if err != nil {
+		return ErrInvalidSign
+	}
+	bps, err := c.bps.BPs(h.BlockNo)
+	if err != nil {
+		return err
+	}
+	for _, bp := range bps {
+		if bp == id {
+			c.append(blk)
+			return nil
+		}
+	}
+	return ErrUnknownBP
+}
+
+func (c *Client) append(blk *types.Block) {
+	c.noByID[blk.BlockID()] = blk.Header.BlockNo
+	c.headers = append(c.headers, blk)
+}
+
+func (c *Client) blockByNo(blockNo types.BlockNo) *types.Block {
+	first := c.headers[0].Header.BlockNo
+	if blockNo < first || blockNo-first >= types.BlockNo(len(c.headers)) {
+		return nil
+	}
+	return c.headers[blockNo-first]
+}
+
+func (c *Client) blockByHash(blockHash []byte) *types.Block {
+	no, exist := c.noByID[types.ToBlockID(blockHash)]
+	if !exist {
+		return nil
+	}
+	return c.blockByNo(no)
+}
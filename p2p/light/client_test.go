@@ -0,0 +1,268 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/willf/bloom"
+)
+
+type testFetcher struct {
+	key      crypto.PrivKey
+	bps      StaticBPs
+	headers  []*types.BlockHeader
+	receipts map[types.BlockID]*types.Receipts
+	states   map[types.BlockID]*trie.Trie
+	accounts map[types.AccountID]*types.State
+}
+
+func (f *testFetcher) GetHeaders(startNo types.BlockNo, size uint32) ([]*types.BlockHeader, error) {
+	var headers []*types.BlockHeader
+	for no := startNo; no < types.BlockNo(len(f.headers)) && uint32(len(headers)) < size; no++ {
+		headers = append(headers, f.headers[no])
+	}
+	return headers, nil
+}
+
+func (f *testFetcher) GetReceiptProof(txHash []byte) (*types.ReceiptProof, error) {
+	for id, rs := range f.receipts {
+		for i, r := range rs.Get() {
+			if bytes.Equal(r.TxHash, txHash) {
+				return f.receiptProof(id, i), nil
+			}
+		}
+	}
+	return nil, ErrUnknownBlock
+}
+
+func (f *testFetcher) GetBloomProof(blockHash []byte) (*types.ReceiptProof, error) {
+	id := types.ToBlockID(blockHash)
+	p := f.receiptProof(id, len(f.receipts[id].Get()))
+	p.Bloom = f.receipts[id].BloomBytes()
+	return p, nil
+}
+
+func (f *testFetcher) receiptProof(id types.BlockID, idx int) *types.ReceiptProof {
+	p := &types.ReceiptProof{BlockHash: id[:], Index: uint32(idx), AuditPath: f.receipts[id].MerkleProof(idx)}
+	for _, h := range f.headers {
+		if bytes.Equal((&types.Block{Header: h}).BlockHash(), id[:]) {
+			p.BlockNo = h.BlockNo
+		}
+	}
+	if idx < len(f.receipts[id].Get()) {
+		p.Receipt = f.receipts[id].Get()[idx]
+	}
+	return p
+}
+
+func (f *testFetcher) GetStateProof(blockHash []byte, account []byte, storageKeys [][]byte) (*types.StateQueryProof, error) {
+	t := f.states[types.ToBlockID(blockHash)]
+	id := types.ToAccountID(account)
+	ap, included, proofKey, proofVal, err := t.MerkleProof(id[:])
+	if err != nil {
+		return nil, err
+	}
+	p := &types.AccountProof{Key: account, Inclusion: included, ProofKey: proofKey, ProofVal: proofVal, AuditPath: ap}
+	if included {
+		p.State = f.accounts[id]
+		p.ProofKey, p.ProofVal = nil, nil
+	}
+	return &types.StateQueryProof{ContractProof: p}, nil
+}
+
+func newTestChain(n int) *testFetcher {
+	key, _, _ := crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	id, _ := types.IDFromPrivateKey(key)
+	f := &testFetcher{
+		key:      key,
+		bps:      StaticBPs{id},
+		receipts: make(map[types.BlockID]*types.Receipts),
+		states:   make(map[types.BlockID]*trie.Trie),
+		accounts: make(map[types.AccountID]*types.State),
+	}
+	for i := 0; i < n; i++ {
+		f.headers = append(f.headers, &types.BlockHeader{ChainID: []byte("light"), BlockNo: types.BlockNo(i)})
+	}
+	f.seal(1)
+	return f
+}
+
+// seal links the headers from the one of from to their previous headers and
+// signs them.
+func (f *testFetcher) seal(from int) {
+	for _, h := range f.headers[from:] {
+		prev := &types.Block{Header: f.headers[h.BlockNo-1]}
+		h.PrevBlockHash = prev.BlockHash()
+		if err := (&types.Block{Header: h}).Sign(f.key); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func TestClientSync(t *testing.T) {
+	f := newTestChain(25)
+	c := NewClient(f, f.headers[0], f.bps)
+	c.SetBatch(10)
+
+	synced, err := c.Sync()
+	assert.NoError(t, err)
+	assert.Equal(t, 24, synced)
+	assert.Equal(t, types.BlockNo(24), c.Best().BlockNo)
+	assert.Equal(t, f.headers[7], c.Header(7))
+	assert.Equal(t, f.headers[7], c.HeaderByHash(f.headers[8].PrevBlockHash))
+	assert.Nil(t, c.Header(25))
+
+	// the full node switched to another branch after block 20
+	f.headers = f.headers[:21]
+	for i := 21; i < 30; i++ {
+		f.headers = append(f.headers, &types.BlockHeader{ChainID: []byte("light"), BlockNo: types.BlockNo(i), Timestamp: 1})
+	}
+	f.seal(21)
+	_, err = c.Sync()
+	assert.Equal(t, ErrBrokenChain, err)
+
+	c.Rewind(4)
+	assert.Equal(t, types.BlockNo(20), c.Best().BlockNo)
+	synced, err = c.Sync()
+	assert.NoError(t, err)
+	assert.Equal(t, 9, synced)
+	assert.Equal(t, types.BlockNo(29), c.Best().BlockNo)
+
+	c.Rewind(100)
+	assert.Equal(t, types.BlockNo(0), c.Best().BlockNo)
+}
+
+func TestClientSigner(t *testing.T) {
+	f := newTestChain(3)
+	c := NewClient(f, f.headers[0], f.bps)
+
+	// unsigned header
+	f.headers[1].PubKey, f.headers[1].Sign = nil, nil
+	_, err := c.Sync()
+	assert.Equal(t, ErrInvalidSign, err)
+
+	// header signed by a node which is not a block producer
+	f.key, _, _ = crypto.GenerateKeyPair(crypto.Secp256k1, 256)
+	f.seal(1)
+	_, err = c.Sync()
+	assert.Equal(t, ErrUnknownBP, err)
+
+	id, _ := types.IDFromPrivateKey(f.key)
+	c = NewClient(f, f.headers[0], StaticBPs{id})
+	synced, err := c.Sync()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, synced)
+}
+
+func TestClientReceipt(t *testing.T) {
+	contract := make([]byte, 33)
+	contract[0] = 1
+	f := newTestChain(3)
+
+	var rs types.Receipts
+	list := make([]*types.Receipt, 3)
+	for i := range list {
+		r := types.NewReceipt(contract, "SUCCESS", `"ret"`)
+		r.TxHash = make([]byte, 32)
+		r.TxHash[0] = byte(i + 1)
+		list[i] = r
+	}
+	rs.Set(list)
+	bf := bloom.New(types.BloomBitBits, types.BloomHashKNum)
+	bf.Add(contract)
+	assert.NoError(t, rs.MergeBloom(bf))
+
+	// block 2 carries the receipts
+	f.headers[2].ReceiptsRootHash = rs.MerkleRoot()
+	f.seal(2)
+	blockHash := (&types.Block{Header: f.headers[2]}).BlockHash()
+	f.receipts[types.ToBlockID(blockHash)] = &rs
+
+	c := NewClient(f, f.headers[0], f.bps)
+	_, err := c.Receipt(list[1].TxHash)
+	assert.Equal(t, ErrUnknownBlock, err, "block is not synced yet")
+
+	_, err = c.Sync()
+	assert.NoError(t, err)
+	r, err := c.Receipt(list[1].TxHash)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r.TxIndex)
+
+	found, err := c.MayContainEvent(blockHash, contract, "")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	found, err = c.MayContainEvent(blockHash, []byte("other contract"), "")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// tampered receipt
+	list[1].Ret = `"forged"`
+	_, err = c.Receipt(list[1].TxHash)
+	assert.Equal(t, ErrInvalidProof, err)
+}
+
+func TestClientState(t *testing.T) {
+	f := newTestChain(2)
+
+	accounts := [][]byte{[]byte("account1"), []byte("account2"), []byte("account3")}
+	var keys, values [][]byte
+	for i, acc := range accounts[:2] {
+		id := types.ToAccountID(acc)
+		st := &types.State{Nonce: uint64(i + 1), Balance: []byte{byte(i + 10)}}
+		raw, err := proto.Marshal(st)
+		assert.NoError(t, err)
+		f.accounts[id] = st
+		keys = append(keys, id[:])
+		values = append(values, common.Hasher(raw))
+	}
+	sort.Sort(kvSorter{keys, values})
+	st := trie.NewTrie(nil, common.Hasher, nil)
+	root, err := st.Update(keys, values)
+	assert.NoError(t, err)
+
+	f.headers[1].BlocksRootHash = root
+	f.seal(1)
+	blockHash := (&types.Block{Header: f.headers[1]}).BlockHash()
+	f.states[types.ToBlockID(blockHash)] = st
+
+	c := NewClient(f, f.headers[0], f.bps)
+	_, err = c.Sync()
+	assert.NoError(t, err)
+
+	state, err := c.State(blockHash, accounts[1])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), state.Nonce)
+
+	state, err = c.State(blockHash, accounts[2])
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	// tampered state
+	f.accounts[types.ToAccountID(accounts[0])].Nonce = 100
+	_, err = c.State(blockHash, accounts[0])
+	assert.Equal(t, ErrInvalidProof, err)
+}
+
+type kvSorter struct {
+	keys   [][]byte
+	values [][]byte
+}
+
+func (s kvSorter) Len() int           { return len(s.keys) }
+func (s kvSorter) Less(i, j int) bool { return bytes.Compare(s.keys[i], s.keys[j]) < 0 }
+func (s kvSorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
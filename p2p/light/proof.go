@@ -0,0 +1,142 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package light
+
+import (
+	"bytes"
+
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// Receipt fetches the receipt of txHash and verifies it against the receipts
+// root hash of its block, which must be synced already.
+func (c *Client) Receipt(txHash []byte) (*types.Receipt, error) {
+	p, err := c.fetcher.GetReceiptProof(txHash)
+	if err != nil {
+		return nil, err
+	}
+	if p.GetReceipt() == nil || !bytes.Equal(p.Receipt.TxHash, txHash) {
+		return nil, ErrProofMismatch
+	}
+	if err := c.verifyReceiptProof(p); err != nil {
+		return nil, err
+	}
+	r := p.Receipt
+	r.SetMemoryInfo(p.BlockHash, p.BlockNo, int32(p.Index))
+	return r, nil
+}
+
+// MayContainEvent reports whether the block may have an event of contract,
+// named eventName if it is not empty, by the verified bloom filter of the
+// block. False positives are possible, but false negatives are not.
+func (c *Client) MayContainEvent(blockHash []byte, contract []byte, eventName string) (bool, error) {
+	p, err := c.fetcher.GetBloomProof(blockHash)
+	if err != nil {
+		return false, err
+	}
+	if len(p.GetBloom()) == 0 || !bytes.Equal(p.BlockHash, blockHash) {
+		return false, ErrProofMismatch
+	}
+	if err := c.verifyReceiptProof(p); err != nil {
+		return false, err
+	}
+	bf, err := types.NewBloomFromBytes(p.Bloom)
+	if err != nil {
+		return false, err
+	}
+	return bf.Test(contract) && (len(eventName) == 0 || bf.Test([]byte(eventName))), nil
+}
+
+func (c *Client) verifyReceiptProof(p *types.ReceiptProof) error {
+	header := c.HeaderByHash(p.GetBlockHash())
+	if header == nil || header.BlockNo != p.GetBlockNo() {
+		return ErrUnknownBlock
+	}
+	if !p.Verify(header.ReceiptsRootHash) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// State fetches the state of account at the block and verifies it against the
+// blocks root hash of the block, which must be synced already. It returns nil
+// if the account does not exist.
+func (c *Client) State(blockHash []byte, account []byte) (*types.State, error) {
+	state, _, err := c.ContractState(blockHash, account, nil)
+	return state, err
+}
+
+// ContractState fetches the state of contract and its variables of
+// storageKeys at the block, and verifies them against the blocks root hash of
+// the block. The storage keys are trie keys as used by QueryContractState.
+// The value of a variable which does not exist is nil.
+func (c *Client) ContractState(blockHash []byte, contract []byte, storageKeys [][]byte) (*types.State, [][]byte, error) {
+	header := c.HeaderByHash(blockHash)
+	if header == nil {
+		return nil, nil, ErrUnknownBlock
+	}
+	p, err := c.fetcher.GetStateProof(blockHash, contract, storageKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+	ap := p.GetContractProof()
+	if ap == nil || !bytes.Equal(ap.Key, contract) {
+		return nil, nil, ErrProofMismatch
+	}
+
+	id := types.ToAccountID(contract)
+	var stateHash []byte
+	if ap.Inclusion {
+		if ap.State == nil {
+			return nil, nil, ErrProofMismatch
+		}
+		raw, err := proto.Marshal(ap.State)
+		if err != nil {
+			return nil, nil, err
+		}
+		stateHash = common.Hasher(raw)
+	}
+	if !verifyTrieProof(header.BlocksRootHash, id[:], stateHash, ap.Inclusion, ap.ProofKey, ap.ProofVal, ap.AuditPath) {
+		return nil, nil, ErrInvalidProof
+	}
+	if !ap.Inclusion {
+		return nil, make([][]byte, len(storageKeys)), nil
+	}
+
+	// variables are proven against the storage root of the proven state
+	if len(p.GetVarProofs()) != len(storageKeys) {
+		return nil, nil, ErrProofMismatch
+	}
+	values := make([][]byte, len(storageKeys))
+	for i, vp := range p.VarProofs {
+		if !bytes.Equal(vp.Key, storageKeys[i]) {
+			return nil, nil, ErrProofMismatch
+		}
+		var valueHash []byte
+		if vp.Inclusion {
+			valueHash = common.Hasher(vp.Value)
+			values[i] = vp.Value
+		}
+		if !verifyTrieProof(ap.State.StorageRoot, vp.Key, valueHash, vp.Inclusion, vp.ProofKey, vp.ProofVal, vp.AuditPath) {
+			return nil, nil, ErrInvalidProof
+		}
+	}
+	return ap.State, values, nil
+}
+
+// verifyTrieProof verifies an uncompressed proof made by trie.MerkleProofR.
+// If included is false, it is a proof of non inclusion, where proofKey and
+// proofVal are the leaf on the path of key, if any.
+func verifyTrieProof(root []byte, key []byte, valueHash []byte, included bool, proofKey []byte, proofVal []byte, ap [][]byte) bool {
+	t := trie.NewTrie(root, common.Hasher, nil)
+	if included {
+		return t.VerifyInclusion(ap, key, valueHash)
+	}
+	return t.VerifyNonInclusion(ap, key, proofVal, proofKey)
+}
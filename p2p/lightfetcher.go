@@ -0,0 +1,108 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aergoio/aergo/p2p/light"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/types"
+)
+
+var (
+	errLightTimeout = errors.New("light client request timeout")
+)
+
+// LightFetcher sends light client subprotocol requests to a remote peer and
+// waits for their responses. It is the transport of light.Client.
+type LightFetcher struct {
+	peer p2pcommon.RemotePeer
+	ttl  time.Duration
+}
+
+var _ light.Fetcher = (*LightFetcher)(nil)
+
+func NewLightFetcher(peer p2pcommon.RemotePeer, ttl time.Duration) *LightFetcher {
+	return &LightFetcher{peer: peer, ttl: ttl}
+}
+
+func (lf *LightFetcher) GetHeaders(startNo types.BlockNo, size uint32) ([]*types.BlockHeader, error) {
+	body, err := lf.request(p2pcommon.GetLightHeadersRequest, &types.GetLightHeadersRequest{StartNo: startNo, Size: size})
+	if err != nil {
+		return nil, err
+	}
+	resp := body.(*types.GetLightHeadersResponse)
+	switch resp.Status {
+	case types.ResultStatus_OK:
+		return resp.Headers, nil
+	case types.ResultStatus_NOT_FOUND:
+		return nil, nil
+	default:
+		return nil, lightStatusError(resp.Status)
+	}
+}
+
+func (lf *LightFetcher) GetReceiptProof(txHash []byte) (*types.ReceiptProof, error) {
+	return lf.getReceiptProof(&types.GetReceiptProofRequest{TxHash: txHash})
+}
+
+func (lf *LightFetcher) GetBloomProof(blockHash []byte) (*types.ReceiptProof, error) {
+	return lf.getReceiptProof(&types.GetReceiptProofRequest{BlockHash: blockHash})
+}
+
+func (lf *LightFetcher) getReceiptProof(req *types.GetReceiptProofRequest) (*types.ReceiptProof, error) {
+	body, err := lf.request(p2pcommon.GetReceiptProofRequest, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := body.(*types.GetReceiptProofResponse)
+	if resp.Status != types.ResultStatus_OK {
+		return nil, lightStatusError(resp.Status)
+	}
+	return resp.Proof, nil
+}
+
+func (lf *LightFetcher) GetStateProof(blockHash []byte, account []byte, storageKeys [][]byte) (*types.StateQueryProof, error) {
+	req := &types.GetStateProofRequest{BlockHash: blockHash, Account: account, StorageKeys: storageKeys}
+	body, err := lf.request(p2pcommon.GetStateProofRequest, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := body.(*types.GetStateProofResponse)
+	if resp.Status != types.ResultStatus_OK {
+		return nil, lightStatusError(resp.Status)
+	}
+	return resp.Proof, nil
+}
+
+// request sends req and blocks until the response arrives or ttl expires.
+func (lf *LightFetcher) request(protocol p2pcommon.SubProtocol, req p2pcommon.MessageBody) (p2pcommon.MessageBody, error) {
+	respCh := make(chan p2pcommon.MessageBody, 1)
+	receiver := func(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) bool {
+		lf.peer.ConsumeRequest(msg.OriginalID())
+		select {
+		case respCh <- msgBody:
+		default:
+		}
+		return true
+	}
+	mo := lf.peer.MF().NewMsgBlockRequestOrder(receiver, protocol, req)
+	lf.peer.SendMessage(mo)
+
+	select {
+	case body := <-respCh:
+		return body, nil
+	case <-time.After(lf.ttl):
+		lf.peer.ConsumeRequest(mo.GetMsgID())
+		return nil, errLightTimeout
+	}
+}
+
+func lightStatusError(status types.ResultStatus) error {
+	return errors.New("remote peer failed: " + status.String())
+}
@@ -12,6 +12,10 @@ import (
 	"time"
 )
 
+// latencyWeight is the inverse of the weight of a new sample in the moving
+// average of response latency.
+const latencyWeight = 8
+
 type PeerMetric struct {
 	mm     MetricsManager
 	PeerID types.PeerID
@@ -20,6 +24,8 @@ type PeerMetric struct {
 	Since    time.Time
 	totalIn  int64
 	totalOut int64
+	// latency is moving average of response latency in nanoseconds
+	latency int64
 
 	InMetric  DataMetric
 	OutMetric DataMetric
@@ -37,6 +43,27 @@ func (m *PeerMetric) OnWrite(protocol p2pcommon.SubProtocol, write int) {
 	m.OutMetric.AddBytes(write)
 }
 
+// OnResponse records the latency of a request to the remote peer, which is
+// the time from sending the request to receiving the first response of it.
+func (m *PeerMetric) OnResponse(latency time.Duration) {
+	for {
+		old := atomic.LoadInt64(&m.latency)
+		avg := int64(latency)
+		if old != 0 {
+			avg = old + (int64(latency)-old)/latencyWeight
+		}
+		if atomic.CompareAndSwapInt64(&m.latency, old, avg) {
+			return
+		}
+	}
+}
+
+// Latency returns the moving average of response latency. It is zero if no
+// response is received yet.
+func (m *PeerMetric) Latency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&m.latency))
+}
+
 func (m *PeerMetric) TotalIn() int64 {
 	return atomic.LoadInt64(&m.totalIn)
 }
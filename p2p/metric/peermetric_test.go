@@ -48,3 +48,17 @@ func TestPeerMetric_OnRead(t *testing.T) {
 		})
 	}
 }
+
+func TestPeerMetric_OnResponse(t *testing.T) {
+	target := PeerMetric{}
+	assert.Equal(t, time.Duration(0), target.Latency())
+
+	target.OnResponse(time.Millisecond * 800)
+	assert.Equal(t, time.Millisecond*800, target.Latency())
+
+	// a new sample moves the average by 1/latencyWeight of the difference
+	target.OnResponse(0)
+	assert.Equal(t, time.Millisecond*700, target.Latency())
+	target.OnResponse(time.Millisecond * 1500)
+	assert.Equal(t, time.Millisecond*800, target.Latency())
+}
@@ -236,6 +236,8 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		p2ps.GetBlockHashes(context, msg)
 	case *message.GetHashByNo:
 		p2ps.GetBlockHashByNo(context, msg)
+	case *message.GetSyncHeaders:
+		p2ps.GetSyncHeaders(context, msg)
 	case *message.NotifyNewBlock:
 		if msg.Produced {
 			p2ps.NotifyBlockProduced(*msg)
@@ -262,6 +264,12 @@ func (p2ps *P2P) Receive(context actor.Context) {
 		context.Respond(&message.GetPeersRsp{Peers: peers})
 	case *message.GetSyncAncestor:
 		p2ps.GetSyncAncestor(context, msg)
+	case *message.GetSyncStateRange:
+		p2ps.GetSyncStateRange(context, msg)
+	case *message.GetSyncStateData:
+		p2ps.GetSyncStateData(context, msg)
+	case *message.GetSyncContractDB:
+		p2ps.GetSyncContractDB(context, msg)
 	case *message.MapQueryMsg:
 		bestBlock, err := p2ps.GetChainAccessor().GetBestBlock()
 		if err == nil {
@@ -404,6 +412,22 @@ func (p2ps *P2P) insertHandlers(peer p2pcommon.RemotePeer) {
 	peer.AddMessageHandler(p2pcommon.GetHashByNoRequest, subproto.NewGetHashByNoReqHandler(p2ps.pm, peer, logger, p2ps))
 	peer.AddMessageHandler(p2pcommon.GetHashByNoResponse, subproto.NewGetHashByNoRespHandler(p2ps.pm, peer, logger, p2ps))
 
+	// LightClientHandlers
+	peer.AddMessageHandler(p2pcommon.GetLightHeadersRequest, subproto.NewGetLightHeadersReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetLightHeadersResponse, subproto.NewGetLightHeadersRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetReceiptProofRequest, subproto.NewGetReceiptProofReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetReceiptProofResponse, subproto.NewGetReceiptProofRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetStateProofRequest, subproto.NewGetStateProofReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetStateProofResponse, subproto.NewGetStateProofRespHandler(p2ps.pm, peer, logger, p2ps))
+
+	// SnapshotSyncHandlers
+	peer.AddMessageHandler(p2pcommon.GetStateRangeRequest, subproto.NewGetStateRangeReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetStateRangeResponse, subproto.NewGetStateRangeRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetStateDataRequest, subproto.NewGetStateDataReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetStateDataResponse, subproto.NewGetStateDataRespHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetContractDBRequest, subproto.NewGetContractDBReqHandler(p2ps.pm, peer, logger, p2ps))
+	peer.AddMessageHandler(p2pcommon.GetContractDBResponse, subproto.NewGetContractDBRespHandler(p2ps.pm, peer, logger, p2ps))
+
 	// TxHandlers
 	peer.AddMessageHandler(p2pcommon.GetTXsRequest, subproto.WithTimeLog(subproto.NewTxReqHandler(p2ps.pm, peer, logger, p2ps), p2ps.Logger, zerolog.DebugLevel))
 	peer.AddMessageHandler(p2pcommon.GetTXsResponse, subproto.WithTimeLog(subproto.NewTxRespHandler(p2ps.pm, peer, logger, p2ps), p2ps.Logger, zerolog.DebugLevel))
@@ -446,7 +470,8 @@ func (p2ps *P2P) CreateRemotePeer(meta p2pcommon.PeerMeta, seq uint32, status *t
 	newPeer := newRemotePeer(meta, seq, p2ps.pm, p2ps, p2ps.Logger, p2ps.mf, p2ps.signer, rw)
 	newPeer.UpdateBlkCache(status.GetBestBlockHash(), status.GetBestHeight())
 	newPeer.tnt = p2ps.tnt
-	rw.AddIOListener(p2ps.mm.NewMetric(newPeer.ID(), newPeer.ManageNumber()))
+	newPeer.metric = p2ps.mm.NewMetric(newPeer.ID(), newPeer.ManageNumber())
+	rw.AddIOListener(newPeer.metric)
 
 	// TODO tune to set prefer role
 	newPeer.role = p2ps.prm.GetRole(meta.ID)
@@ -25,6 +25,12 @@ const (
 
 	MaxBlockHeaderResponseCount = 10000
 	MaxBlockResponseCount       = 2000
+
+	MaxStateRangeResponseCount = 4096
+	MaxStateDataResponseCount  = 1000
+	// MaxStateResponseSize is the size limit of the keys and data in a
+	// response of snapshot sync, under MaxPayloadLength
+	MaxStateResponseSize = 1 << 22 // 4MB
 )
 
 // P2PVersion is version of p2p wire protocol. This version affects p2p handshake, data format transferred, etc
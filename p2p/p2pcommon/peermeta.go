@@ -20,9 +20,10 @@ type PeerMeta struct {
 	Port       uint32
 	Designated bool // Designated means this peer is designated in config file and connect to in startup phase
 
-	Version  string
-	Hidden   bool // Hidden means that meta info of this peer will not be sent to other peers when getting peer list
-	Outbound bool
+	Version     string
+	Hidden      bool // Hidden means that meta info of this peer will not be sent to other peers when getting peer list
+	Outbound    bool
+	SyncHeaders bool // SyncHeaders means that this peer serves block headers for sync
 }
 
 func (m *PeerMeta) GetVersion() string {
@@ -39,6 +40,7 @@ func NewMetaFromStatus(status *types.Status, outbound bool) PeerMeta {
 	meta.Hidden = status.NoExpose
 	meta.Outbound = outbound
 	meta.Version = status.Version
+	meta.SyncHeaders = status.SyncHeaders
 	return meta
 }
 
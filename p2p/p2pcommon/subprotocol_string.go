@@ -10,7 +10,9 @@ const (
 	_SubProtocol_name_2 = "NewBlockNoticeGetAncestorRequestGetAncestorResponseGetHashesRequestGetHashesResponseGetHashByNoRequestGetHashByNoResponse"
 	_SubProtocol_name_3 = "GetTXsRequestGetTXsResponseNewTxNotice"
 	_SubProtocol_name_4 = "BlockProducedNotice"
-	_SubProtocol_name_5 = "GetClusterRequestGetClusterResponseRaftWrapperMessage"
+	_SubProtocol_name_5 = "GetLightHeadersRequestGetLightHeadersResponseGetReceiptProofRequestGetReceiptProofResponseGetStateProofRequestGetStateProofResponse"
+	_SubProtocol_name_6 = "GetStateRangeRequestGetStateRangeResponseGetStateDataRequestGetStateDataResponseGetContractDBRequestGetContractDBResponse"
+	_SubProtocol_name_7 = "GetClusterRequestGetClusterResponseRaftWrapperMessage"
 )
 
 var (
@@ -18,7 +20,9 @@ var (
 	_SubProtocol_index_1 = [...]uint8{0, 16, 33, 55, 78}
 	_SubProtocol_index_2 = [...]uint8{0, 14, 32, 51, 67, 84, 102, 121}
 	_SubProtocol_index_3 = [...]uint8{0, 13, 27, 38}
-	_SubProtocol_index_5 = [...]uint8{0, 17, 35, 53}
+	_SubProtocol_index_5 = [...]uint8{0, 22, 45, 67, 90, 110, 131}
+	_SubProtocol_index_6 = [...]uint8{0, 20, 41, 60, 80, 100, 121}
+	_SubProtocol_index_7 = [...]uint8{0, 17, 35, 53}
 )
 
 func (i SubProtocol) String() string {
@@ -37,9 +41,15 @@ func (i SubProtocol) String() string {
 		return _SubProtocol_name_3[_SubProtocol_index_3[i]:_SubProtocol_index_3[i+1]]
 	case i == 48:
 		return _SubProtocol_name_4
+	case 64 <= i && i <= 69:
+		i -= 64
+		return _SubProtocol_name_5[_SubProtocol_index_5[i]:_SubProtocol_index_5[i+1]]
+	case 80 <= i && i <= 85:
+		i -= 80
+		return _SubProtocol_name_6[_SubProtocol_index_6[i]:_SubProtocol_index_6[i+1]]
 	case 12545 <= i && i <= 12547:
 		i -= 12545
-		return _SubProtocol_name_5[_SubProtocol_index_5[i]:_SubProtocol_index_5[i+1]]
+		return _SubProtocol_name_7[_SubProtocol_index_7[i]:_SubProtocol_index_7[i+1]]
 	default:
 		return "SubProtocol(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
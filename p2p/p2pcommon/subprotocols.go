@@ -42,6 +42,28 @@ const (
 	BlockProducedNotice SubProtocol = 0x030 + iota
 )
 
+// subprotocols for light clients, which keep only block headers and verify
+// receipts and states with merkle proofs
+const (
+	GetLightHeadersRequest SubProtocol = 0x040 + iota
+	GetLightHeadersResponse
+	GetReceiptProofRequest
+	GetReceiptProofResponse
+	GetStateProofRequest
+	GetStateProofResponse
+)
+
+// subprotocols for snapshot sync, which copies the state tries and the
+// contract databases of a recent block instead of executing all the blocks
+const (
+	GetStateRangeRequest SubProtocol = 0x050 + iota
+	GetStateRangeResponse
+	GetStateDataRequest
+	GetStateDataResponse
+	GetContractDBRequest
+	GetContractDBResponse
+)
+
 const (
 	_ SubProtocol = 0x3100 + iota
 	GetClusterRequest
@@ -455,7 +455,7 @@ func (pm *peerManager) GetPeerAddresses(noHidden bool, showSelf bool) []*message
 			return nil
 		}
 		selfpi := &message.PeerInfo{
-			&addr, meta.Version, meta.Hidden, time.Now(), bestBlk.BlockHash(), bestBlk.Header.BlockNo, types.RUNNING, true}
+			&addr, meta.Version, meta.Hidden, time.Now(), bestBlk.BlockHash(), bestBlk.Header.BlockNo, types.RUNNING, true, 0}
 		peers = append(peers, selfpi)
 	}
 	for _, aPeer := range pm.peerCache {
@@ -465,8 +465,14 @@ func (pm *peerManager) GetPeerAddresses(noHidden bool, showSelf bool) []*message
 		}
 		addr := meta.ToPeerAddress()
 		lastStatus := aPeer.LastStatus()
+		var latency time.Duration
+		if pm.mm != nil {
+			if m, found := pm.mm.Metric(aPeer.ID()); found {
+				latency = m.Latency()
+			}
+		}
 		pi := &message.PeerInfo{
-			&addr, meta.Version, meta.Hidden, lastStatus.CheckTime, lastStatus.BlockHash, lastStatus.BlockNumber, aPeer.State(), false}
+			&addr, meta.Version, meta.Hidden, lastStatus.CheckTime, lastStatus.BlockHash, lastStatus.BlockNumber, aPeer.State(), false, latency}
 		peers = append(peers, pi)
 	}
 	return peers
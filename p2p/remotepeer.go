@@ -26,9 +26,10 @@ var TimeoutError = errors.New("timeout")
 var CancelError = errors.New("canceled")
 
 type requestInfo struct {
-	cTime    time.Time
-	reqMO    p2pcommon.MsgOrder
-	receiver p2pcommon.ResponseReceiver
+	cTime     time.Time
+	reqMO     p2pcommon.MsgOrder
+	receiver  p2pcommon.ResponseReceiver
+	responded bool
 }
 
 type queryMsg struct {
@@ -385,7 +386,14 @@ func (p *remotePeerImpl) GetReceiver(originalID p2pcommon.MsgID) p2pcommon.Respo
 	req, found := p.requests[originalID]
 	if !found {
 		return p.requestIDNotFoundReceiver
-	} else if req.receiver == nil {
+	}
+	if !req.responded {
+		req.responded = true
+		if p.metric != nil {
+			p.metric.OnResponse(time.Since(req.cTime))
+		}
+	}
+	if req.receiver == nil {
 		return p.passThroughReceiver
 	} else {
 		return req.receiver
@@ -0,0 +1,240 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"github.com/Cofresi/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+// handlers of light client subprotocols. Light clients keep only block
+// headers, and verify receipts and states with the merkle proofs served here.
+
+type getLightHeadersRequestHandler struct {
+	BaseMsgHandler
+	asyncHelper
+}
+
+var _ p2pcommon.MessageHandler = (*getLightHeadersRequestHandler)(nil)
+
+type getLightHeadersResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*getLightHeadersResponseHandler)(nil)
+
+type getReceiptProofRequestHandler struct {
+	BaseMsgHandler
+	asyncHelper
+}
+
+var _ p2pcommon.MessageHandler = (*getReceiptProofRequestHandler)(nil)
+
+type getReceiptProofResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*getReceiptProofResponseHandler)(nil)
+
+type getStateProofRequestHandler struct {
+	BaseMsgHandler
+	asyncHelper
+}
+
+var _ p2pcommon.MessageHandler = (*getStateProofRequestHandler)(nil)
+
+type getStateProofResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*getStateProofResponseHandler)(nil)
+
+// NewGetLightHeadersReqHandler creates handler for GetLightHeadersRequest
+func NewGetLightHeadersReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getLightHeadersRequestHandler {
+	bh := &getLightHeadersRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetLightHeadersRequest, pm: pm, peer: peer, actor: actor, logger: logger}, newAsyncHelper()}
+	return bh
+}
+
+func (bh *getLightHeadersRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetLightHeadersRequest{})
+}
+
+func (bh *getLightHeadersRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetLightHeadersRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	if bh.issue() {
+		go bh.handleGetLightHeaders(msg, data)
+	} else {
+		resp := &types.GetLightHeadersResponse{Status: types.ResultStatus_RESOURCE_EXHAUSTED}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetLightHeadersResponse, resp))
+	}
+}
+
+func (bh *getLightHeadersRequestHandler) handleGetLightHeaders(msg p2pcommon.Message, data *types.GetLightHeadersRequest) {
+	defer bh.release()
+	remotePeer := bh.peer
+	chainAccessor := bh.actor.GetChainAccessor()
+
+	// headers are returned in ascending order from StartNo, and the list is
+	// cut at the first block not found in the main chain
+	maxFetchSize := min(p2pcommon.MaxBlockHeaderResponseCount, data.Size)
+	headers := make([]*types.BlockHeader, 0, maxFetchSize)
+	for no := types.BlockNo(data.StartNo); uint32(len(headers)) < maxFetchSize; no++ {
+		hash, err := chainAccessor.GetHashByNo(no)
+		if err != nil {
+			break
+		}
+		foundBlock, err := chainAccessor.GetBlock(hash)
+		if err != nil || foundBlock == nil {
+			break
+		}
+		headers = append(headers, getBlockHeader(foundBlock))
+	}
+
+	status := types.ResultStatus_OK
+	if len(headers) == 0 {
+		status = types.ResultStatus_NOT_FOUND
+	}
+	resp := &types.GetLightHeadersResponse{Status: status, Headers: headers}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetLightHeadersResponse, resp))
+}
+
+// NewGetLightHeadersRespHandler creates handler for GetLightHeadersResponse
+func NewGetLightHeadersRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getLightHeadersResponseHandler {
+	bh := &getLightHeadersResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetLightHeadersResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getLightHeadersResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetLightHeadersResponse{})
+}
+
+func (bh *getLightHeadersResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetLightHeadersResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
+
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
+
+// NewGetReceiptProofReqHandler creates handler for GetReceiptProofRequest
+func NewGetReceiptProofReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getReceiptProofRequestHandler {
+	bh := &getReceiptProofRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetReceiptProofRequest, pm: pm, peer: peer, actor: actor, logger: logger}, newAsyncHelper()}
+	return bh
+}
+
+func (bh *getReceiptProofRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetReceiptProofRequest{})
+}
+
+func (bh *getReceiptProofRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetReceiptProofRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	if bh.issue() {
+		go bh.handleGetReceiptProof(msg, data)
+	} else {
+		resp := &types.GetReceiptProofResponse{Status: types.ResultStatus_RESOURCE_EXHAUSTED}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetReceiptProofResponse, resp))
+	}
+}
+
+func (bh *getReceiptProofRequestHandler) handleGetReceiptProof(msg p2pcommon.Message, data *types.GetReceiptProofRequest) {
+	defer bh.release()
+	remotePeer := bh.peer
+	resp := &types.GetReceiptProofResponse{Status: types.ResultStatus_OK}
+
+	if len(data.TxHash) == 0 && len(data.BlockHash) == 0 {
+		resp.Status = types.ResultStatus_INVALID_ARGUMENT
+	} else if rawResponse, err := bh.actor.CallRequestDefaultTimeout(message.ChainSvc,
+		&message.GetReceiptProof{TxHash: data.TxHash, BlockHash: data.BlockHash}); err != nil {
+		resp.Status = types.ResultStatus_ABORTED
+	} else if result := rawResponse.(message.GetReceiptProofRsp); result.Err != nil {
+		resp.Status = types.ResultStatus_NOT_FOUND
+	} else {
+		resp.Proof = result.Proof
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetReceiptProofResponse, resp))
+}
+
+// NewGetReceiptProofRespHandler creates handler for GetReceiptProofResponse
+func NewGetReceiptProofRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getReceiptProofResponseHandler {
+	bh := &getReceiptProofResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetReceiptProofResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getReceiptProofResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetReceiptProofResponse{})
+}
+
+func (bh *getReceiptProofResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetReceiptProofResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
+
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
+
+// NewGetStateProofReqHandler creates handler for GetStateProofRequest
+func NewGetStateProofReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateProofRequestHandler {
+	bh := &getStateProofRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetStateProofRequest, pm: pm, peer: peer, actor: actor, logger: logger}, newAsyncHelper()}
+	return bh
+}
+
+func (bh *getStateProofRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateProofRequest{})
+}
+
+func (bh *getStateProofRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetStateProofRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	if bh.issue() {
+		go bh.handleGetStateProof(msg, data)
+	} else {
+		resp := &types.GetStateProofResponse{Status: types.ResultStatus_RESOURCE_EXHAUSTED}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateProofResponse, resp))
+	}
+}
+
+func (bh *getStateProofRequestHandler) handleGetStateProof(msg p2pcommon.Message, data *types.GetStateProofRequest) {
+	defer bh.release()
+	remotePeer := bh.peer
+	resp := &types.GetStateProofResponse{Status: types.ResultStatus_OK}
+
+	// the block hash is mandatory, since a proof is useless to a light client
+	// unless it knows the header whose BlocksRootHash it is checked against.
+	if len(data.BlockHash) == 0 || len(data.Account) == 0 {
+		resp.Status = types.ResultStatus_INVALID_ARGUMENT
+	} else if rawResponse, err := bh.actor.CallRequestDefaultTimeout(message.ChainSvc,
+		&message.GetStateQuery{ContractAddress: data.Account, StorageKeys: data.StorageKeys, Compressed: data.Compressed, BlockHash: data.BlockHash}); err != nil {
+		resp.Status = types.ResultStatus_ABORTED
+	} else if result := rawResponse.(message.GetStateQueryRsp); result.Err != nil {
+		resp.Status = types.ResultStatus_NOT_FOUND
+	} else {
+		resp.Proof = result.Result
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateProofResponse, resp))
+}
+
+// NewGetStateProofRespHandler creates handler for GetStateProofResponse
+func NewGetStateProofRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateProofResponseHandler {
+	bh := &getStateProofResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetStateProofResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getStateProofResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateProofResponse{})
+}
+
+func (bh *getStateProofResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetStateProofResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
+
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
@@ -0,0 +1,253 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package subproto
+
+import (
+	"github.com/Cofresi/aergo-lib/log"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+	"github.com/aergoio/aergo/p2p/p2putil"
+	"github.com/aergoio/aergo/types"
+)
+
+// handlers of snapshot sync subprotocols. A syncing node copies the state
+// tries of a recent block by ranges of leaves, and the contract codes and sql
+// databases the states refer to. Nothing served here is trusted by the
+// receiver until the rebuilt tries match the state root of the block.
+
+type getStateRangeRequestHandler struct {
+	BaseMsgHandler
+	asyncHelper
+}
+
+var _ p2pcommon.MessageHandler = (*getStateRangeRequestHandler)(nil)
+
+type getStateRangeResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*getStateRangeResponseHandler)(nil)
+
+type getStateDataRequestHandler struct {
+	BaseMsgHandler
+	asyncHelper
+}
+
+var _ p2pcommon.MessageHandler = (*getStateDataRequestHandler)(nil)
+
+type getStateDataResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*getStateDataResponseHandler)(nil)
+
+type getContractDBRequestHandler struct {
+	BaseMsgHandler
+	asyncHelper
+}
+
+var _ p2pcommon.MessageHandler = (*getContractDBRequestHandler)(nil)
+
+type getContractDBResponseHandler struct {
+	BaseMsgHandler
+}
+
+var _ p2pcommon.MessageHandler = (*getContractDBResponseHandler)(nil)
+
+// NewGetStateRangeReqHandler creates handler for GetStateRangeRequest
+func NewGetStateRangeReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateRangeRequestHandler {
+	bh := &getStateRangeRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetStateRangeRequest, pm: pm, peer: peer, actor: actor, logger: logger}, newAsyncHelper()}
+	return bh
+}
+
+func (bh *getStateRangeRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateRangeRequest{})
+}
+
+func (bh *getStateRangeRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetStateRangeRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	if bh.issue() {
+		go bh.handleGetStateRange(msg, data)
+	} else {
+		resp := &types.GetStateRangeResponse{Status: types.ResultStatus_RESOURCE_EXHAUSTED}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateRangeResponse, resp))
+	}
+}
+
+func (bh *getStateRangeRequestHandler) handleGetStateRange(msg p2pcommon.Message, data *types.GetStateRangeRequest) {
+	defer bh.release()
+	remotePeer := bh.peer
+	resp := &types.GetStateRangeResponse{Status: types.ResultStatus_OK}
+
+	if len(data.Root) == 0 || data.Limit == 0 {
+		resp.Status = types.ResultStatus_INVALID_ARGUMENT
+	} else if rawResponse, err := bh.actor.CallRequestDefaultTimeout(message.ChainSvc,
+		&message.GetStateRange{Root: data.Root, Start: data.Start, Limit: min(p2pcommon.MaxStateRangeResponseCount, data.Limit)}); err != nil {
+		resp.Status = types.ResultStatus_ABORTED
+	} else if result := rawResponse.(message.GetStateRangeRsp); result.Err != nil {
+		// the state of root is unknown or pruned
+		resp.Status = types.ResultStatus_NOT_FOUND
+	} else {
+		// cut the range at the size limit. the receiver continues from the
+		// last key it got.
+		size, cnt := 0, 0
+		for cnt < len(result.Keys) {
+			size += len(result.Keys[cnt]) + len(result.Values[cnt]) + len(result.Data[cnt])
+			if cnt > 0 && size > p2pcommon.MaxStateResponseSize {
+				break
+			}
+			cnt++
+		}
+		resp.Keys, resp.Values, resp.Data = result.Keys[:cnt], result.Values[:cnt], result.Data[:cnt]
+		resp.HasNext = result.HasNext || cnt < len(result.Keys)
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateRangeResponse, resp))
+}
+
+// NewGetStateRangeRespHandler creates handler for GetStateRangeResponse
+func NewGetStateRangeRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateRangeResponseHandler {
+	bh := &getStateRangeResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetStateRangeResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getStateRangeResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateRangeResponse{})
+}
+
+func (bh *getStateRangeResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetStateRangeResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
+
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
+
+// NewGetStateDataReqHandler creates handler for GetStateDataRequest
+func NewGetStateDataReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateDataRequestHandler {
+	bh := &getStateDataRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetStateDataRequest, pm: pm, peer: peer, actor: actor, logger: logger}, newAsyncHelper()}
+	return bh
+}
+
+func (bh *getStateDataRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateDataRequest{})
+}
+
+func (bh *getStateDataRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetStateDataRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	if bh.issue() {
+		go bh.handleGetStateData(msg, data)
+	} else {
+		resp := &types.GetStateDataResponse{Status: types.ResultStatus_RESOURCE_EXHAUSTED}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateDataResponse, resp))
+	}
+}
+
+func (bh *getStateDataRequestHandler) handleGetStateData(msg p2pcommon.Message, data *types.GetStateDataRequest) {
+	defer bh.release()
+	remotePeer := bh.peer
+	resp := &types.GetStateDataResponse{Status: types.ResultStatus_OK}
+
+	hashes := data.Hashes
+	if len(hashes) > p2pcommon.MaxStateDataResponseCount {
+		hashes = hashes[:p2pcommon.MaxStateDataResponseCount]
+	}
+	if len(hashes) == 0 {
+		resp.Status = types.ResultStatus_INVALID_ARGUMENT
+	} else if rawResponse, err := bh.actor.CallRequestDefaultTimeout(message.ChainSvc,
+		&message.GetStateData{Hashes: hashes}); err != nil {
+		resp.Status = types.ResultStatus_ABORTED
+	} else {
+		// the data are returned in the order of the hashes, and cut at the
+		// size limit. the receiver requests the rest again.
+		result := rawResponse.(message.GetStateDataRsp)
+		size := 0
+		for i, d := range result.Data {
+			size += len(d)
+			if i > 0 && size > p2pcommon.MaxStateResponseSize {
+				break
+			}
+			resp.Data = append(resp.Data, d)
+		}
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetStateDataResponse, resp))
+}
+
+// NewGetStateDataRespHandler creates handler for GetStateDataResponse
+func NewGetStateDataRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getStateDataResponseHandler {
+	bh := &getStateDataResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetStateDataResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getStateDataResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetStateDataResponse{})
+}
+
+func (bh *getStateDataResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetStateDataResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
+
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
+
+// NewGetContractDBReqHandler creates handler for GetContractDBRequest
+func NewGetContractDBReqHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getContractDBRequestHandler {
+	bh := &getContractDBRequestHandler{BaseMsgHandler{protocol: p2pcommon.GetContractDBRequest, pm: pm, peer: peer, actor: actor, logger: logger}, newAsyncHelper()}
+	return bh
+}
+
+func (bh *getContractDBRequestHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetContractDBRequest{})
+}
+
+func (bh *getContractDBRequestHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	remotePeer := bh.peer
+	data := msgBody.(*types.GetContractDBRequest)
+	p2putil.DebugLogReceive(bh.logger, bh.protocol, msg.ID().String(), remotePeer, data)
+	if bh.issue() {
+		go bh.handleGetContractDB(msg, data)
+	} else {
+		resp := &types.GetContractDBResponse{Status: types.ResultStatus_RESOURCE_EXHAUSTED}
+		remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetContractDBResponse, resp))
+	}
+}
+
+func (bh *getContractDBRequestHandler) handleGetContractDB(msg p2pcommon.Message, data *types.GetContractDBRequest) {
+	defer bh.release()
+	remotePeer := bh.peer
+	resp := &types.GetContractDBResponse{Status: types.ResultStatus_OK}
+
+	if len(data.AccountID) != types.HashIDLength || data.Size == 0 {
+		resp.Status = types.ResultStatus_INVALID_ARGUMENT
+	} else if rawResponse, err := bh.actor.CallRequestDefaultTimeout(message.ChainSvc,
+		&message.GetContractDB{AccountID: data.AccountID, RecoveryPoint: data.RecoveryPoint, Offset: data.Offset, Size: min(p2pcommon.MaxStateResponseSize, data.Size)}); err != nil {
+		resp.Status = types.ResultStatus_ABORTED
+	} else if result := rawResponse.(message.GetContractDBRsp); result.Err != nil {
+		resp.Status = types.ResultStatus_NOT_FOUND
+	} else {
+		resp.Data, resp.Total = result.Data, result.Total
+	}
+	remotePeer.SendMessage(remotePeer.MF().NewMsgResponseOrder(msg.ID(), p2pcommon.GetContractDBResponse, resp))
+}
+
+// NewGetContractDBRespHandler creates handler for GetContractDBResponse
+func NewGetContractDBRespHandler(pm p2pcommon.PeerManager, peer p2pcommon.RemotePeer, logger *log.Logger, actor p2pcommon.ActorService) *getContractDBResponseHandler {
+	bh := &getContractDBResponseHandler{BaseMsgHandler{protocol: p2pcommon.GetContractDBResponse, pm: pm, peer: peer, actor: actor, logger: logger}}
+	return bh
+}
+
+func (bh *getContractDBResponseHandler) ParsePayload(rawbytes []byte) (p2pcommon.MessageBody, error) {
+	return p2putil.UnmarshalAndReturn(rawbytes, &types.GetContractDBResponse{})
+}
+
+func (bh *getContractDBResponseHandler) Handle(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) {
+	data := msgBody.(*types.GetContractDBResponse)
+	p2putil.DebugLogReceiveResponse(bh.logger, bh.protocol, msg.ID().String(), msg.OriginalID().String(), bh.peer, data)
+
+	bh.peer.GetReceiver(msg.OriginalID())(msg, data)
+}
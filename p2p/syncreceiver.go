@@ -0,0 +1,52 @@
+/*
+ * @file
+ * @copyright defined in aergo/LICENSE.txt
+ */
+
+package p2p
+
+import (
+	"time"
+
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/p2p/p2pcommon"
+)
+
+// SyncReceiver sends a sync request to target peer and tells the
+// response to the syncer, after converting it to an actor message by toRsp.
+// It will not send response actor message if timeout expired.
+type SyncReceiver struct {
+	requestID p2pcommon.MsgID
+
+	peer  p2pcommon.RemotePeer
+	actor p2pcommon.ActorService
+
+	protocol p2pcommon.SubProtocol
+	req      p2pcommon.MessageBody
+	toRsp    func(body p2pcommon.MessageBody) interface{}
+	timeout  time.Time
+	finished bool
+}
+
+func NewSyncReceiver(actor p2pcommon.ActorService, peer p2pcommon.RemotePeer, protocol p2pcommon.SubProtocol, req p2pcommon.MessageBody, toRsp func(body p2pcommon.MessageBody) interface{}, ttl time.Duration) *SyncReceiver {
+	timeout := time.Now().Add(ttl)
+	return &SyncReceiver{actor: actor, peer: peer, protocol: protocol, req: req, toRsp: toRsp, timeout: timeout}
+}
+
+func (sr *SyncReceiver) StartGet() {
+	mo := sr.peer.MF().NewMsgBlockRequestOrder(sr.ReceiveResp, sr.protocol, sr.req)
+	sr.requestID = mo.GetMsgID()
+	sr.peer.SendMessage(mo)
+}
+
+// ReceiveResp must be called just in read go routine
+func (sr *SyncReceiver) ReceiveResp(msg p2pcommon.Message, msgBody p2pcommon.MessageBody) (ret bool) {
+	ret = true
+	// silently ignore already finished or timed out job
+	if !sr.finished && !sr.timeout.Before(time.Now()) {
+		sr.actor.TellRequest(message.SyncerSvc, sr.toRsp(msgBody))
+	}
+	sr.finished = true
+	sr.peer.ConsumeRequest(sr.requestID)
+	return
+}
@@ -220,6 +220,7 @@ func createStatus(pm p2pcommon.PeerManager, actor p2pcommon.ActorService, chainI
 		NoExpose:      pm.SelfMeta().Hidden,
 		Version:       p2pkey.NodeVersion(),
 		Genesis:       genesis,
+		SyncHeaders:   true,
 	}
 
 	return statusMsg, nil
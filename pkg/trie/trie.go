@@ -45,6 +45,9 @@ type Trie struct {
 	pastTries [][]byte
 	// atomicUpdate, commit all the changes made by intermediate update calls
 	atomicUpdate bool
+	// recordStale is set to record the nodes replaced by updates, which are
+	// pruned later
+	recordStale bool
 }
 
 // NewSMT creates a new SMT given a keySize and a hash function.
@@ -266,13 +269,18 @@ func (s *Trie) updateParallel(lnode, rnode, root []byte, lkeys, rkeys, lvalues,
 func (s *Trie) deleteOldNode(root []byte, height int, movingUp bool) {
 	var node Hash
 	copy(node[:], root)
-	if !s.atomicUpdate || movingUp {
+	s.db.updatedMux.Lock()
+	if _, updated := s.db.updatedNodes[node]; !updated {
+		// the node is in db, record it so that it can be pruned
+		if len(root) != 0 && s.recordStale {
+			s.db.staleNodes = append(s.db.staleNodes, node)
+		}
+	} else if !s.atomicUpdate || movingUp {
 		// dont delete old nodes with atomic updated except when
 		// moving up a shortcut, we dont record every single move
-		s.db.updatedMux.Lock()
 		delete(s.db.updatedNodes, node)
-		s.db.updatedMux.Unlock()
 	}
+	s.db.updatedMux.Unlock()
 	if height >= s.CacheHeightLimit {
 		s.db.liveMux.Lock()
 		delete(s.db.liveCache, node)
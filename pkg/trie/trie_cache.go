@@ -26,6 +26,9 @@ type CacheDB struct {
 	updatedNodes map[Hash][][]byte
 	// updatedMux is a lock for updatedNodes
 	updatedMux sync.RWMutex
+	// staleNodes are the nodes in db replaced by updates since the last
+	// TakeStaleNodes, they are protected by updatedMux
+	staleNodes []Hash
 	// nodesToRevert will be deleted from db
 	nodesToRevert [][]byte
 	// revertMux is a lock for updatedNodes
@@ -372,6 +372,65 @@ func TestTrieCommit(t *testing.T) {
 	os.RemoveAll(".aergo")
 }
 
+func TestTrieWalk(t *testing.T) {
+	smt := NewTrie(nil, common.Hasher, nil)
+	keys := getFreshData(300, 32)
+	values := getFreshData(300, 32)
+	root, _ := smt.Update(keys, values)
+
+	// walk the whole trie by ranges and copy it to another trie
+	cp := NewTrie(nil, common.Hasher, nil)
+	var walked [][]byte
+	var start []byte
+	for {
+		k, v, more, err := smt.Walk(root, start, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		walked = append(walked, k...)
+		if _, err := cp.Update(k, v); err != nil {
+			t.Fatal(err)
+		}
+		if !more {
+			break
+		}
+		start = nextWalkKey(k[len(k)-1])
+	}
+	if len(walked) != len(keys) {
+		t.Fatalf("walked %d keys, expected %d", len(walked), len(keys))
+	}
+	for i, key := range keys {
+		if !bytes.Equal(key, walked[i]) {
+			t.Fatal("walked keys are not in order")
+		}
+	}
+	if !bytes.Equal(root, cp.Root) {
+		t.Fatal("copied trie has a different root")
+	}
+
+	// start from a key which is not in the trie
+	start = nextWalkKey(keys[100])
+	k, _, more, _ := smt.Walk(root, start, 10)
+	if !more || len(k) != 10 || !bytes.Equal(k[0], keys[101]) {
+		t.Fatal("failed to walk from the middle of the trie")
+	}
+	k, _, more, _ = smt.Walk(root, keys[299], 10)
+	if more || len(k) != 1 {
+		t.Fatal("failed to walk the last key")
+	}
+}
+
+func nextWalkKey(key []byte) []byte {
+	next := append([]byte{}, key...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
 func TestTrieStageUpdates(t *testing.T) {
 	dbPath := path.Join(".aergo", "db")
 	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
@@ -398,6 +457,98 @@ func TestTrieStageUpdates(t *testing.T) {
 	os.RemoveAll(".aergo")
 }
 
+func TestTrieStaleNodes(t *testing.T) {
+	dbPath := path.Join(".aergo", "db")
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		_ = os.MkdirAll(dbPath, 0711)
+	}
+	st := db.NewDB(db.LevelImpl, dbPath)
+
+	nodesOf := func(smt *Trie, root []byte) map[Hash]bool {
+		nodes := make(map[Hash]bool)
+		err := smt.WalkNodes(root, func(node []byte) bool {
+			var h Hash
+			copy(h[:], node)
+			nodes[h] = true
+			return true
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return nodes
+	}
+
+	smt := NewTrie(nil, common.Hasher, st)
+	smt.RecordStaleNodes()
+	keys := getFreshData(100, 32)
+	values := getFreshData(100, 32)
+	root, _ := smt.Update(keys, values)
+	smt.Commit()
+	if len(smt.TakeStaleNodes()) != 0 {
+		t.Fatal("no node in db is replaced by the first commit")
+	}
+
+	// update some keys, delete some and add new ones
+	newValues := getFreshData(10, 32)
+	smt.Update(keys[:10], newValues)
+	deleted := make([][]byte, 10)
+	for i := range deleted {
+		deleted[i] = DefaultLeaf
+	}
+	smt.Update(keys[10:20], deleted)
+	newKeys := getFreshData(10, 32)
+	newRoot, _ := smt.Update(newKeys, getFreshData(10, 32))
+	smt.Commit()
+	stale := smt.TakeStaleNodes()
+
+	oldNodes := nodesOf(smt, root)
+	newNodes := nodesOf(smt, newRoot)
+	staleNodes := make(map[Hash]bool)
+	for _, node := range stale {
+		var h Hash
+		copy(h[:], node)
+		if newNodes[h] {
+			t.Fatal("stale node must not be in the new trie")
+		}
+		staleNodes[h] = true
+	}
+	for h := range oldNodes {
+		if !newNodes[h] && !staleNodes[h] {
+			t.Fatal("node replaced by update is not recorded", h)
+		}
+	}
+
+	// the new trie is complete without the stale nodes
+	for _, node := range stale {
+		st.Delete(node)
+	}
+	smt = NewTrie(newRoot, common.Hasher, st)
+	for i, key := range keys[:10] {
+		value, err := smt.Get(key)
+		if err != nil || !bytes.Equal(value, newValues[i]) {
+			t.Fatal("failed to get value after stale nodes are deleted")
+		}
+	}
+	for _, key := range keys[10:] {
+		value, err := smt.Get(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if deleted := bytes.Compare(key, keys[20]) < 0; deleted != (len(value) == 0) {
+			t.Fatal("failed to get value after stale nodes are deleted")
+		}
+	}
+
+	// the replaced nodes are not recorded unless pruning is enabled
+	smt.Update(keys[:10], values[:10])
+	smt.Commit()
+	if len(smt.TakeStaleNodes()) != 0 {
+		t.Fatal("stale nodes are recorded without pruning")
+	}
+	st.Close()
+	os.RemoveAll(".aergo")
+}
+
 func TestTrieRevert(t *testing.T) {
 	dbPath := path.Join(".aergo", "db")
 	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
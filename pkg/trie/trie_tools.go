@@ -144,6 +144,102 @@ func (s *Trie) getValue(root, key []byte, batch [][]byte, iBatch, height int) ([
 	return s.getValue(lnode, key, batch, 2*iBatch+1, height-1)
 }
 
+// Walk returns up to limit keys and values of the trie of root, in ascending
+// order of keys from start. more reports whether the trie has keys after the
+// returned ones. Walking the whole trie a range at a time is used to copy a
+// trie to another node.
+func (s *Trie) Walk(root, start []byte, limit int) (keys, values [][]byte, more bool, err error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	w := &walker{start: start, limit: limit}
+	err = s.walk(w, root, nil, 0, s.TrieHeight, len(start) != 0)
+	if err != nil && err != errWalkDone {
+		return nil, nil, false, err
+	}
+	return w.keys, w.values, err == errWalkDone, nil
+}
+
+var errWalkDone = fmt.Errorf("walk limit reached")
+
+type walker struct {
+	start  []byte
+	limit  int
+	keys   [][]byte
+	values [][]byte
+}
+
+// walk visits the leaves under root in key order. onPath is true while the
+// node is on the path of w.start, where the subtrees left of it are skipped.
+func (s *Trie) walk(w *walker, root []byte, batch [][]byte, iBatch, height int, onPath bool) error {
+	if len(root) == 0 {
+		return nil
+	}
+	batch, iBatch, lnode, rnode, isShortcut, err := s.loadChildren(root, height, iBatch, batch)
+	if err != nil {
+		return err
+	}
+	if isShortcut {
+		key := lnode[:HashLength]
+		if onPath && bytes.Compare(key, w.start) < 0 {
+			return nil
+		}
+		if len(w.keys) == w.limit {
+			return errWalkDone
+		}
+		w.keys = append(w.keys, key)
+		w.values = append(w.values, rnode[:HashLength])
+		return nil
+	}
+	if height == 0 {
+		return nil
+	}
+	if !onPath || !bitIsSet(w.start, s.TrieHeight-height) {
+		if err := s.walk(w, lnode, batch, 2*iBatch+1, height-1, onPath); err != nil {
+			return err
+		}
+		onPath = false
+	}
+	return s.walk(w, rnode, batch, 2*iBatch+2, height-1, onPath)
+}
+
+// WalkNodes visits the nodes of the trie of root which are stored in db, from
+// the root to the leaves. onNode is called with the db key of each node, and
+// the subtree of the node is skipped when it returns false, so the subtrees
+// shared by several tries can be visited only once. onLeaf, if not nil, is
+// called with the key and value of each leaf.
+func (s *Trie) WalkNodes(root []byte, onNode func(node []byte) bool, onLeaf func(key, value []byte) error) error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.walkNodes(root, nil, 0, s.TrieHeight, onNode, onLeaf)
+}
+
+func (s *Trie) walkNodes(root []byte, batch [][]byte, iBatch, height int, onNode func(node []byte) bool, onLeaf func(key, value []byte) error) error {
+	if len(root) == 0 {
+		return nil
+	}
+	// only the roots of batches are stored in db
+	if height%4 == 0 && !onNode(root[:HashLength]) {
+		return nil
+	}
+	batch, iBatch, lnode, rnode, isShortcut, err := s.loadChildren(root, height, iBatch, batch)
+	if err != nil {
+		return err
+	}
+	if isShortcut {
+		if onLeaf != nil {
+			return onLeaf(lnode[:HashLength], rnode[:HashLength])
+		}
+		return nil
+	}
+	if height == 0 {
+		return nil
+	}
+	if err := s.walkNodes(lnode, batch, 2*iBatch+1, height-1, onNode, onLeaf); err != nil {
+		return err
+	}
+	return s.walkNodes(rnode, batch, 2*iBatch+2, height-1, onNode, onLeaf)
+}
+
 // TrieRootExists returns true if the root exists in Database.
 func (s *Trie) TrieRootExists(root []byte) bool {
 	s.db.lock.RLock()
@@ -188,6 +284,30 @@ func (s *Trie) StageUpdates(txn DbTx) {
 	s.prevRoot = s.Root
 }
 
+// RecordStaleNodes makes the following updates record the nodes they replace,
+// which are returned by TakeStaleNodes. It is called when pruning is enabled.
+func (s *Trie) RecordStaleNodes() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.recordStale = true
+}
+
+// TakeStaleNodes returns the keys of the nodes in db which were replaced by
+// the updates since the last call, and forgets them. It is called after the
+// updates are committed. The nodes may be deleted from db once no root in use
+// refers to them anymore. Some of them may be intermediate nodes of the
+// updates which were never written to db.
+func (s *Trie) TakeStaleNodes() [][]byte {
+	s.db.updatedMux.Lock()
+	defer s.db.updatedMux.Unlock()
+	nodes := make([][]byte, len(s.db.staleNodes))
+	for i := range s.db.staleNodes {
+		nodes[i] = s.db.staleNodes[i][:]
+	}
+	s.db.staleNodes = nil
+	return nodes
+}
+
 // Stash rolls back the changes made by previous updates
 // and loads the cache from before the rollback.
 func (s *Trie) Stash(rollbackCache bool) error {
@@ -208,6 +328,7 @@ func (s *Trie) Stash(rollbackCache bool) error {
 		s.db.liveCache = make(map[Hash][][]byte)
 	}
 	s.db.updatedNodes = make(map[Hash][][]byte)
+	s.db.staleNodes = nil
 	// also stash past tries created by Atomic update
 	for i := len(s.pastTries) - 1; i >= 0; i-- {
 		if bytes.Equal(s.pastTries[i], s.Root) {
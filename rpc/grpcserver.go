@@ -43,6 +43,11 @@ var (
 	ErrNotSupportedConsensus = errors.New("not supported by this consensus")
 )
 
+type PendingTxStream struct {
+	filter *types.PendingTxFilter
+	stream types.AergoRPCService_ListPendingTxStreamServer
+}
+
 type EventStream struct {
 	filter *types.FilterInfo
 	stream types.AergoRPCService_ListEventStreamServer
@@ -64,6 +69,9 @@ type AergoRPCService struct {
 	eventStreamLock sync.RWMutex
 	eventStream     map[*EventStream]*EventStream
 
+	pendingTxStreamLock sync.RWMutex
+	pendingTxStream     map[*PendingTxStream]*PendingTxStream
+
 	clientAuthLock sync.RWMutex
 	clientAuthOn   bool
 	clientAuth     map[string]Authentication
@@ -98,6 +106,8 @@ func (rpc *AergoRPCService) Metric(ctx context.Context, req *types.MetricsReques
 		switch mt {
 		case types.MetricType_P2P_NETWORK:
 			rpc.fillPeerMetrics(result)
+		case types.MetricType_MEMPOOL:
+			rpc.fillMempoolMetrics(result)
 		default:
 			// TODO log itB
 		}
@@ -124,6 +134,15 @@ func (rpc *AergoRPCService) fillPeerMetrics(result *types.Metrics) {
 	result.Peers = mets
 }
 
+func (rpc *AergoRPCService) fillMempoolMetrics(result *types.Metrics) {
+	mresult, err := rpc.actorHelper.CallRequestDefaultTimeout(message.MemPoolSvc,
+		&message.MemPoolGetMetric{})
+	if err != nil {
+		return
+	}
+	result.Mempool = mresult.(*message.MemPoolGetMetricRsp).Metric
+}
+
 // Blockchain handle rpc request blockchain. It has no additional input parameter
 func (rpc *AergoRPCService) Blockchain(ctx context.Context, in *types.Empty) (*types.BlockchainStatus, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
@@ -188,8 +207,10 @@ func (rpc *AergoRPCService) getChainInfo(ctx context.Context) (*types.ChainInfo,
 	chainInfo.Maxblocksize = uint64(chain.MaxBlockSize())
 
 	if consensus.IsDposName(chainInfo.Id.Consensus) {
-		if minStaking := types.GetStakingMinimum(); minStaking != nil {
+		if minStaking, err := rpc.actorHelper.GetChainAccessor().GetSystemValue(types.StakingMin); minStaking != nil {
 			chainInfo.Stakingminimum = minStaking.Bytes()
+		} else {
+			return nil, err
 		}
 
 		if total, err := rpc.actorHelper.GetChainAccessor().GetSystemValue(types.StakingTotal); total != nil {
@@ -511,6 +532,40 @@ func (rpc *AergoRPCService) GetTX(ctx context.Context, in *types.SingleBytes) (*
 	return nil, status.Errorf(codes.NotFound, "not found")
 }
 
+// GetMempoolContent handle rpc request getmempoolcontent
+func (rpc *AergoRPCService) GetMempoolContent(ctx context.Context, in *types.AccountAddress) (*types.MempoolAccount, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.actorHelper.CallRequestDefaultTimeout(message.MemPoolSvc,
+		&message.MemPoolGetContent{Account: in.Value})
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.MemPoolGetContentRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Content, rsp.Err
+}
+
+// GetMempoolSummary handle rpc request getmempoolsummary
+func (rpc *AergoRPCService) GetMempoolSummary(ctx context.Context, in *types.Empty) (*types.MempoolSummary, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.actorHelper.CallRequestDefaultTimeout(message.MemPoolSvc,
+		&message.MemPoolGetSummary{})
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(*message.MemPoolGetSummaryRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.Summary, nil
+}
+
 // GetBlockTX handle rpc request gettx
 func (rpc *AergoRPCService) GetBlockTX(ctx context.Context, in *types.SingleBytes) (*types.TxInBlock, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
@@ -665,13 +720,30 @@ func (rpc *AergoRPCService) GetState(ctx context.Context, in *types.SingleBytes)
 	return rsp.State, rsp.Err
 }
 
+// GetStateAt handle rpc request getstate at a specific block
+func (rpc *AergoRPCService) GetStateAt(ctx context.Context, in *types.AccountAndRoot) (*types.State, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetState{Account: in.Account, BlockNo: in.BlockNo, HasBlockNo: in.HasBlockNo, BlockHash: in.BlockHash}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateAt").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetStateRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	return rsp.State, rsp.Err
+}
+
 // GetStateAndProof handle rpc request getstateproof
 func (rpc *AergoRPCService) GetStateAndProof(ctx context.Context, in *types.AccountAndRoot) (*types.AccountProof, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
 		return nil, err
 	}
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetStateAndProof{Account: in.Account, Root: in.Root, Compressed: in.Compressed}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateAndProof").Result()
+		&message.GetStateAndProof{Account: in.Account, Root: in.Root, Compressed: in.Compressed, BlockNo: in.BlockNo, HasBlockNo: in.HasBlockNo, BlockHash: in.BlockHash}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateAndProof").Result()
 	if err != nil {
 		return nil, err
 	}
@@ -878,6 +950,26 @@ func (rpc *AergoRPCService) VerifyTX(ctx context.Context, in *types.Tx) (*types.
 	return ret, nil
 }
 
+// SimulateTX handle rpc request simulatetx
+func (rpc *AergoRPCService) SimulateTX(ctx context.Context, in *types.Tx) (*types.SimulateResult, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.SimulateTx{Tx: in}, defaultActorTimeout, "rpc.(*AergoRPCService).SimulateTX").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.SimulateTxRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, rsp.Err.Error())
+	}
+	return rsp.Result, nil
+}
+
 // GetPeers handle rpc request getpeers
 func (rpc *AergoRPCService) GetPeers(ctx context.Context, in *types.PeersParams) (*types.PeerList, error) {
 	if err := rpc.checkAuth(ctx, ShowNode); err != nil {
@@ -993,7 +1085,7 @@ func (rpc *AergoRPCService) GetNameInfo(ctx context.Context, in *types.Name) (*t
 		return nil, err
 	}
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetNameInfo{Name: in.Name, BlockNo: in.BlockNo}, defaultActorTimeout, "rpc.(*AergoRPCService).GetName").Result()
+		&message.GetNameInfo{Name: in.Name, BlockNo: in.BlockNo, Reverse: in.Reverse}, defaultActorTimeout, "rpc.(*AergoRPCService).GetName").Result()
 	if err != nil {
 		return nil, err
 	}
@@ -1023,6 +1115,30 @@ func (rpc *AergoRPCService) GetReceipt(ctx context.Context, in *types.SingleByte
 	return rsp.Receipt, rsp.Err
 }
 
+// ListAccountTxs handle rpc request listaccounttxs
+func (rpc *AergoRPCService) ListAccountTxs(ctx context.Context, in *types.AccountTxsParams) (*types.AccountTxList, error) {
+	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
+		return nil, err
+	}
+	if len(in.Account) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "account is required")
+	}
+	result, err := rpc.hub.RequestFuture(message.ChainSvc,
+		&message.GetAccountTxs{Account: in.Account, Offset: in.Offset, Size: in.Size, Asc: in.Asc},
+		defaultActorTimeout, "rpc.(*AergoRPCService).ListAccountTxs").Result()
+	if err != nil {
+		return nil, err
+	}
+	rsp, ok := result.(message.GetAccountTxsRsp)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "internal type (%v) error", reflect.TypeOf(result))
+	}
+	if rsp.Err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, rsp.Err.Error())
+	}
+	return &types.AccountTxList{Account: in.Account, Txs: rsp.Txs}, nil
+}
+
 func (rpc *AergoRPCService) GetABI(ctx context.Context, in *types.SingleBytes) (*types.ABI, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
 		return nil, err
@@ -1044,7 +1160,7 @@ func (rpc *AergoRPCService) QueryContract(ctx context.Context, in *types.Query)
 		return nil, err
 	}
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetQuery{Contract: in.ContractAddress, Queryinfo: in.Queryinfo}, defaultActorTimeout, "rpc.(*AergoRPCService).QueryContract").Result()
+		&message.GetQuery{Contract: in.ContractAddress, Queryinfo: in.Queryinfo, BlockNo: in.BlockNo, HasBlockNo: in.HasBlockNo, BlockHash: in.BlockHash}, defaultActorTimeout, "rpc.(*AergoRPCService).QueryContract").Result()
 	if err != nil {
 		return nil, err
 	}
@@ -1061,7 +1177,7 @@ func (rpc *AergoRPCService) QueryContractState(ctx context.Context, in *types.St
 		return nil, err
 	}
 	result, err := rpc.hub.RequestFuture(message.ChainSvc,
-		&message.GetStateQuery{ContractAddress: in.ContractAddress, StorageKeys: in.StorageKeys, Root: in.Root, Compressed: in.Compressed}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateQuery").Result()
+		&message.GetStateQuery{ContractAddress: in.ContractAddress, StorageKeys: in.StorageKeys, Root: in.Root, Compressed: in.Compressed, BlockNo: in.BlockNo, HasBlockNo: in.HasBlockNo, BlockHash: in.BlockHash}, defaultActorTimeout, "rpc.(*AergoRPCService).GetStateQuery").Result()
 	if err != nil {
 		return nil, err
 	}
@@ -1130,6 +1246,40 @@ func (rpc *AergoRPCService) BroadcastToEventStream(events []*types.Event) error
 	return nil
 }
 
+// ListPendingTxStream starts a stream of txs accepted by mempool
+func (rpc *AergoRPCService) ListPendingTxStream(in *types.PendingTxFilter, stream types.AergoRPCService_ListPendingTxStreamServer) error {
+	if err := rpc.checkAuth(stream.Context(), ReadBlockChain); err != nil {
+		return err
+	}
+	txStream := &PendingTxStream{in, stream}
+	rpc.pendingTxStreamLock.Lock()
+	rpc.pendingTxStream[txStream] = txStream
+	rpc.pendingTxStreamLock.Unlock()
+
+	for {
+		select {
+		case <-txStream.stream.Context().Done():
+			rpc.pendingTxStreamLock.Lock()
+			delete(rpc.pendingTxStream, txStream)
+			rpc.pendingTxStreamLock.Unlock()
+			return nil
+		}
+	}
+}
+
+func (rpc *AergoRPCService) BroadcastToPendingTxStream(tx *types.Tx) {
+	rpc.pendingTxStreamLock.RLock()
+	defer rpc.pendingTxStreamLock.RUnlock()
+
+	for _, ts := range rpc.pendingTxStream {
+		if ts != nil && ts.filter.Match(tx) {
+			if err := ts.stream.Send(ts.filter.Apply(tx)); err != nil {
+				logger.Warn().Err(err).Msg("failed to broadcast pending tx stream")
+			}
+		}
+	}
+}
+
 func (rpc *AergoRPCService) ListEvents(ctx context.Context, in *types.FilterInfo) (*types.EventList, error) {
 	if err := rpc.checkAuth(ctx, ReadBlockChain); err != nil {
 		return nil, err
@@ -63,6 +63,7 @@ func NewRPC(cfg *config.Config, chainAccessor types.ChainAccessor, version strin
 		blockStream:         map[uint32]types.AergoRPCService_ListBlockStreamServer{},
 		blockMetadataStream: map[uint32]types.AergoRPCService_ListBlockMetadataStreamServer{},
 		eventStream:         make(map[*EventStream]*EventStream),
+		pendingTxStream:     make(map[*PendingTxStream]*PendingTxStream),
 	}
 
 	tracer := opentracing.GlobalTracer()
@@ -244,6 +245,8 @@ func (ns *RPC) Receive(context actor.Context) {
 			}
 		}
 		server.BroadcastToEventStream(msg)
+	case *types.Tx:
+		ns.actualServer.BroadcastToPendingTxStream(msg)
 	case *message.GetServerInfo:
 		context.Respond(ns.CollectServerInfo(msg.Categories))
 	case *actor.Started, *actor.Stopping, *actor.Stopped, *component.CompStatReq: // donothing
@@ -396,7 +399,7 @@ func convertError(err error) types.CommitStatus {
 		return types.CommitStatus_TX_INVALID_FORMAT
 	case types.ErrInsufficientBalance:
 		return types.CommitStatus_TX_INSUFFICIENT_BALANCE
-	case types.ErrSameNonceAlreadyInMempool:
+	case types.ErrSameNonceAlreadyInMempool, types.ErrTxReplacementUnderpriced:
 		return types.CommitStatus_TX_HAS_SAME_NONCE
 	default:
 		//logger.Info().Str("hash", err.Error()).Msg("RPC encountered unconvertable error")
@@ -17,6 +17,7 @@ type ChainStateDB struct {
 	states   *StateDB
 	store    db.DB
 	testmode bool
+	pruner   *statePruner
 }
 
 // NewChainStateDB creates instance of ChainStateDB
@@ -32,6 +33,7 @@ func (sdb *ChainStateDB) Clone() *ChainStateDB {
 	newSdb := &ChainStateDB{
 		store:  sdb.store,
 		states: sdb.GetStateDB().Clone(),
+		pruner: sdb.pruner,
 	}
 	return newSdb
 }
@@ -65,6 +67,9 @@ func (sdb *ChainStateDB) Close() error {
 	sdb.Lock()
 	defer sdb.Unlock()
 
+	if sdb.pruner != nil {
+		sdb.pruner.wait()
+	}
 	// close db
 	if sdb.store != nil {
 		sdb.store.Close()
@@ -84,7 +89,32 @@ func (sdb *ChainStateDB) GetSystemAccountState() (*ContractState, error) {
 
 // OpenNewStateDB returns new instance of statedb given state root hash
 func (sdb *ChainStateDB) OpenNewStateDB(root []byte) *StateDB {
-	return NewStateDB(sdb.store, root, sdb.testmode)
+	states := NewStateDB(sdb.store, root, sdb.testmode)
+	states.setPruner(sdb.pruner)
+	return states
+}
+
+// EnablePruning makes the trie nodes replaced by the following commits be
+// deleted by Prune, once no state root kept refers to them. It must be called
+// before any state is opened.
+func (sdb *ChainStateDB) EnablePruning() {
+	sdb.Lock()
+	defer sdb.Unlock()
+
+	if sdb.pruner == nil {
+		sdb.pruner = newStatePruner(sdb.store)
+		sdb.states.setPruner(sdb.pruner)
+	}
+}
+
+// Prune deletes in background the replaced trie nodes which are not reachable
+// from roots, which are the state roots of the blocks to keep. It returns
+// false if pruning is not enabled or the previous one is still running.
+func (sdb *ChainStateDB) Prune(roots [][]byte) bool {
+	if sdb.pruner == nil {
+		return false
+	}
+	return sdb.pruner.start(roots)
 }
 
 func (sdb *ChainStateDB) SetGenesis(genesis *types.Genesis, bpInit func(*StateDB, *types.Genesis) error) error {
@@ -21,6 +21,9 @@ func (states *StateDB) OpenContractState(aid types.AccountID, st *types.State) (
 	if storage == nil {
 		root := common.Compactz(st.StorageRoot)
 		storage = newBufferedStorage(root, states.store)
+		if states.pruner != nil {
+			storage.trie.RecordStaleNodes()
+		}
 	}
 	res := &ContractState{
 		State:   st,
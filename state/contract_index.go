@@ -0,0 +1,300 @@
+package state
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+// A key index keeps the keys of a contract variable in byte order, so that
+// they can be enumerated. It is stored in the contract storage like any other
+// data, which keeps it consistent with Snapshot and Rollback.
+//
+// The keys are split into leaves of at most maxIndexLeafKeys keys. The
+// directory, stored under the index key, lists the lower bound and the number
+// of every leaf. A leaf is stored under the index key followed by its number.
+
+const (
+	maxIndexLeafKeys = 64
+	MaxIndexKeys     = 1000
+)
+
+var errInvalidIndex = errors.New("invalid key index")
+
+// KeyQuery selects the keys of an index. Every bound is optional.
+type KeyQuery struct {
+	Prefix []byte // keys starting with Prefix
+	From   []byte // keys greater than or equal to From
+	To     []byte // keys less than To
+	After  []byte // keys greater than After, the cursor of the previous page
+	Limit  int    // MaxIndexKeys when zero
+}
+
+type indexLeaf struct {
+	lower []byte
+	no    uint32
+}
+
+type indexDir struct {
+	next   uint32
+	leaves []indexLeaf
+}
+
+func indexLeafKey(index []byte, no uint32) []byte {
+	key := make([]byte, len(index)+5)
+	copy(key, index)
+	key[len(index)] = '/'
+	binary.BigEndian.PutUint32(key[len(index)+1:], no)
+	return key
+}
+
+func encodeKeys(keys [][]byte) []byte {
+	var b bytes.Buffer
+	l := make([]byte, binary.MaxVarintLen64)
+	b.Write(l[:binary.PutUvarint(l, uint64(len(keys)))])
+	for _, k := range keys {
+		b.Write(l[:binary.PutUvarint(l, uint64(len(k)))])
+		b.Write(k)
+	}
+	return b.Bytes()
+}
+
+func decodeKeys(data []byte) ([][]byte, []byte, error) {
+	n, l := binary.Uvarint(data)
+	if l <= 0 {
+		return nil, nil, errInvalidIndex
+	}
+	data = data[l:]
+	keys := make([][]byte, 0, n)
+	for i := uint64(0); i < n; i++ {
+		kl, l := binary.Uvarint(data)
+		if l <= 0 || uint64(len(data)-l) < kl {
+			return nil, nil, errInvalidIndex
+		}
+		keys = append(keys, data[l:l+int(kl)])
+		data = data[l+int(kl):]
+	}
+	return keys, data, nil
+}
+
+func (st *ContractState) getIndexDir(index []byte) (*indexDir, error) {
+	data, err := st.GetData(index)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	next, l := binary.Uvarint(data)
+	if l <= 0 {
+		return nil, errInvalidIndex
+	}
+	lowers, rest, err := decodeKeys(data[l:])
+	if err != nil || len(rest) != 4*len(lowers) {
+		return nil, errInvalidIndex
+	}
+	dir := &indexDir{next: uint32(next), leaves: make([]indexLeaf, len(lowers))}
+	for i, lower := range lowers {
+		dir.leaves[i] = indexLeaf{lower: lower, no: binary.BigEndian.Uint32(rest[4*i:])}
+	}
+	return dir, nil
+}
+
+func (st *ContractState) setIndexDir(index []byte, dir *indexDir) error {
+	l := make([]byte, binary.MaxVarintLen64)
+	data := append([]byte{}, l[:binary.PutUvarint(l, uint64(dir.next))]...)
+	lowers := make([][]byte, len(dir.leaves))
+	for i, leaf := range dir.leaves {
+		lowers[i] = leaf.lower
+	}
+	data = append(data, encodeKeys(lowers)...)
+	for _, leaf := range dir.leaves {
+		binary.BigEndian.PutUint32(l, leaf.no)
+		data = append(data, l[:4]...)
+	}
+	return st.SetData(index, data)
+}
+
+func (st *ContractState) getIndexLeaf(index []byte, no uint32) ([][]byte, error) {
+	data, err := st.GetData(indexLeafKey(index, no))
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	keys, rest, err := decodeKeys(data)
+	if err != nil || len(rest) != 0 {
+		return nil, errInvalidIndex
+	}
+	return keys, nil
+}
+
+func (st *ContractState) setIndexLeaf(index []byte, no uint32, keys [][]byte) error {
+	if len(keys) == 0 {
+		return st.DeleteData(indexLeafKey(index, no))
+	}
+	return st.SetData(indexLeafKey(index, no), encodeKeys(keys))
+}
+
+// leafOf returns the position in dir of the leaf which key belongs to.
+func (dir *indexDir) leafOf(key []byte) int {
+	i := sort.Search(len(dir.leaves), func(i int) bool {
+		return bytes.Compare(dir.leaves[i].lower, key) > 0
+	})
+	if i == 0 {
+		return 0
+	}
+	return i - 1
+}
+
+func searchKey(keys [][]byte, key []byte) (int, bool) {
+	i := sort.Search(len(keys), func(i int) bool {
+		return bytes.Compare(keys[i], key) >= 0
+	})
+	return i, i < len(keys) && bytes.Equal(keys[i], key)
+}
+
+// AddIndexKey adds key to index. It returns false if key is already in index.
+func (st *ContractState) AddIndexKey(index, key []byte) (bool, error) {
+	dir, err := st.getIndexDir(index)
+	if err != nil {
+		return false, err
+	}
+	if dir == nil {
+		dir = &indexDir{next: 1, leaves: []indexLeaf{{lower: []byte{}, no: 0}}}
+		if err = st.setIndexDir(index, dir); err != nil {
+			return false, err
+		}
+	}
+	pos := dir.leafOf(key)
+	leaf := dir.leaves[pos]
+	keys, err := st.getIndexLeaf(index, leaf.no)
+	if err != nil {
+		return false, err
+	}
+	i, found := searchKey(keys, key)
+	if found {
+		return false, nil
+	}
+	keys = append(keys, nil)
+	copy(keys[i+1:], keys[i:])
+	keys[i] = append([]byte{}, key...)
+
+	if len(keys) <= maxIndexLeafKeys {
+		return true, st.setIndexLeaf(index, leaf.no, keys)
+	}
+
+	// split the leaf in halves
+	half := len(keys) / 2
+	right := indexLeaf{lower: keys[half], no: dir.next}
+	dir.next++
+	dir.leaves = append(dir.leaves, indexLeaf{})
+	copy(dir.leaves[pos+2:], dir.leaves[pos+1:])
+	dir.leaves[pos+1] = right
+	if err = st.setIndexLeaf(index, leaf.no, keys[:half]); err != nil {
+		return false, err
+	}
+	if err = st.setIndexLeaf(index, right.no, keys[half:]); err != nil {
+		return false, err
+	}
+	return true, st.setIndexDir(index, dir)
+}
+
+// DeleteIndexKey deletes key from index. It returns false if key is not in
+// index.
+func (st *ContractState) DeleteIndexKey(index, key []byte) (bool, error) {
+	dir, err := st.getIndexDir(index)
+	if err != nil || dir == nil {
+		return false, err
+	}
+	pos := dir.leafOf(key)
+	leaf := dir.leaves[pos]
+	keys, err := st.getIndexLeaf(index, leaf.no)
+	if err != nil {
+		return false, err
+	}
+	i, found := searchKey(keys, key)
+	if !found {
+		return false, nil
+	}
+	keys = append(keys[:i], keys[i+1:]...)
+	if len(keys) >= maxIndexLeafKeys/4 {
+		return true, st.setIndexLeaf(index, leaf.no, keys)
+	}
+	if len(dir.leaves) == 1 {
+		if len(keys) == 0 {
+			// free the index with its last key
+			if err = st.setIndexLeaf(index, leaf.no, nil); err != nil {
+				return false, err
+			}
+			return true, st.DeleteData(index)
+		}
+		return true, st.setIndexLeaf(index, leaf.no, keys)
+	}
+
+	// merge an underfull leaf with its neighbour if they fit in a leaf
+	left, right := pos-1, pos
+	if pos == 0 {
+		left, right = 0, 1
+	}
+	other := dir.leaves[left+right-pos]
+	otherKeys, err := st.getIndexLeaf(index, other.no)
+	if err != nil {
+		return false, err
+	}
+	if len(keys)+len(otherKeys) > maxIndexLeafKeys {
+		return true, st.setIndexLeaf(index, leaf.no, keys)
+	}
+	merged := make([][]byte, 0, len(keys)+len(otherKeys))
+	if pos == left {
+		merged = append(append(merged, keys...), otherKeys...)
+	} else {
+		merged = append(append(merged, otherKeys...), keys...)
+	}
+	if err = st.setIndexLeaf(index, dir.leaves[left].no, merged); err != nil {
+		return false, err
+	}
+	if err = st.setIndexLeaf(index, dir.leaves[right].no, nil); err != nil {
+		return false, err
+	}
+	dir.leaves = append(dir.leaves[:right], dir.leaves[right+1:]...)
+	return true, st.setIndexDir(index, dir)
+}
+
+// IndexKeys returns the keys of index selected by q in byte order. more is set
+// if there are more keys after the last one returned.
+func (st *ContractState) IndexKeys(index []byte, q *KeyQuery) (keys [][]byte, more bool, err error) {
+	limit := q.Limit
+	if limit <= 0 || limit > MaxIndexKeys {
+		limit = MaxIndexKeys
+	}
+	dir, err := st.getIndexDir(index)
+	if err != nil || dir == nil {
+		return nil, false, err
+	}
+
+	start := q.Prefix
+	if bytes.Compare(q.From, start) > 0 {
+		start = q.From
+	}
+	if bytes.Compare(q.After, start) > 0 {
+		start = q.After
+	}
+	for pos := dir.leafOf(start); pos < len(dir.leaves); pos++ {
+		leafKeys, err := st.getIndexLeaf(index, dir.leaves[pos].no)
+		if err != nil {
+			return nil, false, err
+		}
+		i, _ := searchKey(leafKeys, start)
+		for ; i < len(leafKeys); i++ {
+			k := leafKeys[i]
+			if q.After != nil && bytes.Compare(k, q.After) <= 0 {
+				continue
+			}
+			if (q.To != nil && bytes.Compare(k, q.To) >= 0) || !bytes.HasPrefix(k, q.Prefix) {
+				return keys, false, nil
+			}
+			if len(keys) == limit {
+				return keys, true, nil
+			}
+			keys = append(keys, k)
+		}
+	}
+	return keys, false, nil
+}
@@ -0,0 +1,119 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func indexKeyStrings(keys [][]byte) []string {
+	s := make([]string, len(keys))
+	for i, k := range keys {
+		s[i] = string(k)
+	}
+	return s
+}
+
+func TestContractStateIndexKeys(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	index := []byte("_sv_meta-idx_balances")
+
+	contractState, err := stateDB.OpenContractStateAccount(types.ToAccountID([]byte("test_address")))
+	assert.NoError(t, err, "could not open contract state")
+
+	keys, more, err := contractState.IndexKeys(index, &KeyQuery{})
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.False(t, more)
+
+	// insert in reverse order to split leaves
+	n := 3 * maxIndexLeafKeys
+	for i := n - 1; i >= 0; i-- {
+		added, err := contractState.AddIndexKey(index, []byte(fmt.Sprintf("key%04d", i)))
+		assert.NoError(t, err)
+		assert.True(t, added)
+	}
+	added, err := contractState.AddIndexKey(index, []byte("key0000"))
+	assert.NoError(t, err)
+	assert.False(t, added, "duplicated key")
+
+	keys, more, err = contractState.IndexKeys(index, &KeyQuery{Limit: n})
+	assert.NoError(t, err)
+	assert.False(t, more)
+	assert.Len(t, keys, n)
+	for i, k := range keys {
+		assert.Equal(t, fmt.Sprintf("key%04d", i), string(k))
+	}
+
+	// pages
+	var all []string
+	q := &KeyQuery{Limit: 50}
+	for {
+		keys, more, err = contractState.IndexKeys(index, q)
+		assert.NoError(t, err)
+		all = append(all, indexKeyStrings(keys)...)
+		if !more {
+			break
+		}
+		q.After = keys[len(keys)-1]
+	}
+	assert.Len(t, all, n)
+
+	// bounds
+	keys, _, err = contractState.IndexKeys(index, &KeyQuery{Prefix: []byte("key001")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key0010", "key0011", "key0012", "key0013", "key0014",
+		"key0015", "key0016", "key0017", "key0018", "key0019"}, indexKeyStrings(keys))
+	keys, _, err = contractState.IndexKeys(index, &KeyQuery{From: []byte("key0100"), To: []byte("key0103")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key0100", "key0101", "key0102"}, indexKeyStrings(keys))
+
+	// rollback
+	snap := contractState.Snapshot()
+	for i := 0; i < n; i += 2 {
+		deleted, err := contractState.DeleteIndexKey(index, []byte(fmt.Sprintf("key%04d", i)))
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	}
+	deleted, err := contractState.DeleteIndexKey(index, []byte("nokey"))
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+	keys, _, err = contractState.IndexKeys(index, &KeyQuery{Limit: n})
+	assert.NoError(t, err)
+	assert.Len(t, keys, n/2)
+	assert.Equal(t, "key0001", string(keys[0]))
+
+	assert.NoError(t, contractState.Rollback(snap))
+	keys, _, err = contractState.IndexKeys(index, &KeyQuery{Limit: n})
+	assert.NoError(t, err)
+	assert.Len(t, keys, n)
+	// underfull leaves are merged and an empty index is freed
+	for i := 0; i < n; i++ {
+		if i%maxIndexLeafKeys == 0 {
+			continue
+		}
+		deleted, err := contractState.DeleteIndexKey(index, []byte(fmt.Sprintf("key%04d", i)))
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	}
+	dir, err := contractState.getIndexDir(index)
+	assert.NoError(t, err)
+	assert.Len(t, dir.leaves, 1)
+	keys, _, err = contractState.IndexKeys(index, &KeyQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key0000", "key0064", "key0128"}, indexKeyStrings(keys))
+	for _, k := range keys {
+		deleted, err := contractState.DeleteIndexKey(index, k)
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	}
+	data, err := contractState.GetData(index)
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+	data, err = contractState.GetData(indexLeafKey(index, dir.leaves[0].no))
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+}
@@ -0,0 +1,203 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package state
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/Cofresi/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/trie"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+)
+
+// pruneJournalPrefix is the key prefix of the journal of stale trie nodes.
+// The length of the keys differs from the one of the hashes keying the trie
+// nodes and data blobs.
+var pruneJournalPrefix = []byte(stateName + ".prune.")
+
+// statePruner deletes the trie nodes which are replaced by the state updates
+// once no state root kept refers to them.
+//
+// The nodes replaced by each commit are journaled in db. Since a node may be
+// shared by the tries of several contracts, or be added back by a later
+// update, a journaled node is deleted only if it is not reachable from any of
+// the kept roots, which are walked from their roots to the leaves. The data
+// blobs like the account states and the contract codes are not deleted, as
+// several leaves may refer to the same blob.
+type statePruner struct {
+	sync.Mutex
+	store db.DB
+
+	// stale is the set of the journaled nodes, which may be deleted
+	stale map[trie.Hash]struct{}
+	// seq is the sequence number of the next journal entry
+	seq uint64
+
+	// running is true while a pruning is in progress, the roots committed
+	// meanwhile are kept
+	running bool
+	roots   [][]byte
+	wg      sync.WaitGroup
+}
+
+func newStatePruner(store db.DB) *statePruner {
+	p := &statePruner{
+		store: store,
+		stale: make(map[trie.Hash]struct{}),
+	}
+	for it := store.Iterator(pruneJournalPrefix, p.journalKey(^uint64(0))); it.Valid(); it.Next() {
+		p.addStale(it.Value())
+		p.seq = binary.BigEndian.Uint64(it.Key()[len(pruneJournalPrefix):]) + 1
+	}
+	return p
+}
+
+func (p *statePruner) journalKey(seq uint64) []byte {
+	key := make([]byte, len(pruneJournalPrefix)+8)
+	copy(key, pruneJournalPrefix)
+	binary.BigEndian.PutUint64(key[len(pruneJournalPrefix):], seq)
+	return key
+}
+
+func (p *statePruner) addStale(nodes []byte) {
+	for i := 0; i+trie.HashLength <= len(nodes); i += trie.HashLength {
+		var h trie.Hash
+		copy(h[:], nodes[i:i+trie.HashLength])
+		p.stale[h] = struct{}{}
+	}
+}
+
+// stage journals the nodes replaced by the commit of root. It must be called
+// with the lock held until the commit is flushed.
+func (p *statePruner) stage(txn trie.DbTx, root []byte, stale [][]byte) {
+	if p.running && len(root) != 0 {
+		p.roots = append(p.roots, root)
+	}
+	if len(stale) == 0 {
+		return
+	}
+	nodes := make([]byte, 0, len(stale)*trie.HashLength)
+	for _, node := range stale {
+		nodes = append(nodes, node...)
+	}
+	txn.Set(p.journalKey(p.seq), nodes)
+	p.seq++
+	p.addStale(nodes)
+}
+
+// start runs a pruning in background, which keeps the states of roots. It
+// returns false if the previous one is still running.
+func (p *statePruner) start(roots [][]byte) bool {
+	p.Lock()
+	defer p.Unlock()
+	if p.running {
+		return false
+	}
+	p.running = true
+	p.roots = nil
+
+	stale := p.stale
+	p.stale = make(map[trie.Hash]struct{})
+
+	p.wg.Add(1)
+	go p.prune(roots, stale, p.seq)
+	return true
+}
+
+// wait blocks until the running pruning finishes.
+func (p *statePruner) wait() {
+	p.wg.Wait()
+}
+
+func (p *statePruner) prune(roots [][]byte, stale map[trie.Hash]struct{}, end uint64) {
+	defer p.wg.Done()
+
+	reachable := make(map[trie.Hash]struct{})
+	var err error
+	for _, root := range roots {
+		// the states before a snapshot sync are not in db
+		if len(root) == 0 || !bytes.Equal(p.store.Get(common.Hasher(root)), stateMarker) {
+			continue
+		}
+		if err = p.mark(root, reachable); err != nil {
+			break
+		}
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	defer func() { p.running = false }()
+
+	// the roots committed while marking
+	for _, root := range p.roots {
+		if err != nil {
+			break
+		}
+		err = p.mark(root, reachable)
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to prune state")
+		for h := range stale {
+			p.stale[h] = struct{}{}
+		}
+		return
+	}
+
+	var (
+		bulk    = p.store.NewBulk()
+		kept    []byte
+		deleted int
+	)
+	for h := range stale {
+		if _, ok := reachable[h]; ok {
+			kept = append(kept, h[:]...)
+			p.stale[h] = struct{}{}
+			continue
+		}
+		bulk.Delete(h[:])
+		deleted++
+	}
+	for it := p.store.Iterator(pruneJournalPrefix, p.journalKey(end)); it.Valid(); it.Next() {
+		bulk.Delete(append([]byte{}, it.Key()...))
+	}
+	if len(kept) != 0 {
+		bulk.Set(p.journalKey(p.seq), kept)
+		p.seq++
+	}
+	bulk.Flush()
+
+	logger.Info().Int("deleted", deleted).Int("kept", len(kept)/trie.HashLength).
+		Int("roots", len(roots)).Msg("pruned state")
+}
+
+// mark adds the nodes of the state of root, including the storages of the
+// contracts, to reachable.
+func (p *statePruner) mark(root []byte, reachable map[trie.Hash]struct{}) error {
+	onNode := func(node []byte) bool {
+		var h trie.Hash
+		copy(h[:], node)
+		if _, ok := reachable[h]; ok {
+			return false
+		}
+		reachable[h] = struct{}{}
+		return true
+	}
+	return trie.NewTrie(nil, common.Hasher, p.store).WalkNodes(root, onNode, func(key, value []byte) error {
+		raw := p.store.Get(value)
+		if len(raw) == 0 {
+			return errLoadStateData
+		}
+		st := &types.State{}
+		if err := proto.Unmarshal(raw, st); err != nil {
+			return err
+		}
+		return trie.NewTrie(nil, common.Hasher, p.store).WalkNodes(st.StorageRoot, onNode, nil)
+	})
+}
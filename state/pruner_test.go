@@ -0,0 +1,76 @@
+package state
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Cofresi/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatePrune(t *testing.T) {
+	sdb := NewChainStateDB()
+	assert.NoError(t, sdb.Init(string(db.LevelImpl), "test_prune", nil, false))
+	defer func() {
+		_ = sdb.Close()
+		_ = os.RemoveAll("test_prune")
+	}()
+	sdb.EnablePruning()
+
+	contract1 := types.ToAccountID([]byte("contract1"))
+	contract2 := types.ToAccountID([]byte("contract2"))
+
+	var roots [][]byte
+	for i := 0; i < 10; i++ {
+		bs := sdb.NewBlockState(sdb.GetRoot())
+		for j := 0; j < 20; j++ {
+			id := types.ToAccountID([]byte{byte(j), 'a'})
+			assert.NoError(t, bs.PutState(id, &types.State{Nonce: uint64(i*100 + j)}))
+		}
+		// both contracts have the same storage in the first block
+		for _, id := range []types.AccountID{contract1, contract2} {
+			if i > 0 && id == contract2 {
+				continue
+			}
+			cs, err := bs.OpenContractStateAccount(id)
+			assert.NoError(t, err)
+			assert.NoError(t, cs.SetData([]byte("key"), []byte{byte(i)}))
+			assert.NoError(t, bs.StageContractState(cs))
+		}
+		assert.NoError(t, sdb.Apply(bs))
+		roots = append(roots, sdb.GetRoot())
+	}
+
+	assert.True(t, sdb.Prune(roots[7:]))
+	sdb.pruner.wait()
+
+	// the kept states are complete
+	for i, root := range roots[7:] {
+		states := sdb.OpenNewStateDB(root)
+		st, err := states.GetState(types.ToAccountID([]byte{5, 'a'}))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64((i+7)*100+5), st.Nonce)
+
+		cs, err := states.OpenContractStateAccount(contract1)
+		assert.NoError(t, err)
+		v, err := cs.GetData([]byte("key"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{byte(i + 7)}, v)
+
+		// the storage nodes shared with contract1 are not deleted
+		cs, err = states.OpenContractStateAccount(contract2)
+		assert.NoError(t, err)
+		v, err = cs.GetData([]byte("key"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0}, v)
+	}
+
+	// the old states are pruned
+	_, err := sdb.OpenNewStateDB(roots[2]).GetState(types.ToAccountID([]byte{5, 'a'}))
+	assert.Error(t, err)
+
+	// the nodes still reachable remain in the journal
+	assert.Equal(t, len(sdb.pruner.stale), len(newStatePruner(sdb.store).stale))
+	assert.NotEmpty(t, sdb.pruner.stale)
+}
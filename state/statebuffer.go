@@ -172,6 +172,26 @@ func (buffer *stateBuffer) export() ([][]byte, [][]byte) {
 	return keys, vals
 }
 
+// keys returns the keys of the live entries in the buffer sorted in
+// ascending order. Meta entries are not included.
+func (buffer *stateBuffer) keys() []types.HashID {
+	keys := make([]types.HashID, 0, len(buffer.indexes))
+	for k, v := range buffer.indexes {
+		idx := v.peek()
+		if idx < 0 {
+			continue
+		}
+		if _, ok := buffer.entries[idx].(*metaEntry); ok {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return -1 == keys[i].Compare(keys[j])
+	})
+	return keys
+}
+
 func (buffer *stateBuffer) updateTrie(tr *trie.Trie) error {
 	keys, vals := buffer.export()
 	if len(keys) == 0 || len(vals) == 0 {
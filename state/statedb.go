@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"sync"
 
 	"github.com/Cofresi/aergo-lib/db"
@@ -64,6 +65,7 @@ type StateDB struct {
 	store    db.DB
 	batchtx  db.Transaction
 	testmode bool
+	pruner   *statePruner
 }
 
 // NewStateDB craete StateDB instance
@@ -83,7 +85,18 @@ func (states *StateDB) Clone() *StateDB {
 	states.lock.RLock()
 	defer states.lock.RUnlock()
 
-	return NewStateDB(states.store, states.GetRoot(), states.testmode)
+	newStates := NewStateDB(states.store, states.GetRoot(), states.testmode)
+	newStates.setPruner(states.pruner)
+	return newStates
+}
+
+// setPruner makes the updates of the state journal the trie nodes they
+// replace to p, if p is not nil.
+func (states *StateDB) setPruner(p *statePruner) {
+	states.pruner = p
+	if p != nil {
+		states.trie.RecordStaleNodes()
+	}
 }
 
 // GetRoot returns root hash of trie
@@ -426,6 +439,49 @@ func (states *StateDB) GetAccountAndProof(id []byte, root []byte, compressed boo
 	return accountProof, nil
 }
 
+// ChangedAccounts returns the IDs of the accounts whose states or contract
+// storages are buffered but not yet committed, sorted in ascending order.
+func (states *StateDB) ChangedAccounts() []types.AccountID {
+	states.lock.RLock()
+	defer states.lock.RUnlock()
+
+	changed := make(map[types.AccountID]bool)
+	for _, k := range states.buffer.keys() {
+		changed[types.AccountID(k)] = true
+	}
+	states.cache.lock.RLock()
+	for id, storage := range states.cache.storages {
+		if storage != nil && len(storage.buffer.keys()) > 0 {
+			changed[id] = true
+		}
+	}
+	states.cache.lock.RUnlock()
+
+	ids := make([]types.AccountID, 0, len(changed))
+	for id := range changed {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return -1 == types.HashID(ids[i]).Compare(types.HashID(ids[j]))
+	})
+	return ids
+}
+
+// ChangedStorageKeys returns the hashed keys of the contract storage entries
+// of the account which are buffered but not yet committed.
+func (states *StateDB) ChangedStorageKeys(id types.AccountID) [][]byte {
+	storage := states.cache.get(id)
+	if storage == nil {
+		return nil
+	}
+	keys := storage.buffer.keys()
+	ret := make([][]byte, len(keys))
+	for i, k := range keys {
+		ret[i] = k.Bytes()
+	}
+	return ret
+}
+
 // Snapshot represents revision number of statedb
 type Snapshot int
 
@@ -509,10 +565,25 @@ func (states *StateDB) Commit() error {
 		bulk.DiscardLast()
 		return err
 	}
+	if states.pruner != nil {
+		// nodes must not be pruned before the commit is flushed
+		states.pruner.Lock()
+		defer states.pruner.Unlock()
+		states.pruner.stage(bulk, states.trie.Root, states.staleNodes())
+	}
 	bulk.Flush()
 	return nil
 }
 
+// staleNodes returns the trie nodes replaced by the committed updates.
+func (states *StateDB) staleNodes() [][]byte {
+	stale := states.trie.TakeStaleNodes()
+	for _, storage := range states.cache.storages {
+		stale = append(stale, storage.trie.TakeStaleNodes()...)
+	}
+	return stale
+}
+
 func (states *StateDB) stage(txn trie.DbTx) error {
 	// stage trie and buffer
 	states.trie.StageUpdates(txn)
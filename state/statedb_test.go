@@ -249,3 +249,25 @@ func TestStateDBMarker(t *testing.T) {
 	assert.False(t, stateDB.HasMarker([]byte{}))
 	assert.False(t, stateDB.HasMarker(nil))
 }
+
+func TestStateDBChanges(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+	assert.Empty(t, stateDB.ChangedAccounts())
+
+	testContract := types.ToAccountID([]byte("test_contract"))
+	testKey := []byte("test_key")
+
+	_ = stateDB.PutState(testAccount, &testStates[0])
+	contractState, err := stateDB.OpenContractState(testContract, &types.State{})
+	assert.NoError(t, err, "could not open contract state")
+	_ = contractState.SetData(testKey, []byte("test_value"))
+	_ = stateDB.StageContractState(contractState)
+
+	changed := stateDB.ChangedAccounts()
+	assert.Len(t, changed, 2)
+	assert.Contains(t, changed, testAccount)
+	assert.Contains(t, changed, testContract)
+	assert.Empty(t, stateDB.ChangedStorageKeys(testAccount))
+	assert.Equal(t, [][]byte{types.GetHashID(testKey).Bytes()}, stateDB.ChangedStorageKeys(testContract))
+}
@@ -0,0 +1,139 @@
+/**
+ *  @file
+ *  @copyright defined in aergo/LICENSE.txt
+ */
+
+package state
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/Cofresi/aergo-lib/db"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/pkg/trie"
+)
+
+// importCommitSize is the number of leaves imported before the rebuilt trie
+// nodes are written to db.
+const importCommitSize = 4096
+
+var (
+	ErrInvalidStateRange = errors.New("invalid state range")
+	ErrStateRootMismatch = errors.New("imported state root does not match")
+	ErrStateDataNotFound = errors.New("state data not found")
+)
+
+// GetStateRange returns up to limit leaves of the trie of root from start in
+// ascending order of keys, with the data blobs which the leaf values are the
+// hashes of. It serves both the account state trie and the storage tries of
+// contracts. more reports whether the trie has leaves after the returned ones.
+func (sdb *ChainStateDB) GetStateRange(root, start []byte, limit int) (keys, values, data [][]byte, more bool, err error) {
+	keys, values, more, err = trie.NewTrie(nil, common.Hasher, sdb.store).Walk(root, start, limit)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	data = make([][]byte, len(values))
+	for i, v := range values {
+		if data[i] = sdb.store.Get(v); len(data[i]) == 0 {
+			return nil, nil, nil, false, ErrStateDataNotFound
+		}
+	}
+	return keys, values, data, more, nil
+}
+
+// GetStateData returns the data blobs stored under hashes, like contract
+// codes. The blob of an unknown hash is nil.
+func (sdb *ChainStateDB) GetStateData(hashes [][]byte) [][]byte {
+	data := make([][]byte, len(hashes))
+	for i, h := range hashes {
+		if raw := sdb.store.Get(h); len(raw) != 0 {
+			data[i] = raw
+		}
+	}
+	return data
+}
+
+// PutStateData stores the data blobs under their hashes.
+func (sdb *ChainStateDB) PutStateData(data [][]byte) {
+	bulk := sdb.store.NewBulk()
+	for _, d := range data {
+		bulk.Set(common.Hasher(d), d)
+	}
+	bulk.Flush()
+}
+
+// MarkState marks root as a finalized state root. It must be called only
+// after all the tries and data blobs of the state are imported.
+func (sdb *ChainStateDB) MarkState(root []byte) {
+	txn := sdb.store.NewTx()
+	txn.Set(common.Hasher(root), stateMarker)
+	txn.Commit()
+}
+
+// StateImporter rebuilds a trie of a known root from its leaves, which are
+// downloaded by ranges as served by GetStateRange.
+type StateImporter struct {
+	store   db.DB
+	root    []byte
+	trie    *trie.Trie
+	lastKey []byte
+	pending int
+}
+
+// NewStateImporter returns an importer of the trie of root.
+func (sdb *ChainStateDB) NewStateImporter(root []byte) *StateImporter {
+	return &StateImporter{
+		store: sdb.store,
+		root:  root,
+		trie:  trie.NewTrie(nil, common.Hasher, sdb.store),
+	}
+}
+
+// Import adds a range of leaves to the trie. The keys must be in ascending
+// order and follow the keys imported before, and each value must be the hash
+// of its data blob.
+func (si *StateImporter) Import(keys, values, data [][]byte) error {
+	if len(keys) != len(values) || len(keys) != len(data) {
+		return ErrInvalidStateRange
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	for i, key := range keys {
+		if len(key) != trie.HashLength || (si.lastKey != nil && bytes.Compare(key, si.lastKey) <= 0) {
+			return ErrInvalidStateRange
+		}
+		if !bytes.Equal(common.Hasher(data[i]), values[i]) {
+			return ErrInvalidStateRange
+		}
+		si.lastKey = key
+	}
+	if _, err := si.trie.Update(keys, values); err != nil {
+		return err
+	}
+
+	bulk := si.store.NewBulk()
+	for i, v := range values {
+		bulk.Set(v, data[i])
+	}
+	bulk.Flush()
+
+	si.pending += len(keys)
+	if si.pending >= importCommitSize {
+		si.pending = 0
+		return si.trie.Commit()
+	}
+	return nil
+}
+
+// Finish writes the rest of the trie and verifies its root.
+func (si *StateImporter) Finish() error {
+	if err := si.trie.Commit(); err != nil {
+		return err
+	}
+	if !bytes.Equal(si.trie.Root, si.root) {
+		return ErrStateRootMismatch
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package state
+
+import (
+	"os"
+	"testing"
+
+	"github.com/Cofresi/aergo-lib/db"
+	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func importState(t *testing.T, src, dst *ChainStateDB, root []byte, batch int) error {
+	importer := dst.NewStateImporter(root)
+	var start []byte
+	for {
+		keys, values, data, more, err := src.GetStateRange(root, start, batch)
+		assert.NoError(t, err)
+		if err := importer.Import(keys, values, data); err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+		start = append([]byte{}, keys[len(keys)-1]...)
+		for i := len(start) - 1; i >= 0; i-- {
+			if start[i]++; start[i] != 0 {
+				break
+			}
+		}
+	}
+	return importer.Finish()
+}
+
+func TestStateImport(t *testing.T) {
+	initTest(t)
+	defer deinitTest()
+
+	for i := 0; i < 50; i++ {
+		id := types.ToAccountID([]byte{byte(i), 'a'})
+		assert.NoError(t, stateDB.PutState(id, &types.State{Nonce: uint64(i + 1)}))
+	}
+	contract, err := stateDB.OpenContractStateAccount(types.ToAccountID([]byte("test_contract")))
+	assert.NoError(t, err)
+	assert.NoError(t, contract.SetCode([]byte("test_code")))
+	for i := 0; i < 20; i++ {
+		assert.NoError(t, contract.SetData([]byte{byte(i), 'k'}, []byte{byte(i), 'v'}))
+	}
+	assert.NoError(t, stateDB.PutState(types.ToAccountID([]byte("test_contract")), contract.State))
+	assert.NoError(t, stateDB.StageContractState(contract))
+	assert.NoError(t, stateDB.Update())
+	assert.NoError(t, stateDB.Commit())
+	root := stateDB.GetRoot()
+	cst, err := stateDB.GetState(types.ToAccountID([]byte("test_contract")))
+	assert.NoError(t, err)
+
+	dst := NewChainStateDB()
+	assert.NoError(t, dst.Init(string(db.LevelImpl), "test_import", nil, false))
+	defer func() {
+		_ = dst.Close()
+		_ = os.RemoveAll("test_import")
+	}()
+
+	assert.NoError(t, importState(t, chainStateDB, dst, root, 7))
+	assert.NoError(t, importState(t, chainStateDB, dst, cst.StorageRoot, 3))
+	dst.PutStateData(chainStateDB.GetStateData([][]byte{cst.CodeHash}))
+	dst.MarkState(root)
+
+	imported := dst.OpenNewStateDB(root)
+	assert.True(t, imported.HasMarker(root))
+	st, err := imported.GetState(types.ToAccountID([]byte{9, 'a'}))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), st.Nonce)
+	cs, err := imported.OpenContractStateAccount(types.ToAccountID([]byte("test_contract")))
+	assert.NoError(t, err)
+	code, err := cs.GetCode()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test_code"), code)
+	v, err := cs.GetData([]byte{5, 'k'})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{5, 'v'}, v)
+
+	// leaves of another trie do not make the expected root
+	keys, values, data, _, err := chainStateDB.GetStateRange(cst.StorageRoot, nil, 100)
+	assert.NoError(t, err)
+	importer := dst.NewStateImporter(root)
+	assert.NoError(t, importer.Import(keys, values, data))
+	assert.Equal(t, ErrStateRootMismatch, importer.Finish())
+
+	// data blobs must match the leaf values
+	data[1] = []byte("forged")
+	assert.Equal(t, ErrInvalidStateRange, dst.NewStateImporter(root).Import(keys, values, data))
+}
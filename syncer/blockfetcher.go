@@ -62,6 +62,13 @@ type SyncPeer struct {
 	ID      types.PeerID
 	FailCnt int
 	IsErr   bool
+
+	// fetchSize is the number of blocks requested to the peer at once. It
+	// starts from the latency of the peer measured by p2p, and adapts to the
+	// time taken by the fetch tasks of the peer.
+	fetchSize int
+	// fetchTime is the moving average of the time taken by a fetch task
+	fetchTime time.Duration
 }
 
 type TaskQueue struct {
@@ -101,6 +108,10 @@ type FetchTask struct {
 
 	started time.Time
 	retry   int
+
+	// failedPeers are the peers which failed or stalled on this task. The
+	// task is retried by other peers if possible.
+	failedPeers map[types.PeerID]bool
 }
 
 type PeerSet struct {
@@ -112,10 +123,25 @@ type PeerSet struct {
 	badPeers  *list.List
 }
 
+const (
+	// RefFetchLatency is the peer latency under which the max number of
+	// blocks is requested at once
+	RefFetchLatency = time.Millisecond * 200
+	// TargetFetchTime is the time in which a fetch task is expected to be
+	// done. The fetch size of a peer grows if the peer is much faster than
+	// it, and shrinks if slower.
+	TargetFetchTime = time.Second * 3
+	// StallFactor times of the average fetch time of a peer is the time
+	// after which a task of the peer is regarded as stalled
+	StallFactor = 4
+)
+
 var (
 	schedTick            = time.Millisecond * 100
 	DfltFetchTimeOut     = time.Second * 30
 	DfltBlockFetchSize   = 100
+	MinBlockFetchSize    = 10
+	MinStallTimeOut      = time.Second * 5
 	MaxPeerFailCount     = 3
 	DfltBlockFetchTasks  = 5
 	MaxBlockPendingTasks = 10
@@ -251,10 +277,15 @@ func (bf *BlockFetcher) init() error {
 		for _, peerElem := range msg.Peers {
 			state := peerElem.State
 			if state.Get() == types.RUNNING {
-				bf.peers.addNew(types.PeerID(peerElem.Addr.PeerID))
+				bf.peers.addNew(types.PeerID(peerElem.Addr.PeerID), bf.initialFetchSize(peerElem.Latency))
 			}
 		}
 
+		// request blocks from all peers at once
+		if bf.peers.total > bf.maxFetchTasks {
+			bf.maxFetchTasks = bf.peers.total
+		}
+
 		if bf.peers.freePeers.Len() != bf.peers.free {
 			panic(fmt.Sprintf("free peer len mismatch %d,%d", bf.peers.freePeers.Len(), bf.peers.free))
 		}
@@ -302,19 +333,21 @@ func (bf *BlockFetcher) schedule() error {
 			return nil
 		}
 
-		freePeer, err := bf.popFreePeer()
+		freePeer, err := bf.popFreePeer(candTask)
 		if err != nil {
 			logger.Error().Err(err).Msg("error to get free peer")
 			return err
 		}
 		if freePeer == nil {
-			panic("free peer can't be nil")
+			// wait for a peer which didn't fail on the retry task
+			return nil
 		}
 
 		bf.popNextTask(candTask)
 		if candTask == nil {
 			panic("task can't be nil")
 		}
+		bf.splitTask(candTask, freePeer.fetchSize)
 
 		logger.Debug().Int("pendingConn", curPendingConn).Int("running", curRunning).Msg("schedule")
 		bf.runTask(candTask, freePeer)
@@ -332,7 +365,7 @@ func (bf *BlockFetcher) checkTaskTimeout() error {
 		task := e.Value.(*FetchTask)
 		next = e.Next()
 
-		if !task.isTimeOut(now, bf.cfg.fetchTimeOut) {
+		if !task.isTimeOut(now, bf.stallTimeOut(task.syncPeer)) {
 			continue
 		}
 
@@ -365,9 +398,14 @@ func (bf *BlockFetcher) processFailedTask(task *FetchTask, isErr bool) error {
 	logBadPeer(failPeer, bf.peers, bf.cfg)
 
 	bf.peers.processPeerFail(failPeer, isErr)
+	bf.shrinkFetchSize(failPeer)
 
 	task.retry++
 	task.syncPeer = nil
+	if task.failedPeers == nil {
+		task.failedPeers = make(map[types.PeerID]bool)
+	}
+	task.failedPeers[failPeer.ID] = true
 
 	//TODO sort by time because deadlock
 	bf.retryQueue.Push(task)
@@ -479,7 +517,95 @@ func (bf *BlockFetcher) searchCandidateTask() (*FetchTask, error) {
 	return newTask, nil
 }
 
-func (bf *BlockFetcher) popFreePeer() (*SyncPeer, error) {
+// splitTask cuts task to size blocks, and puts the rest back to the front of
+// the queue where task was.
+func (bf *BlockFetcher) splitTask(task *FetchTask, size int) {
+	if size <= 0 || task.count <= size {
+		return
+	}
+
+	rest := &FetchTask{count: task.count - size, hashes: task.hashes[size:], startNo: task.startNo + uint64(size),
+		retry: task.retry, failedPeers: task.failedPeers}
+	task.count = size
+	task.hashes = task.hashes[:size]
+
+	if rest.retry > 0 {
+		bf.retryQueue.Push(rest)
+	} else {
+		bf.pendingQueue.PushFront(rest)
+	}
+
+	logger.Debug().Uint64("StartNo", task.startNo).Int("count", task.count).Uint64("restNo", rest.startNo).Int("rest", rest.count).Msg("split fetchtask")
+}
+
+func (bf *BlockFetcher) minFetchSize() int {
+	if bf.maxFetchSize < MinBlockFetchSize {
+		return bf.maxFetchSize
+	}
+	return MinBlockFetchSize
+}
+
+func (bf *BlockFetcher) shrinkFetchSize(peer *SyncPeer) {
+	if peer.fetchSize /= 2; peer.fetchSize < bf.minFetchSize() {
+		peer.fetchSize = bf.minFetchSize()
+	}
+}
+
+// initialFetchSize returns the fetch size of a peer, which decreases in
+// proportion to its latency.
+func (bf *BlockFetcher) initialFetchSize(latency time.Duration) int {
+	size := bf.maxFetchSize
+	if latency > RefFetchLatency {
+		size = int(int64(bf.maxFetchSize) * int64(RefFetchLatency) / int64(latency))
+	}
+	if size < bf.minFetchSize() {
+		size = bf.minFetchSize()
+	}
+	return size
+}
+
+// adjustFetchSize updates the fetch size of the peer of the finished task
+// by the time taken for it.
+func (bf *BlockFetcher) adjustFetchSize(task *FetchTask) {
+	peer := task.syncPeer
+	elapsed := time.Now().Sub(task.started)
+
+	if peer.fetchTime == 0 {
+		peer.fetchTime = elapsed
+	} else {
+		peer.fetchTime = (peer.fetchTime*3 + elapsed) / 4
+	}
+
+	// only a full sized task tells the capacity of the peer
+	if task.count < peer.fetchSize {
+		return
+	}
+	if elapsed < TargetFetchTime/2 {
+		if peer.fetchSize *= 2; peer.fetchSize > bf.maxFetchSize {
+			peer.fetchSize = bf.maxFetchSize
+		}
+	} else if elapsed > TargetFetchTime {
+		bf.shrinkFetchSize(peer)
+	}
+
+	logger.Debug().Int("peerno", peer.No).Dur("elapsed", elapsed).Int("fetchsize", peer.fetchSize).Msg("adjusted fetch size")
+}
+
+// stallTimeOut returns the time after which a task of peer is regarded as
+// stalled and retried by another peer.
+func (bf *BlockFetcher) stallTimeOut(peer *SyncPeer) time.Duration {
+	timeout := bf.cfg.fetchTimeOut
+	stall := peer.fetchTime * StallFactor
+	if stall < MinStallTimeOut {
+		stall = MinStallTimeOut
+	}
+	if peer.fetchTime > 0 && stall < timeout {
+		timeout = stall
+	}
+	return timeout
+}
+
+func (bf *BlockFetcher) popFreePeer(task *FetchTask) (*SyncPeer, error) {
 	setDebugAllPeerBad := func(err error, cfg *SyncerConfig) {
 		if err == ErrAllPeerBad && cfg != nil && cfg.debugContext != nil {
 			debugCtx := cfg.debugContext
@@ -487,7 +613,7 @@ func (bf *BlockFetcher) popFreePeer() (*SyncPeer, error) {
 		}
 	}
 
-	freePeer, err := bf.peers.popFree()
+	freePeer, err := bf.peers.popFree(task.failedPeers)
 	if err != nil {
 		setDebugAllPeerBad(err, bf.cfg)
 		logger.Error().Err(err).Msg("pop free peer failed")
@@ -630,9 +756,9 @@ func (ps *PeerSet) isAllBad() bool {
 	return false
 }
 
-func (ps *PeerSet) addNew(peerID types.PeerID) {
+func (ps *PeerSet) addNew(peerID types.PeerID, fetchSize int) {
 	peerno := ps.total
-	ps.pushFree(&SyncPeer{No: peerno, ID: peerID})
+	ps.pushFree(&SyncPeer{No: peerno, ID: peerID, fetchSize: fetchSize})
 	ps.total++
 
 	logger.Info().Str("peer", p2putil.ShortForm(peerID)).Int("peerno", peerno).Int("no", ps.total).Msg("new peer added")
@@ -649,7 +775,10 @@ func (ps *PeerSet) pushFree(freePeer *SyncPeer) {
 	logger.Info().Int("no", freePeer.No).Int("free", ps.free).Msg("free peer added")
 }
 
-func (ps *PeerSet) popFree() (*SyncPeer, error) {
+// popFree pops a free peer which is not one of the failed peers. It returns
+// a failed peer only if no other good peer exists, and nil if the other good
+// peers are busy.
+func (ps *PeerSet) popFree(failedPeers map[types.PeerID]bool) (*SyncPeer, error) {
 	if ps.isAllBad() {
 		logger.Error().Msg("all peers are bad")
 		return nil, ErrAllPeerBad
@@ -659,6 +788,17 @@ func (ps *PeerSet) popFree() (*SyncPeer, error) {
 	if elem == nil {
 		return nil, nil
 	}
+	if len(failedPeers) > 0 {
+		for e := elem; e != nil; e = e.Next() {
+			if !failedPeers[e.Value.(*SyncPeer).ID] {
+				elem = e
+				break
+			}
+			if e.Next() == nil && ps.hasGoodPeerExcept(failedPeers) {
+				return nil, nil
+			}
+		}
+	}
 
 	ps.freePeers.Remove(elem)
 	ps.free--
@@ -672,6 +812,23 @@ func (ps *PeerSet) popFree() (*SyncPeer, error) {
 	return freePeer, nil
 }
 
+// hasGoodPeerExcept reports whether a peer which is neither bad nor one of
+// peers exists.
+func (ps *PeerSet) hasGoodPeerExcept(peers map[types.PeerID]bool) bool {
+	good := ps.total - ps.bad
+	for e := ps.badPeers.Front(); e != nil; e = e.Next() {
+		if peers[e.Value.(*SyncPeer).ID] {
+			good++
+		}
+	}
+	for id := range peers {
+		if peers[id] {
+			good--
+		}
+	}
+	return good > 0
+}
+
 func (ps *PeerSet) processPeerFail(failPeer *SyncPeer, isErr bool) {
 	//TODO handle connection closed
 	failPeer.FailCnt++
@@ -1,10 +1,13 @@
 package syncer
 
 import (
+	"fmt"
 	"github.com/aergoio/aergo/chain"
+	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 // test blockfetcher without finder/hashfetcher
@@ -99,3 +102,66 @@ func TestBlockFetcher_SortedQueue(t *testing.T) {
 	}
 	assert.Equal(t, 0, squeue.Len())
 }
+
+func TestBlockFetcher_PopFreePeerForRetry(t *testing.T) {
+	ps := newPeerSet()
+	for i := 0; i < 3; i++ {
+		ps.addNew(types.PeerID(fmt.Sprintf("peer-%d", i)), TestMaxBlockFetchSize)
+	}
+	failed := map[types.PeerID]bool{"peer-0": true}
+
+	// the failed peer is skipped
+	peer, err := ps.popFree(failed)
+	assert.NoError(t, err)
+	assert.Equal(t, types.PeerID("peer-1"), peer.ID)
+
+	// wait for a good peer while only the failed peer is free
+	busy, _ := ps.popFree(failed)
+	assert.Equal(t, types.PeerID("peer-2"), busy.ID)
+	peer, err = ps.popFree(failed)
+	assert.NoError(t, err)
+	assert.Nil(t, peer)
+
+	// the failed peer is used if the others are bad
+	ps.processPeerFail(busy, true)
+	ps.pushFree(&SyncPeer{No: 1, ID: "peer-1"})
+	failed["peer-1"] = true
+	peer, err = ps.popFree(failed)
+	assert.NoError(t, err)
+	assert.Equal(t, types.PeerID("peer-0"), peer.ID)
+}
+
+func TestBlockFetcher_FetchSize(t *testing.T) {
+	testCfg := *SyncerCfg
+	bf := &BlockFetcher{maxFetchSize: 100, cfg: &testCfg}
+
+	assert.Equal(t, 100, bf.initialFetchSize(0))
+	assert.Equal(t, 100, bf.initialFetchSize(RefFetchLatency))
+	assert.Equal(t, 50, bf.initialFetchSize(RefFetchLatency*2))
+	assert.Equal(t, MinBlockFetchSize, bf.initialFetchSize(RefFetchLatency*100))
+
+	peer := &SyncPeer{fetchSize: 40}
+	bf.adjustFetchSize(&FetchTask{count: 40, syncPeer: peer, started: time.Now()})
+	assert.Equal(t, 80, peer.fetchSize, "fast peer")
+	bf.adjustFetchSize(&FetchTask{count: 80, syncPeer: peer, started: time.Now().Add(-TargetFetchTime * 2)})
+	assert.Equal(t, 40, peer.fetchSize, "slow peer")
+
+	assert.Equal(t, testCfg.fetchTimeOut, bf.stallTimeOut(&SyncPeer{}))
+	assert.Equal(t, MinStallTimeOut, bf.stallTimeOut(&SyncPeer{fetchTime: time.Millisecond}))
+}
+
+func TestBlockFetcher_SplitTask(t *testing.T) {
+	bf := &BlockFetcher{}
+	bf.pendingQueue.Init()
+	bf.retryQueue.Init()
+
+	hashes := make([]message.BlockHash, 10)
+	task := &FetchTask{count: 10, hashes: hashes, startNo: 1}
+	bf.splitTask(task, 4)
+	assert.Equal(t, 4, task.count)
+	assert.Equal(t, 4, len(task.hashes))
+
+	rest := bf.pendingQueue.Pop()
+	assert.Equal(t, 6, rest.count)
+	assert.Equal(t, uint64(5), rest.startNo)
+}
@@ -88,6 +88,13 @@ func (bproc *BlockProcessor) isValidResponse(msg interface{}) error {
 				return &ErrSyncMsg{msg: msg, str: "blocks hash not matched"}
 			}
 
+			// the hashes are checked with the verified headers later, so
+			// the body must match the header
+			if !bytes.Equal(types.CalculateTxsRootHash(block.GetBody().GetTxs()), block.GetHeader().GetTxsRootHash()) {
+				logger.Error().Str("peer", p2putil.ShortForm(msg.ToWhom)).Uint64("no", block.BlockNo()).Msg("GetBlockChunksRsp has invalid block body")
+				return &ErrSyncMsg{msg: msg, str: "block body not matched"}
+			}
+
 			prev = block.GetHash()
 		}
 		return nil
@@ -143,6 +150,7 @@ func (bproc *BlockProcessor) GetBlockChunkRsp(msg *message.GetBlockChunksRsp) er
 		return nil
 	}
 
+	bf.adjustFetchSize(task)
 	bf.pushFreePeer(task.syncPeer)
 
 	bf.stat.setMaxChunkRsp(msg.Blocks[len(msg.Blocks)-1])
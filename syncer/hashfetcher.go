@@ -1,6 +1,7 @@
 package syncer
 
 import (
+	"bytes"
 	"sync"
 	"time"
 
@@ -11,17 +12,26 @@ import (
 	"github.com/pkg/errors"
 )
 
+// HashFetcher fetches the block headers of the sync peer ahead of the block
+// bodies. It validates the signatures and the links of prev hashes of the
+// headers, and passes their hashes to BlockFetcher, which downloads the
+// bodies from all peers. If the sync peer does not serve the headers, only
+// the hashes are fetched as before.
 type HashFetcher struct {
 	compRequester component.IComponentRequester //for communicate with other service
+	verifier      blockVerifier
 
 	ctx *types.SyncContext
 
-	responseCh chan *message.GetHashesRsp //HashSet response channel (<- Syncer)
-	resultCh   chan *HashSet              //BlockFetcher input channel (-> BlockFetcher)
+	responseCh chan *message.GetSyncHeadersRsp //header response channel (<- Syncer)
+	hashesCh   chan *message.GetHashesRsp      //HashSet response channel of the peers without headers (<- Syncer)
+	resultCh   chan *HashSet                   //BlockFetcher input channel (-> BlockFetcher)
 	//HashFetcher can wait in resultCh
 	quitCh chan interface{}
 
 	lastBlockInfo *types.BlockInfo
+	lastHeader    *types.BlockHeader
+	noHeaders     bool
 	reqCount      uint64
 	reqTime       time.Time
 	isRequesting  bool
@@ -48,6 +58,12 @@ type HashRequest struct {
 	count    uint64
 }
 
+// blockVerifier verifies the signature of a block by the consensus. It is
+// implemented by the chain service.
+type blockVerifier interface {
+	VerifySign(block *types.Block) error
+}
+
 var (
 	dfltTimeout     = time.Second * 180
 	DfltHashReqSize = uint64(1000)
@@ -57,17 +73,20 @@ var (
 	ErrQuitHashFetcher    = errors.New("Hashfetcher quit")
 	ErrInvalidHashSet     = errors.New("Invalid hash set reply")
 	ErrHashFetcherTimeout = errors.New("HashFetcher response timeout")
+	ErrInvalidHeaders     = errors.New("Invalid block headers reply")
 )
 
-func newHashFetcher(ctx *types.SyncContext, compRequester component.IComponentRequester, bfCh chan *HashSet, cfg *SyncerConfig) *HashFetcher {
-	hf := &HashFetcher{ctx: ctx, compRequester: compRequester, name: NameHashFetcher}
+func newHashFetcher(ctx *types.SyncContext, compRequester component.IComponentRequester, bfCh chan *HashSet, verifier blockVerifier, cfg *SyncerConfig) *HashFetcher {
+	hf := &HashFetcher{ctx: ctx, compRequester: compRequester, verifier: verifier, name: NameHashFetcher}
 
 	hf.quitCh = make(chan interface{})
-	hf.responseCh = make(chan *message.GetHashesRsp)
+	hf.responseCh = make(chan *message.GetSyncHeadersRsp)
+	hf.hashesCh = make(chan *message.GetHashesRsp)
 
 	hf.resultCh = bfCh
 
 	hf.lastBlockInfo = &types.BlockInfo{Hash: ctx.CommonAncestor.GetHash(), No: ctx.CommonAncestor.BlockNo()}
+	hf.lastHeader = ctx.CommonAncestor.GetHeader()
 
 	hf.maxHashReq = cfg.maxHashReqSize
 
@@ -105,28 +124,51 @@ func (hf *HashFetcher) Start() {
 					logger.Error().Msg("HashFetcher responseCh is closed. Syncer is stopping now")
 					return
 				}
-				logger.Debug().Msg("process GetHashesRsp")
+				logger.Debug().Msg("process GetSyncHeadersRsp")
 
 				timer.Stop()
+				if msg.Err == message.UnsupportedError {
+					logger.Info().Msg("sync peer does not serve headers, fetch hashes instead")
+					hf.noHeaders = true
+					hf.requestHashSet()
+					timer.Reset(hf.timeout)
+					continue
+				}
 				res, err := hf.isValidResponse(msg)
 				if res {
-					HashSet := &HashSet{Count: len(msg.Hashes), Hashes: msg.Hashes, StartNo: msg.PrevInfo.No + 1}
+					hashes, err := hf.verifyHeaders(msg.Headers)
+					if err != nil {
+						logger.Error().Err(err).Uint64("start", msg.StartNo).Msg("invalid headers, HashFetcher exited")
+						stopSyncer(hf.compRequester, hf.GetSeq(), hf.name, err)
+						return
+					}
+					hf.lastHeader = msg.Headers[len(msg.Headers)-1]
+					HashSet := &HashSet{Count: len(hashes), Hashes: hashes, StartNo: msg.StartNo}
 
-					if err := hf.processHashSet(HashSet); err != nil {
-						//TODO send errmsg to syncer & stop sync
-						logger.Error().Err(err).Msg("error! process hash chunk, HashFetcher exited")
-						if err != ErrQuitHashFetcher {
-							stopSyncer(hf.compRequester, hf.GetSeq(), hf.name, err)
-						}
+					if !hf.handleHashSet(HashSet) {
 						return
 					}
+				} else if err != nil {
+					stopSyncer(hf.compRequester, hf.GetSeq(), hf.name, err)
+				}
+
+				//timer restart
+				timer.Reset(hf.timeout)
+			case msg, ok := <-hf.hashesCh:
+				if !ok {
+					logger.Error().Msg("HashFetcher hashesCh is closed. Syncer is stopping now")
+					return
+				}
+				logger.Debug().Msg("process GetHashesRsp")
 
-					if hf.isFinished(HashSet) {
-						closeFetcher(hf.compRequester, hf.GetSeq(), hf.name)
-						logger.Info().Msg("HashFetcher finished")
+				timer.Stop()
+				res, err := hf.isValidHashesResponse(msg)
+				if res {
+					HashSet := &HashSet{Count: len(msg.Hashes), Hashes: msg.Hashes, StartNo: msg.PrevInfo.No + 1}
+
+					if !hf.handleHashSet(HashSet) {
 						return
 					}
-					hf.requestHashSet()
 				} else if err != nil {
 					stopSyncer(hf.compRequester, hf.GetSeq(), hf.name, err)
 				}
@@ -161,6 +203,27 @@ func (hf *HashFetcher) requestTimeout() bool {
 	return hf.isRequesting && time.Now().Sub(hf.reqTime) > hf.timeout
 }
 
+// handleHashSet passes the hashes to BlockFetcher and requests the next ones.
+// It returns false if HashFetcher has to exit.
+func (hf *HashFetcher) handleHashSet(HashSet *HashSet) bool {
+	if err := hf.processHashSet(HashSet); err != nil {
+		//TODO send errmsg to syncer & stop sync
+		logger.Error().Err(err).Msg("error! process hash chunk, HashFetcher exited")
+		if err != ErrQuitHashFetcher {
+			stopSyncer(hf.compRequester, hf.GetSeq(), hf.name, err)
+		}
+		return false
+	}
+
+	if hf.isFinished(HashSet) {
+		closeFetcher(hf.compRequester, hf.GetSeq(), hf.name)
+		logger.Info().Msg("HashFetcher finished")
+		return false
+	}
+	hf.requestHashSet()
+	return true
+}
+
 func (hf *HashFetcher) requestHashSet() {
 	count := hf.maxHashReq
 	if hf.ctx.TargetNo < hf.lastBlockInfo.No+hf.maxHashReq {
@@ -171,9 +234,44 @@ func (hf *HashFetcher) requestHashSet() {
 	hf.reqTime = time.Now()
 	hf.isRequesting = true
 
-	logger.Debug().Uint64("prev", hf.lastBlockInfo.No).Str("prevhash", enc.ToString(hf.lastBlockInfo.Hash)).Uint64("count", count).Msg("request hashset to peer")
+	if hf.noHeaders {
+		logger.Debug().Uint64("prev", hf.lastBlockInfo.No).Str("prevhash", enc.ToString(hf.lastBlockInfo.Hash)).Uint64("count", count).Msg("request hashset to peer")
 
-	hf.compRequester.TellTo(message.P2PSvc, &message.GetHashes{Seq: hf.GetSeq(), ToWhom: hf.ctx.PeerID, PrevInfo: hf.lastBlockInfo, Count: count})
+		hf.compRequester.TellTo(message.P2PSvc, &message.GetHashes{Seq: hf.GetSeq(), ToWhom: hf.ctx.PeerID, PrevInfo: hf.lastBlockInfo, Count: count})
+		return
+	}
+
+	logger.Debug().Uint64("prev", hf.lastBlockInfo.No).Str("prevhash", enc.ToString(hf.lastBlockInfo.Hash)).Uint64("count", count).Msg("request headers to peer")
+
+	hf.compRequester.TellTo(message.P2PSvc, &message.GetSyncHeaders{Seq: hf.GetSeq(), ToWhom: hf.ctx.PeerID, StartNo: hf.lastBlockInfo.No + 1, Count: count})
+}
+
+// verifyHeaders checks that each header is the child of its predecessor,
+// starting from the last verified block, and verifies their signatures. It
+// returns the hashes of the headers.
+func (hf *HashFetcher) verifyHeaders(headers []*types.BlockHeader) ([]message.BlockHash, error) {
+	hashes := make([]message.BlockHash, len(headers))
+	prevHash := hf.lastBlockInfo.Hash
+	parent := &types.Block{Header: hf.lastHeader}
+	for i, header := range headers {
+		block := &types.Block{Header: header}
+		if header.BlockNo != hf.lastBlockInfo.No+uint64(i)+1 ||
+			!bytes.Equal(header.PrevBlockHash, prevHash) ||
+			!block.ValidChildOf(parent) {
+			logger.Error().Uint64("no", header.BlockNo).Str("prev", enc.ToString(header.PrevBlockHash)).Msg("header is not linked to previous one")
+			return nil, ErrInvalidHeaders
+		}
+		if hf.verifier != nil {
+			if err := hf.verifier.VerifySign(block); err != nil {
+				logger.Error().Err(err).Uint64("no", header.BlockNo).Msg("invalid signature of header")
+				return nil, ErrInvalidHeaders
+			}
+		}
+		hashes[i] = block.BlockHash()
+		prevHash = hashes[i]
+		parent = block
+	}
+	return hashes, nil
 }
 
 func (hf *HashFetcher) processHashSet(hashSet *HashSet) error {
@@ -215,6 +313,7 @@ func (hf *HashFetcher) stop() {
 		logger.Info().Msg("HashFetcher close quitCh")
 
 		close(hf.responseCh)
+		close(hf.hashesCh)
 
 		hf.waitGroup.Wait()
 		hf.isRunning = false
@@ -222,7 +321,57 @@ func (hf *HashFetcher) stop() {
 	logger.Info().Msg("HashFetcher stopped")
 }
 
-func (hf *HashFetcher) isValidResponse(msg *message.GetHashesRsp) (bool, error) {
+func (hf *HashFetcher) isValidResponse(msg *message.GetSyncHeadersRsp) (bool, error) {
+	isValid := true
+	var err error
+
+	if msg == nil {
+		panic("nil message error")
+	}
+
+	if msg.Err != nil {
+		logger.Error().Err(msg.Err).Msg("receive GetSyncHeadersRsp with error")
+		err = msg.Err
+		isValid = false
+	}
+
+	if hf.lastBlockInfo.No+1 != msg.StartNo || hf.reqCount != uint64(len(msg.Headers)) {
+		isValid = false
+	}
+
+	if !isValid {
+		logger.Error().Uint64("req start", hf.lastBlockInfo.No+1).
+			Uint64("msg start", msg.StartNo).
+			Uint64("req count", hf.reqCount).
+			Int("msg count", len(msg.Headers)).
+			Msg("invalid GetSyncHeadersRsp")
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (hf *HashFetcher) GetHeadersRsp(msg *message.GetSyncHeadersRsp) {
+	if hf == nil {
+		return
+	}
+
+	count := len(msg.Headers)
+
+	if count == 0 && msg.Err == nil {
+		logger.Error().Int("count", count).
+			Uint64("start", msg.StartNo).Msg("receive empty GetSyncHeadersRsp")
+		return
+	}
+
+	logger.Debug().Int("count", count).
+		Uint64("start", msg.StartNo).Err(msg.Err).Msg("receive GetSyncHeadersRsp")
+
+	hf.responseCh <- msg
+	return
+}
+
+func (hf *HashFetcher) isValidHashesResponse(msg *message.GetHashesRsp) (bool, error) {
 	isValid := true
 	var err error
 
@@ -270,6 +419,6 @@ func (hf *HashFetcher) GetHahsesRsp(msg *message.GetHashesRsp) {
 		Str("start", enc.ToString(msg.Hashes[0])).
 		Str("end", enc.ToString(msg.Hashes[count-1])).Msg("receive GetHashesRsp")
 
-	hf.responseCh <- msg
+	hf.hashesCh <- msg
 	return
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aergoio/aergo/message"
 	"github.com/aergoio/aergo/types"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestHashFetcher_normal(t *testing.T) {
@@ -46,6 +47,45 @@ func TestHashFetcher_normal(t *testing.T) {
 	syncer.waitStop()
 }
 
+// test if hashfetcher fetches hashes from an old peer which does not serve headers
+func TestHashFetcher_noSyncHeaders(t *testing.T) {
+	remoteChainLen := 100
+	localChainLen := 99
+	targetNo := uint64(99)
+
+	//ancestor = 0
+	remoteChain := chain.InitStubBlockChain(nil, remoteChainLen)
+	localChain := chain.InitStubBlockChain(remoteChain.Blocks[0:1], localChainLen)
+
+	remoteChains := []*chain.StubBlockChain{remoteChain}
+	peers := makeStubPeerSet(remoteChains)
+
+	//set debug property
+	testCfg := *SyncerCfg
+	testCfg.maxHashReqSize = TestMaxHashReqSize
+	testCfg.maxBlockReqSize = TestMaxBlockFetchSize
+	testCfg.debugContext = &SyncerDebug{t: t, expAncestor: 0}
+	testCfg.debugContext.debugHashFetcher = true
+	testCfg.debugContext.targetNo = targetNo
+
+	//set ctx because finder is skipped
+	ctx := types.NewSyncCtx(1, "peer-0", targetNo, uint64(localChain.Best), nil)
+	ancestorInfo := remoteChain.GetBlockInfo(0)
+
+	syncer := NewTestSyncer(t, localChain, remoteChain, peers, &testCfg)
+	syncer.noSyncHeaders = true
+	syncer.realSyncer.ctx = ctx
+	syncer.realSyncer.Seq = 1
+	seq := syncer.realSyncer.GetSeq()
+
+	syncer.start()
+
+	//ancestor of ctx will be set by FinderResult
+	syncer.stubRequester.TellTo(message.SyncerSvc, &message.FinderResult{Seq: seq, Ancestor: ancestorInfo, Err: nil})
+
+	syncer.waitStop()
+}
+
 //test if hashfetcher stops successfully while waiting to send HashSet to resultCh
 func TestHashFetcher_quit(t *testing.T) {
 	remoteChainLen := 100
@@ -114,3 +154,47 @@ func TestHashFetcher_ResponseError(t *testing.T) {
 		assert.True(t, syncer.isStop, "hashfetcher finished")
 	*/
 }
+
+type testVerifier struct {
+	badNo types.BlockNo
+}
+
+func (v *testVerifier) VerifySign(block *types.Block) error {
+	if block.BlockNo() == v.badNo {
+		return ErrInvalidHeaders
+	}
+	return nil
+}
+
+func TestHashFetcher_verifyHeaders(t *testing.T) {
+	remoteChain := chain.InitStubBlockChain(nil, 10)
+
+	ctx := types.NewSyncCtx(1, "peer-0", 10, 0, nil)
+	ctx.SetAncestor(remoteChain.Blocks[0])
+	hf := newHashFetcher(ctx, nil, nil, &testVerifier{}, SyncerCfg)
+
+	headers, _ := remoteChain.GetHeaders(1, 5)
+	hashes, err := hf.verifyHeaders(headers)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(hashes))
+	assert.Equal(t, remoteChain.Hashes[5], []byte(hashes[4]))
+
+	// headers not linked to the ancestor
+	headers, _ = remoteChain.GetHeaders(2, 5)
+	_, err = hf.verifyHeaders(headers)
+	assert.Equal(t, ErrInvalidHeaders, err)
+
+	// header not the child of its predecessor
+	headers, _ = remoteChain.GetHeaders(1, 5)
+	forked := *headers[4]
+	forked.ChainID = []byte("other chain")
+	headers[4] = &forked
+	_, err = hf.verifyHeaders(headers)
+	assert.Equal(t, ErrInvalidHeaders, err)
+
+	// bad signature
+	hf.verifier = &testVerifier{badNo: 3}
+	headers, _ = remoteChain.GetHeaders(1, 5)
+	_, err = hf.verifyHeaders(headers)
+	assert.Equal(t, ErrInvalidHeaders, err)
+}
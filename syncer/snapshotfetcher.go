@@ -0,0 +1,425 @@
+package syncer
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/aergoio/aergo/contract"
+	"github.com/aergoio/aergo/internal/common"
+	"github.com/aergoio/aergo/internal/enc"
+	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/pkg/component"
+	"github.com/aergoio/aergo/state"
+	"github.com/aergoio/aergo/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+const (
+	DfltStateRangeSize      = 1024
+	DfltStateDataSize       = 100
+	DfltContractDBChunkSize = 1 << 20
+)
+
+var (
+	ErrSnapshotQuit         = errors.New("snapshot fetcher quit")
+	ErrSnapshotTimeout      = errors.New("snapshot fetcher timeout")
+	ErrSnapshotInvalidBlock = errors.New("invalid pivot block of snapshot")
+	ErrSnapshotInvalidData  = errors.New("invalid state data of snapshot")
+)
+
+// snapshotChain is implemented by the chain service, whose state db the
+// snapshot is imported into.
+type snapshotChain interface {
+	SDB() *state.ChainStateDB
+}
+
+// SnapshotFetcher downloads the state of a pivot block near the target from
+// the remote peer instead of executing all the blocks before it. The blocks up
+// to the pivot are downloaded and stored without execution, after the chain
+// service checks that they are linked from the genesis block and signed by
+// the BPs of their periods. The state tries are verified against the blocks
+// root hash of the pivot, the codes against their hashes, and the sql
+// databases of contracts against the hashes saved to the contract states.
+//
+// After the pivot is imported, it is reported to syncer as the common
+// ancestor, and the rest of the blocks are fetched and executed as usual.
+type SnapshotFetcher struct {
+	compRequester component.IComponentRequester //for communicate with other service
+	sdb           *state.ChainStateDB
+
+	ctx     *types.SyncContext
+	pivotNo types.BlockNo
+
+	maxHeaderReq uint64
+	maxBlockReq  int
+
+	rspCh  chan interface{}
+	quitCh chan interface{}
+
+	dfltTimeout time.Duration
+
+	isRunning bool
+	waitGroup *sync.WaitGroup
+}
+
+func newSnapshotFetcher(ctx *types.SyncContext, compRequester component.IComponentRequester, sdb *state.ChainStateDB, pivotNo types.BlockNo, cfg *SyncerConfig) *SnapshotFetcher {
+	sf := &SnapshotFetcher{ctx: ctx, compRequester: compRequester, sdb: sdb, pivotNo: pivotNo}
+
+	sf.maxHeaderReq = cfg.maxHashReqSize
+	sf.maxBlockReq = cfg.maxBlockReqSize
+
+	sf.dfltTimeout = cfg.fetchTimeOut
+	sf.rspCh = make(chan interface{}, 1)
+	sf.quitCh = make(chan interface{})
+
+	return sf
+}
+
+func (sf *SnapshotFetcher) start() {
+	sf.waitGroup = &sync.WaitGroup{}
+	sf.waitGroup.Add(1)
+	sf.isRunning = true
+
+	run := func() {
+		defer RecoverSyncer(NameSnapshotFetcher, sf.GetSeq(), sf.compRequester, func() { sf.waitGroup.Done() })
+
+		logger.Info().Uint64("pivot", sf.pivotNo).Msg("start to fetch snapshot")
+
+		pivot, err := sf.fetch()
+		if err != nil {
+			logger.Error().Err(err).Msg("quit snapshot fetcher")
+			stopSyncer(sf.compRequester, sf.GetSeq(), NameSnapshotFetcher, err)
+			return
+		}
+
+		sf.compRequester.TellTo(message.SyncerSvc, &message.FinderResult{Seq: sf.GetSeq(), Ancestor: &types.BlockInfo{Hash: pivot.BlockHash(), No: pivot.BlockNo()}})
+		logger.Info().Msg("stopped snapshot fetcher successfully")
+	}
+
+	go run()
+}
+
+func (sf *SnapshotFetcher) stop() {
+	if sf == nil {
+		return
+	}
+
+	if sf.isRunning {
+		close(sf.quitCh)
+		sf.isRunning = false
+	}
+
+	sf.waitGroup.Wait()
+
+	logger.Info().Msg("snapshot fetcher stopped")
+}
+
+func (sf *SnapshotFetcher) GetSeq() uint64 {
+	return sf.ctx.Seq
+}
+
+// handleRsp passes a response of the remote peer to the running request.
+func (sf *SnapshotFetcher) handleRsp(msg interface{}) {
+	select {
+	case sf.rspCh <- msg:
+	default:
+		logger.Debug().Msgf("snapshot fetcher dropped response(%T)", msg)
+	}
+}
+
+// contractDB is a contract whose sql database is copied.
+type contractDB struct {
+	id    []byte
+	state *types.State
+}
+
+func (sf *SnapshotFetcher) fetch() (*types.Block, error) {
+	pivot, err := sf.fetchBlocks()
+	if err != nil {
+		return nil, err
+	}
+	root := pivot.GetHeader().GetBlocksRootHash()
+
+	var (
+		codes    [][]byte
+		storages [][]byte
+		dbs      []contractDB
+		seen     = make(map[types.HashID]bool)
+	)
+	err = sf.importTrie(root, func(keys, data [][]byte) error {
+		for i, raw := range data {
+			st := &types.State{}
+			if err := proto.Unmarshal(raw, st); err != nil {
+				return ErrSnapshotInvalidData
+			}
+			if len(st.CodeHash) != 0 && !seen[types.ToHashID(st.CodeHash)] {
+				seen[types.ToHashID(st.CodeHash)] = true
+				codes = append(codes, st.CodeHash)
+			}
+			if len(st.StorageRoot) != 0 {
+				storages = append(storages, st.StorageRoot)
+			}
+			if st.SqlRecoveryPoint != 0 {
+				dbs = append(dbs, contractDB{id: keys[i], state: st})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info().Str("root", enc.ToString(root)).Int("storages", len(storages)).Int("codes", len(codes)).Int("dbs", len(dbs)).Msg("fetched account states of snapshot")
+
+	for _, storageRoot := range storages {
+		if err := sf.importTrie(storageRoot, nil); err != nil {
+			return nil, err
+		}
+	}
+	if err := sf.fetchData(codes); err != nil {
+		return nil, err
+	}
+	for _, db := range dbs {
+		if err := sf.fetchContractDB(db); err != nil {
+			return nil, err
+		}
+	}
+	sf.sdb.MarkState(root)
+
+	result, err := sf.compRequester.RequestToFutureResult(message.ChainSvc, &message.ImportSnapshot{Block: pivot}, sf.dfltTimeout, "SnapshotFetcher/importSnapshot")
+	if err != nil {
+		return nil, err
+	}
+	if err := result.(message.ImportSnapshotRsp).Err; err != nil {
+		return nil, err
+	}
+	return pivot, nil
+}
+
+// fetchBlocks fetches the headers and the blocks up to pivotNo on the chain
+// of the remote peer, and stores the blocks before the pivot by the chain
+// service, which verifies them. It returns the pivot block.
+func (sf *SnapshotFetcher) fetchBlocks() (*types.Block, error) {
+	var (
+		pivot    *types.Block
+		prevHash []byte
+	)
+	for startNo := types.BlockNo(1); startNo <= sf.pivotNo; {
+		count := sf.maxHeaderReq
+		if rest := sf.pivotNo - startNo + 1; rest < count {
+			count = rest
+		}
+		rsp, err := sf.request(&message.GetSyncHeaders{Seq: sf.GetSeq(), ToWhom: sf.ctx.PeerID, StartNo: startNo, Count: count})
+		if err != nil {
+			return nil, err
+		}
+		headerRsp, ok := rsp.(*message.GetSyncHeadersRsp)
+		if !ok || headerRsp.Err != nil || len(headerRsp.Headers) == 0 || uint64(len(headerRsp.Headers)) > count {
+			return nil, ErrSnapshotInvalidBlock
+		}
+
+		hashes := make([]message.BlockHash, len(headerRsp.Headers))
+		for i, header := range headerRsp.Headers {
+			if header.BlockNo != startNo+uint64(i) || (prevHash != nil && !bytes.Equal(header.PrevBlockHash, prevHash)) {
+				return nil, ErrSnapshotInvalidBlock
+			}
+			hashes[i] = (&types.Block{Header: header}).BlockHash()
+			prevHash = hashes[i]
+		}
+
+		for len(hashes) > 0 {
+			req := hashes
+			if len(req) > sf.maxBlockReq {
+				req = req[:sf.maxBlockReq]
+			}
+			blocks, err := sf.fetchBlockBodies(req)
+			if err != nil {
+				return nil, err
+			}
+			if last := blocks[len(blocks)-1]; last.BlockNo() == sf.pivotNo {
+				pivot, blocks = last, blocks[:len(blocks)-1]
+			}
+			if len(blocks) > 0 {
+				result, err := sf.compRequester.RequestToFutureResult(message.ChainSvc, &message.AddSnapshotBlocks{Blocks: blocks}, sf.dfltTimeout, "SnapshotFetcher/addSnapshotBlocks")
+				if err != nil {
+					return nil, err
+				}
+				if err := result.(message.AddSnapshotBlocksRsp).Err; err != nil {
+					return nil, err
+				}
+			}
+			hashes = hashes[len(req):]
+		}
+		startNo += uint64(len(headerRsp.Headers))
+	}
+	if pivot == nil {
+		return nil, ErrSnapshotInvalidBlock
+	}
+	logger.Info().Uint64("no", pivot.BlockNo()).Str("hash", pivot.ID()).Msg("fetched blocks up to snapshot pivot")
+	return pivot, nil
+}
+
+// fetchBlockBodies fetches the blocks of hashes, which are in order.
+func (sf *SnapshotFetcher) fetchBlockBodies(hashes []message.BlockHash) ([]*types.Block, error) {
+	rsp, err := sf.request(&message.GetBlockChunks{Seq: sf.GetSeq(),
+		GetBlockInfos: message.GetBlockInfos{ToWhom: sf.ctx.PeerID, Hashes: hashes}, TTL: sf.dfltTimeout})
+	if err != nil {
+		return nil, err
+	}
+	blockRsp, ok := rsp.(*message.GetBlockChunksRsp)
+	if !ok || blockRsp.Err != nil || len(blockRsp.Blocks) != len(hashes) {
+		return nil, ErrSnapshotInvalidBlock
+	}
+	for i, block := range blockRsp.Blocks {
+		if !bytes.Equal(block.BlockHash(), hashes[i]) {
+			return nil, ErrSnapshotInvalidBlock
+		}
+	}
+	return blockRsp.Blocks, nil
+}
+
+// importTrie rebuilds the trie of root from its leaves. onLeaves is called
+// with each range of leaves after they are imported.
+func (sf *SnapshotFetcher) importTrie(root []byte, onLeaves func(keys, data [][]byte) error) error {
+	importer := sf.sdb.NewStateImporter(root)
+
+	var start []byte
+	for {
+		rsp, err := sf.request(&message.GetSyncStateRange{Seq: sf.GetSeq(), ToWhom: sf.ctx.PeerID, Root: root, Start: start, Limit: DfltStateRangeSize})
+		if err != nil {
+			return err
+		}
+		rangeRsp, ok := rsp.(*message.GetSyncStateRangeRsp)
+		if !ok {
+			return ErrSnapshotInvalidData
+		} else if rangeRsp.Err != nil {
+			return rangeRsp.Err
+		}
+		if err := importer.Import(rangeRsp.Keys, rangeRsp.Values, rangeRsp.Data); err != nil {
+			return err
+		}
+		if onLeaves != nil {
+			if err := onLeaves(rangeRsp.Keys, rangeRsp.Data); err != nil {
+				return err
+			}
+		}
+		if !rangeRsp.HasNext {
+			break
+		}
+		if len(rangeRsp.Keys) == 0 {
+			return ErrSnapshotInvalidData
+		}
+		start = nextStateKey(rangeRsp.Keys[len(rangeRsp.Keys)-1])
+	}
+	return importer.Finish()
+}
+
+// fetchData fetches the data blobs of hashes, which are contract codes.
+func (sf *SnapshotFetcher) fetchData(hashes [][]byte) error {
+	for len(hashes) > 0 {
+		req := hashes
+		if len(req) > DfltStateDataSize {
+			req = req[:DfltStateDataSize]
+		}
+		rsp, err := sf.request(&message.GetSyncStateData{Seq: sf.GetSeq(), ToWhom: sf.ctx.PeerID, Hashes: req})
+		if err != nil {
+			return err
+		}
+		dataRsp, ok := rsp.(*message.GetSyncStateDataRsp)
+		if !ok {
+			return ErrSnapshotInvalidData
+		} else if dataRsp.Err != nil {
+			return dataRsp.Err
+		}
+		// the response may be cut at its size limit
+		if len(dataRsp.Data) == 0 || len(dataRsp.Data) > len(req) {
+			return ErrSnapshotInvalidData
+		}
+		for i, d := range dataRsp.Data {
+			if !bytes.Equal(common.Hasher(d), req[i]) {
+				return ErrSnapshotInvalidData
+			}
+		}
+		sf.sdb.PutStateData(dataRsp.Data)
+		hashes = hashes[len(dataRsp.Data):]
+	}
+	return nil
+}
+
+// fetchContractDB copies the sql database file of the contract of db by
+// chunks, and verifies it against the hash saved to the contract state.
+func (sf *SnapshotFetcher) fetchContractDB(db contractDB) error {
+	aid := types.AccountID(types.ToHashID(db.id))
+	dbName := aid.String()
+	rp := db.state.SqlRecoveryPoint
+
+	var offset uint64
+	for {
+		rsp, err := sf.request(&message.GetSyncContractDB{Seq: sf.GetSeq(), ToWhom: sf.ctx.PeerID, AccountID: db.id, RecoveryPoint: rp, Offset: offset, Size: DfltContractDBChunkSize})
+		if err != nil {
+			return err
+		}
+		dbRsp, ok := rsp.(*message.GetSyncContractDBRsp)
+		if !ok {
+			return ErrSnapshotInvalidData
+		} else if dbRsp.Err != nil {
+			return dbRsp.Err
+		}
+		if err := contract.WriteDatabaseFile(dbName, offset, dbRsp.Data); err != nil {
+			return err
+		}
+		offset += uint64(len(dbRsp.Data))
+		if offset >= dbRsp.Total {
+			break
+		}
+		if len(dbRsp.Data) == 0 {
+			return ErrSnapshotInvalidData
+		}
+	}
+
+	contractState, err := sf.sdb.GetStateDB().OpenContractState(aid, db.state)
+	if err != nil {
+		return err
+	}
+	if err := contract.VerifyDatabase(dbName, contractState); err != nil {
+		logger.Error().Err(err).Str("db", dbName).Uint64("rp", rp).Msg("invalid contract database of snapshot")
+		return ErrSnapshotInvalidData
+	}
+	return nil
+}
+
+// request sends req to p2p service and waits its response.
+func (sf *SnapshotFetcher) request(req interface{}) (interface{}, error) {
+	// drop a late response of the previous request
+	select {
+	case <-sf.rspCh:
+	default:
+	}
+
+	sf.compRequester.TellTo(message.P2PSvc, req)
+
+	timer := time.NewTimer(sf.dfltTimeout)
+	defer timer.Stop()
+
+	select {
+	case rsp := <-sf.rspCh:
+		return rsp, nil
+	case <-timer.C:
+		logger.Error().Float64("sec", sf.dfltTimeout.Seconds()).Msgf("snapshot fetcher %T timeout", req)
+		return nil, ErrSnapshotTimeout
+	case <-sf.quitCh:
+		return nil, ErrSnapshotQuit
+	}
+}
+
+// nextStateKey returns the smallest trie key after key.
+func nextStateKey(key []byte) []byte {
+	next := append([]byte{}, key...)
+	for i := len(next) - 1; i >= 0; i-- {
+		if next[i]++; next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
@@ -33,6 +33,9 @@ type StubSyncer struct {
 
 	cfg *SyncerConfig
 
+	//remote peer is an old node which does not serve block headers
+	noSyncHeaders bool
+
 	checkResultFn         TestResultFn
 	getAnchorsHookFn      GetAnchorsHookFn
 	getSyncAncestorHookFn GetSyncAncestorHookFn
@@ -100,6 +103,8 @@ func isOtherActorRequest(msg interface{}) bool {
 		return true
 	case *message.GetHashByNo:
 		return true
+	case *message.GetSyncHeaders:
+		return true
 	case *message.GetHashes:
 		return true
 	case *message.GetPeers:
@@ -184,6 +189,13 @@ func (stubSyncer *StubSyncer) handleActorMsg(inmsg interface{}) {
 	case *message.GetHashByNo:
 		stubSyncer.GetHashByNo(msg)
 
+	case *message.GetSyncHeaders:
+		if stubSyncer.noSyncHeaders {
+			stubSyncer.stubRequester.TellTo(message.SyncerSvc, &message.GetSyncHeadersRsp{Seq: msg.Seq, StartNo: msg.StartNo, Err: message.UnsupportedError})
+			break
+		}
+		stubSyncer.GetSyncHeaders(msg, nil)
+
 	case *message.GetHashes:
 		stubSyncer.GetHashes(msg, nil)
 
@@ -254,6 +266,15 @@ func (syncer *StubSyncer) GetHashByNo(msg *message.GetHashByNo) {
 	rsp := &message.GetHashByNoRsp{Seq: msg.Seq, BlockHash: hash, Err: err}
 	syncer.stubRequester.TellTo(message.SyncerSvc, rsp)
 }
+func (syncer *StubSyncer) GetSyncHeaders(msg *message.GetSyncHeaders, responseErr error) {
+	headers, _ := syncer.remoteChain.GetHeaders(msg.StartNo, msg.Count)
+
+	assert.Equal(syncer.t, len(headers), int(msg.Count))
+	rsp := &message.GetSyncHeadersRsp{Seq: msg.Seq, StartNo: msg.StartNo, Headers: headers, Err: responseErr}
+
+	syncer.stubRequester.TellTo(message.SyncerSvc, rsp)
+}
+
 func (syncer *StubSyncer) GetHashes(msg *message.GetHashes, responseErr error) {
 	blkHashes, _ := syncer.remoteChain.GetHashes(msg.PrevInfo, msg.Count)
 
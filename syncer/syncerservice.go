@@ -16,6 +16,7 @@ import (
 
 	"github.com/aergoio/aergo-actor/actor"
 	"github.com/aergoio/aergo/message"
+	"github.com/aergoio/aergo/state"
 	"github.com/aergoio/aergo/types"
 	"github.com/pkg/errors"
 )
@@ -31,9 +32,10 @@ type Syncer struct {
 	isRunning bool
 	ctx       *types.SyncContext
 
-	finder       *Finder
-	hashFetcher  *HashFetcher
-	blockFetcher *BlockFetcher
+	finder          *Finder
+	snapshotFetcher *SnapshotFetcher
+	hashFetcher     *HashFetcher
+	blockFetcher    *BlockFetcher
 
 	compRequester component.IComponentRequester //for test
 }
@@ -66,12 +68,13 @@ type SyncerDebug struct {
 }
 
 var (
-	logger             = log.NewLogger("syncer")
-	NameFinder         = "Finder"
-	NameHashFetcher    = "HashFetcher"
-	NameBlockFetcher   = "BlockFetcher"
-	NameBlockProcessor = "BlockProcessor"
-	SyncerCfg          = &SyncerConfig{
+	logger              = log.NewLogger("syncer")
+	NameFinder          = "Finder"
+	NameSnapshotFetcher = "SnapshotFetcher"
+	NameHashFetcher     = "HashFetcher"
+	NameBlockFetcher    = "BlockFetcher"
+	NameBlockProcessor  = "BlockProcessor"
+	SyncerCfg           = &SyncerConfig{
 		maxHashReqSize:   DfltHashReqSize,
 		maxBlockReqSize:  DfltBlockFetchSize,
 		maxPendingConn:   MaxBlockPendingTasks,
@@ -132,10 +135,12 @@ func (syncer *Syncer) Reset(err error) {
 		logger.Info().Uint64("targetNo", syncer.ctx.TargetNo).Msg("syncer stop#1")
 
 		syncer.finder.stop()
+		syncer.snapshotFetcher.stop()
 		syncer.hashFetcher.stop()
 		syncer.blockFetcher.stop()
 
 		syncer.finder = nil
+		syncer.snapshotFetcher = nil
 		syncer.hashFetcher = nil
 		syncer.blockFetcher = nil
 		syncer.isRunning = false
@@ -191,10 +196,14 @@ func (syncer *Syncer) Receive(context actor.Context) {
 		switch context.Message().(type) {
 		case *message.GetSyncAncestorRsp,
 			*message.FinderResult,
+			*message.GetSyncHeadersRsp,
 			*message.GetHashesRsp,
 			*message.GetHashByNoRsp,
 			*message.GetBlockChunks,
 			*message.GetBlockChunksRsp,
+			*message.GetSyncStateRangeRsp,
+			*message.GetSyncStateDataRsp,
+			*message.GetSyncContractDBRsp,
 			*message.AddBlockRsp,
 			*message.SyncStop,
 			*message.CloseFetcher:
@@ -223,6 +232,9 @@ func (syncer *Syncer) verifySeq(inmsg interface{}) bool {
 	case *message.FinderResult:
 		seq = msg.Seq
 		match = isMatch(seq)
+	case *message.GetSyncHeadersRsp:
+		seq = msg.Seq
+		match = isMatch(seq)
 	case *message.GetHashesRsp:
 		seq = msg.Seq
 		match = isMatch(seq)
@@ -232,6 +244,15 @@ func (syncer *Syncer) verifySeq(inmsg interface{}) bool {
 	case *message.GetBlockChunksRsp:
 		seq = msg.Seq
 		match = isMatch(seq)
+	case *message.GetSyncStateRangeRsp:
+		seq = msg.Seq
+		match = isMatch(seq)
+	case *message.GetSyncStateDataRsp:
+		seq = msg.Seq
+		match = isMatch(seq)
+	case *message.GetSyncContractDBRsp:
+		seq = msg.Seq
+		match = isMatch(seq)
 	case *message.SyncStop:
 		seq = msg.Seq
 		match = isMatch(seq)
@@ -272,15 +293,31 @@ func (syncer *Syncer) handleMessage(inmsg interface{}) {
 			syncer.Reset(err)
 			logger.Error().Err(err).Msg("FinderResult failed")
 		}
+	case *message.GetSyncHeadersRsp:
+		if syncer.snapshotFetcher != nil {
+			syncer.snapshotFetcher.handleRsp(msg)
+			break
+		}
+		syncer.hashFetcher.GetHeadersRsp(msg)
 	case *message.GetHashesRsp:
 		syncer.hashFetcher.GetHahsesRsp(msg)
 
 	case *message.GetBlockChunksRsp:
+		if syncer.snapshotFetcher != nil {
+			syncer.snapshotFetcher.handleRsp(msg)
+			break
+		}
 		err := syncer.blockFetcher.handleBlockRsp(msg)
 		if err != nil {
 			syncer.Reset(err)
 			logger.Error().Err(err).Msg("GetBlockChunksRsp failed")
 		}
+	case *message.GetSyncStateRangeRsp, *message.GetSyncStateDataRsp, *message.GetSyncContractDBRsp:
+		if syncer.snapshotFetcher == nil {
+			logger.Debug().Msg("snapshot fetcher already stopped. so drop unexpected response message")
+			break
+		}
+		syncer.snapshotFetcher.handleRsp(msg)
 	case *message.AddBlockRsp:
 		err := syncer.blockFetcher.handleBlockRsp(msg)
 		if err != nil {
@@ -348,12 +385,36 @@ func (syncer *Syncer) handleSyncStart(msg *message.SyncStart) error {
 	syncer.ctx = types.NewSyncCtx(syncer.GetSeq(), msg.PeerID, msg.TargetNo, bestBlockNo, msg.NotifyC)
 	syncer.isRunning = true
 
+	if pivotNo, sdb := syncer.snapshotPivot(bestBlockNo, msg.TargetNo); sdb != nil {
+		syncer.snapshotFetcher = newSnapshotFetcher(syncer.ctx, syncer.getCompRequester(), sdb, pivotNo, syncer.syncerCfg)
+		syncer.snapshotFetcher.start()
+		return err
+	}
+
 	syncer.finder = newFinder(syncer.ctx, syncer.getCompRequester(), syncer.chain, syncer.syncerCfg)
 	syncer.finder.start()
 
 	return err
 }
 
+// snapshotPivot returns the pivot block number and the state db to import
+// the snapshot into, if snapshot sync is enabled and the chain has no block
+// but the genesis. Otherwise it returns nil state db.
+func (syncer *Syncer) snapshotPivot(bestNo types.BlockNo, targetNo types.BlockNo) (types.BlockNo, *state.ChainStateDB) {
+	if syncer.cfg == nil || syncer.cfg.Blockchain == nil || !syncer.cfg.Blockchain.SnapshotSync {
+		return 0, nil
+	}
+	pivotDistance := syncer.cfg.Blockchain.SnapshotPivot
+	if bestNo != 0 || targetNo <= pivotDistance {
+		return 0, nil
+	}
+	sc, ok := syncer.chain.(snapshotChain)
+	if !ok {
+		return 0, nil
+	}
+	return targetNo - pivotDistance, sc.SDB()
+}
+
 func (syncer *Syncer) handleAncestorRsp(msg *message.GetSyncAncestorRsp) {
 	var ancestorNo uint64
 
@@ -380,6 +441,11 @@ func (syncer *Syncer) handleAncestorRsp(msg *message.GetSyncAncestorRsp) {
 func (syncer *Syncer) handleGetHashByNoRsp(msg *message.GetHashByNoRsp) {
 	logger.Debug().Msg("syncer received gethashbyno response")
 
+	if syncer.snapshotFetcher != nil {
+		syncer.snapshotFetcher.handleRsp(msg)
+		return
+	}
+
 	//set ancestor in types.SyncContext
 	syncer.finder.GetHashByNoRsp(msg)
 }
@@ -408,13 +474,16 @@ func (syncer *Syncer) handleFinderResult(msg *message.FinderResult) error {
 
 	syncer.finder.stop()
 	syncer.finder = nil
+	syncer.snapshotFetcher.stop()
+	syncer.snapshotFetcher = nil
 
 	if syncer.syncerCfg.debugContext != nil && syncer.syncerCfg.debugContext.debugFinder {
 		return nil
 	}
 
 	syncer.blockFetcher = newBlockFetcher(syncer.ctx, syncer.getCompRequester(), syncer.syncerCfg)
-	syncer.hashFetcher = newHashFetcher(syncer.ctx, syncer.getCompRequester(), syncer.blockFetcher.hfCh, syncer.syncerCfg)
+	verifier, _ := syncer.chain.(blockVerifier)
+	syncer.hashFetcher = newHashFetcher(syncer.ctx, syncer.getCompRequester(), syncer.blockFetcher.hfCh, verifier, syncer.syncerCfg)
 
 	syncer.blockFetcher.Start()
 	syncer.hashFetcher.Start()
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -11,6 +12,11 @@ import (
 
 const AddressLength = 33
 const NameLength = 12
+
+// NameMaxLength is the maximum length of a name including the labels of its
+// subdomains. It is shorter than AddressLength, so that a name is never taken
+// for a raw address.
+const NameMaxLength = 32
 const EncodedAddressLength = 52
 
 //NewAccount alloc new account object
@@ -56,6 +62,23 @@ func EncodeAddress(addr Address) string {
 
 const allowed = "abcdefghijklmnopqrstuvwxyz1234567890."
 
+// IsNameAddress returns true if addr is a name, such as a registered name, its
+// subdomain or the name of a governance contract, rather than a raw address.
+func IsNameAddress(addr Address) bool {
+	return len(addr) <= NameLength ||
+		(len(addr) != AddressLength && bytes.IndexByte(addr, '.') >= 0)
+}
+
+// IsGovernanceName returns true if name is the account of a governance
+// contract, which is not registered in aergo.name.
+func IsGovernanceName(name []byte) bool {
+	switch string(name) {
+	case AergoSystem, AergoName, AergoEnterprise:
+		return true
+	}
+	return false
+}
+
 func DecodeAddress(encodedAddr string) (Address, error) {
 	if len(encodedAddr) <= NameLength || strings.Contains(encodedAddr, ".") {
 		name := encodedAddr
@@ -28,6 +28,15 @@ const (
 	DefaultMaxBlockSize = 1 << 20
 	DefaultTxVerifyTime = time.Microsecond * 200
 	DefaultEvictPeriod  = 12
+	// DefaultPriceBump is the minimum gas price increase (in percent) for a tx
+	// to replace a pending tx with the same nonce in the mempool.
+	DefaultPriceBump = 10
+
+	// default capacity limits of the mempool
+	DefaultMempoolMaxTxs     = 1 << 20
+	DefaultMempoolMaxBytes   = 1 << 29
+	DefaultMempoolAccountTxs = 1 << 10
+	DefaultMempoolMaxOrphans = 1 << 16
 
 	// DefaultMaxHdrSize is the max size of the proto-buf serialized non-body
 	// fields. For the estimation detail, check 'TestBlockHeaderLimit' in
@@ -541,11 +550,11 @@ func (tx *Tx) NeedNameVerify() bool {
 }
 
 func (tx *Tx) HasNameAccount() bool {
-	return len(tx.Body.Account) <= NameLength
+	return IsNameAddress(tx.Body.Account)
 }
 
 func (tx *Tx) HasNameRecipient() bool {
-	return tx.Body.Recipient != nil && len(tx.Body.Recipient) <= NameLength
+	return tx.Body.Recipient != nil && IsNameAddress(tx.Body.Recipient)
 }
 
 func (tx *Tx) Clone() *Tx {
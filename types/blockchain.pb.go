@@ -21,21 +21,24 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type TxType int32
 
 const (
-	TxType_NORMAL     TxType = 0
-	TxType_GOVERNANCE TxType = 1
-	TxType_REDEPLOY   TxType = 2
+	TxType_NORMAL        TxType = 0
+	TxType_GOVERNANCE    TxType = 1
+	TxType_REDEPLOY      TxType = 2
+	TxType_FEEDELEGATION TxType = 3
 )
 
 var TxType_name = map[int32]string{
 	0: "NORMAL",
 	1: "GOVERNANCE",
 	2: "REDEPLOY",
+	3: "FEEDELEGATION",
 }
 
 var TxType_value = map[string]int32{
-	"NORMAL":     0,
-	"GOVERNANCE": 1,
-	"REDEPLOY":   2,
+	"NORMAL":        0,
+	"GOVERNANCE":    1,
+	"REDEPLOY":      2,
+	"FEEDELEGATION": 3,
 }
 
 func (x TxType) String() string {
@@ -544,14 +547,15 @@ func (m *TxInBlock) GetTx() *Tx {
 }
 
 type State struct {
-	Nonce                uint64   `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Balance              []byte   `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	CodeHash             []byte   `protobuf:"bytes,3,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
-	StorageRoot          []byte   `protobuf:"bytes,4,opt,name=storageRoot,proto3" json:"storageRoot,omitempty"`
-	SqlRecoveryPoint     uint64   `protobuf:"varint,5,opt,name=sqlRecoveryPoint,proto3" json:"sqlRecoveryPoint,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Nonce                uint64    `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Balance              []byte    `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	CodeHash             []byte    `protobuf:"bytes,3,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	StorageRoot          []byte    `protobuf:"bytes,4,opt,name=storageRoot,proto3" json:"storageRoot,omitempty"`
+	SqlRecoveryPoint     uint64    `protobuf:"varint,5,opt,name=sqlRecoveryPoint,proto3" json:"sqlRecoveryPoint,omitempty"`
+	Multisig             *Multisig `protobuf:"bytes,6,opt,name=multisig,proto3" json:"multisig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -613,6 +617,13 @@ func (m *State) GetSqlRecoveryPoint() uint64 {
 	return 0
 }
 
+func (m *State) GetMultisig() *Multisig {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
 type AccountProof struct {
 	State                *State   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
 	Inclusion            bool     `protobuf:"varint,2,opt,name=inclusion,proto3" json:"inclusion,omitempty"`
@@ -861,6 +872,9 @@ type Receipt struct {
 	TxIndex              int32    `protobuf:"varint,11,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
 	From                 []byte   `protobuf:"bytes,12,opt,name=from,proto3" json:"from,omitempty"`
 	To                   []byte   `protobuf:"bytes,13,opt,name=to,proto3" json:"to,omitempty"`
+	GasUsed              uint64   `protobuf:"varint,14,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	FeeDelegation        bool     `protobuf:"varint,15,opt,name=feeDelegation,proto3" json:"feeDelegation,omitempty"`
+	PrevCodeHash         []byte   `protobuf:"bytes,16,opt,name=prevCodeHash,proto3" json:"prevCodeHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -981,6 +995,27 @@ func (m *Receipt) GetTo() []byte {
 	return nil
 }
 
+func (m *Receipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *Receipt) GetFeeDelegation() bool {
+	if m != nil {
+		return m.FeeDelegation
+	}
+	return false
+}
+
+func (m *Receipt) GetPrevCodeHash() []byte {
+	if m != nil {
+		return m.PrevCodeHash
+	}
+	return nil
+}
+
 type Event struct {
 	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 	EventName            string   `protobuf:"bytes,2,opt,name=eventName,proto3" json:"eventName,omitempty"`
@@ -1294,6 +1329,9 @@ func (m *ABI) GetStateVariables() []*StateVar {
 type Query struct {
 	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 	Queryinfo            []byte   `protobuf:"bytes,2,opt,name=queryinfo,proto3" json:"queryinfo,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,3,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,4,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	HasBlockNo           bool     `protobuf:"varint,5,opt,name=hasBlockNo,proto3" json:"hasBlockNo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1337,11 +1375,35 @@ func (m *Query) GetQueryinfo() []byte {
 	return nil
 }
 
+func (m *Query) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *Query) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *Query) GetHasBlockNo() bool {
+	if m != nil {
+		return m.HasBlockNo
+	}
+	return false
+}
+
 type StateQuery struct {
 	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 	Root                 []byte   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
 	Compressed           bool     `protobuf:"varint,4,opt,name=compressed,proto3" json:"compressed,omitempty"`
 	StorageKeys          [][]byte `protobuf:"bytes,5,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,6,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,7,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	HasBlockNo           bool     `protobuf:"varint,8,opt,name=hasBlockNo,proto3" json:"hasBlockNo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1399,6 +1461,27 @@ func (m *StateQuery) GetStorageKeys() [][]byte {
 	return nil
 }
 
+func (m *StateQuery) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *StateQuery) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *StateQuery) GetHasBlockNo() bool {
+	if m != nil {
+		return m.HasBlockNo
+	}
+	return false
+}
+
 type FilterInfo struct {
 	ContractAddress      []byte   `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
 	EventName            string   `protobuf:"bytes,2,opt,name=eventName,proto3" json:"eventName,omitempty"`
@@ -1485,6 +1568,223 @@ func (m *FilterInfo) GetRecentBlockCnt() int32 {
 	return 0
 }
 
+type Multisig struct {
+	Threshold            uint32   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Keys                 [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Multisig) Reset()         { *m = Multisig{} }
+func (m *Multisig) String() string { return proto.CompactTextString(m) }
+func (*Multisig) ProtoMessage()    {}
+func (*Multisig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{21}
+}
+func (m *Multisig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Multisig.Unmarshal(m, b)
+}
+func (m *Multisig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Multisig.Marshal(b, m, deterministic)
+}
+func (m *Multisig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Multisig.Merge(m, src)
+}
+func (m *Multisig) XXX_Size() int {
+	return xxx_messageInfo_Multisig.Size(m)
+}
+func (m *Multisig) XXX_DiscardUnknown() {
+	xxx_messageInfo_Multisig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Multisig proto.InternalMessageInfo
+
+func (m *Multisig) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Multisig) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type MultisigSign struct {
+	Multisig             *Multisig `protobuf:"bytes,1,opt,name=multisig,proto3" json:"multisig,omitempty"`
+	Signs                [][]byte  `protobuf:"bytes,2,rep,name=signs,proto3" json:"signs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *MultisigSign) Reset()         { *m = MultisigSign{} }
+func (m *MultisigSign) String() string { return proto.CompactTextString(m) }
+func (*MultisigSign) ProtoMessage()    {}
+func (*MultisigSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{22}
+}
+func (m *MultisigSign) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultisigSign.Unmarshal(m, b)
+}
+func (m *MultisigSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultisigSign.Marshal(b, m, deterministic)
+}
+func (m *MultisigSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigSign.Merge(m, src)
+}
+func (m *MultisigSign) XXX_Size() int {
+	return xxx_messageInfo_MultisigSign.Size(m)
+}
+func (m *MultisigSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigSign proto.InternalMessageInfo
+
+func (m *MultisigSign) GetMultisig() *Multisig {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
+func (m *MultisigSign) GetSigns() [][]byte {
+	if m != nil {
+		return m.Signs
+	}
+	return nil
+}
+
+type ReceiptProof struct {
+	Receipt              *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Bloom                []byte   `protobuf:"bytes,2,opt,name=bloom,proto3" json:"bloom,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,4,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	Index                uint32   `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	AuditPath            [][]byte `protobuf:"bytes,6,rep,name=auditPath,proto3" json:"auditPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptProof) Reset()         { *m = ReceiptProof{} }
+func (m *ReceiptProof) String() string { return proto.CompactTextString(m) }
+func (*ReceiptProof) ProtoMessage()    {}
+func (*ReceiptProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{23}
+}
+func (m *ReceiptProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptProof.Unmarshal(m, b)
+}
+func (m *ReceiptProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptProof.Marshal(b, m, deterministic)
+}
+func (m *ReceiptProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptProof.Merge(m, src)
+}
+func (m *ReceiptProof) XXX_Size() int {
+	return xxx_messageInfo_ReceiptProof.Size(m)
+}
+func (m *ReceiptProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptProof proto.InternalMessageInfo
+
+func (m *ReceiptProof) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetBloom() []byte {
+	if m != nil {
+		return m.Bloom
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ReceiptProof) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *ReceiptProof) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReceiptProof) GetAuditPath() [][]byte {
+	if m != nil {
+		return m.AuditPath
+	}
+	return nil
+}
+
+type BlockArchive struct {
+	Block *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	// receipts of the block in their binary encoding, with the bloom filter
+	Receipts             []byte   `protobuf:"bytes,2,opt,name=receipts,proto3" json:"receipts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockArchive) Reset()         { *m = BlockArchive{} }
+func (m *BlockArchive) String() string { return proto.CompactTextString(m) }
+func (*BlockArchive) ProtoMessage()    {}
+func (*BlockArchive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9ac6287ce250c9a, []int{24}
+}
+func (m *BlockArchive) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockArchive.Unmarshal(m, b)
+}
+func (m *BlockArchive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockArchive.Marshal(b, m, deterministic)
+}
+func (m *BlockArchive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockArchive.Merge(m, src)
+}
+func (m *BlockArchive) XXX_Size() int {
+	return xxx_messageInfo_BlockArchive.Size(m)
+}
+func (m *BlockArchive) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockArchive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockArchive proto.InternalMessageInfo
+
+func (m *BlockArchive) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlockArchive) GetReceipts() []byte {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Block)(nil), "types.Block")
 	proto.RegisterType((*BlockHeader)(nil), "types.BlockHeader")
@@ -1507,95 +1807,115 @@ func init() {
 	proto.RegisterType((*Query)(nil), "types.Query")
 	proto.RegisterType((*StateQuery)(nil), "types.StateQuery")
 	proto.RegisterType((*FilterInfo)(nil), "types.FilterInfo")
+	proto.RegisterType((*Multisig)(nil), "types.Multisig")
+	proto.RegisterType((*MultisigSign)(nil), "types.MultisigSign")
+	proto.RegisterType((*ReceiptProof)(nil), "types.ReceiptProof")
+	proto.RegisterType((*BlockArchive)(nil), "types.BlockArchive")
 	proto.RegisterEnum("types.TxType", TxType_name, TxType_value)
 }
 
 func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_e9ac6287ce250c9a) }
 
 var fileDescriptor_e9ac6287ce250c9a = []byte{
-	// 1342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x66, 0xec, 0x19, 0xc7, 0xae, 0xfc, 0x79, 0x9b, 0x15, 0x0c, 0xb0, 0x42, 0x66, 0xb4, 0xa0,
-	0x68, 0x05, 0x8b, 0x14, 0x84, 0x00, 0x71, 0xca, 0xee, 0x26, 0xe0, 0xdd, 0x90, 0x84, 0x26, 0x44,
-	0xe2, 0x84, 0xda, 0x33, 0x6d, 0x7b, 0x58, 0xcf, 0xb4, 0x77, 0xa6, 0x6d, 0xc6, 0x07, 0x4e, 0xbc,
-	0x01, 0x37, 0x8e, 0x48, 0x3c, 0x1a, 0x42, 0x5c, 0x10, 0xe2, 0x0d, 0x50, 0x55, 0xf7, 0xfc, 0xd8,
-	0x09, 0xa0, 0x95, 0x38, 0x70, 0xeb, 0xef, 0xeb, 0xea, 0x76, 0x55, 0x7d, 0x55, 0xd5, 0x63, 0xe8,
-	0x8f, 0x66, 0x2a, 0x7c, 0x1a, 0x4e, 0x45, 0x9c, 0xde, 0x9f, 0x67, 0x4a, 0x2b, 0xe6, 0xe9, 0xd5,
-	0x5c, 0xe6, 0x41, 0x02, 0xde, 0x03, 0xdc, 0x62, 0x0c, 0xdc, 0xa9, 0xc8, 0xa7, 0xbe, 0x33, 0x70,
-	0x0e, 0x76, 0x38, 0xad, 0xd9, 0x3d, 0xe8, 0x4c, 0xa5, 0x88, 0x64, 0xe6, 0xb7, 0x06, 0xce, 0xc1,
-	0xf6, 0x21, 0xbb, 0x4f, 0x87, 0xee, 0xd3, 0x89, 0x4f, 0x69, 0x87, 0x5b, 0x0b, 0x76, 0x17, 0xdc,
-	0x91, 0x8a, 0x56, 0x7e, 0x9b, 0x2c, 0xfb, 0x4d, 0xcb, 0x07, 0x2a, 0x5a, 0x71, 0xda, 0x0d, 0x7e,
-	0x6f, 0xc1, 0x76, 0xe3, 0x34, 0xf3, 0x61, 0x8b, 0x9c, 0x1a, 0x3e, 0xb2, 0x3f, 0x5c, 0x42, 0x76,
-	0x17, 0x76, 0xe7, 0x99, 0x5c, 0x1a, 0x63, 0x74, 0xac, 0x45, 0xfb, 0xeb, 0x24, 0x9e, 0xa7, 0xc8,
-	0xce, 0x14, 0xfd, 0xb0, 0xcb, 0x4b, 0xc8, 0xee, 0x40, 0x4f, 0xc7, 0x89, 0xcc, 0xb5, 0x48, 0xe6,
-	0xbe, 0x3b, 0x70, 0x0e, 0xda, 0xbc, 0x26, 0xd8, 0x5b, 0xb0, 0x47, 0x86, 0x39, 0x57, 0x4a, 0xd3,
-	0xf5, 0x1e, 0x5d, 0xbf, 0xc1, 0xb2, 0x01, 0x6c, 0xeb, 0xa2, 0x36, 0xea, 0x90, 0x51, 0x93, 0x62,
-	0xf7, 0xa0, 0x9f, 0xc9, 0x50, 0xc6, 0x73, 0x5d, 0x9b, 0x6d, 0x91, 0xd9, 0x35, 0x9e, 0xbd, 0x0a,
-	0xdd, 0x50, 0xa5, 0xe3, 0x38, 0x4b, 0x72, 0xbf, 0x4b, 0xee, 0x56, 0x98, 0xbd, 0x04, 0x9d, 0xf9,
-	0x62, 0xf4, 0x44, 0xae, 0xfc, 0x1e, 0x9d, 0xb6, 0x88, 0x1d, 0xc0, 0x7e, 0xa8, 0xe2, 0x74, 0x24,
-	0x72, 0x79, 0x14, 0x86, 0x6a, 0x91, 0x6a, 0x1f, 0xc8, 0x60, 0x93, 0x46, 0x05, 0xf3, 0x78, 0x92,
-	0xfa, 0xdb, 0x46, 0x41, 0x5c, 0x07, 0x07, 0xd0, 0xab, 0x24, 0x60, 0xaf, 0x41, 0x5b, 0x17, 0xb9,
-	0xef, 0x0c, 0xda, 0x07, 0xdb, 0x87, 0x3d, 0xab, 0xd0, 0x65, 0xc1, 0x91, 0x0d, 0xde, 0x84, 0xce,
-	0x65, 0x71, 0x1a, 0xe7, 0xfa, 0x9f, 0xcd, 0x3e, 0x86, 0xd6, 0x65, 0x71, 0x63, 0xb1, 0xbc, 0x61,
-	0x0b, 0xc0, 0x94, 0xca, 0x6e, 0x75, 0xae, 0xa1, 0xfe, 0x8f, 0x2d, 0xfc, 0x11, 0xf2, 0xe5, 0x36,
-	0x78, 0xa9, 0x4a, 0x43, 0x49, 0x57, 0xb8, 0xdc, 0x00, 0x94, 0x53, 0xd8, 0x20, 0x8d, 0xdc, 0x25,
-	0x44, 0x39, 0x33, 0x19, 0xc6, 0xf3, 0x58, 0xa6, 0x9a, 0xa4, 0xde, 0xe1, 0x35, 0x81, 0xc9, 0x13,
-	0x09, 0x1d, 0x73, 0x4d, 0xf2, 0x0c, 0xc2, 0xfb, 0xe6, 0x62, 0x35, 0x53, 0x22, 0xb2, 0xfa, 0x96,
-	0x10, 0xa5, 0x98, 0x88, 0xfc, 0x34, 0x4e, 0x62, 0x4d, 0xaa, 0xba, 0xbc, 0xc2, 0x76, 0xef, 0x22,
-	0x8b, 0x43, 0x69, 0xa5, 0xac, 0x30, 0x46, 0x89, 0x81, 0x91, 0x7c, 0x7b, 0x8d, 0x28, 0x2f, 0x57,
-	0x73, 0xc9, 0x69, 0x0b, 0x6b, 0xc6, 0x14, 0x71, 0x44, 0xc5, 0x60, 0xe4, 0x6c, 0x52, 0x95, 0x52,
-	0xd0, 0x50, 0xea, 0x03, 0xf0, 0x2e, 0x8b, 0x61, 0x54, 0x60, 0xa4, 0xa3, 0xaa, 0xe8, 0x4d, 0x82,
-	0x6b, 0x82, 0xf5, 0xa1, 0x1d, 0x47, 0x05, 0x65, 0xc7, 0xe3, 0xb8, 0x0c, 0x1e, 0x43, 0xef, 0xb2,
-	0x18, 0xa6, 0xa6, 0x8b, 0x03, 0xf0, 0x34, 0xde, 0x42, 0x07, 0xb7, 0x0f, 0x77, 0x2a, 0xff, 0x86,
-	0x51, 0xc1, 0xcd, 0x16, 0x7b, 0x05, 0x5a, 0xba, 0xb0, 0x32, 0x35, 0xe4, 0x6d, 0xe9, 0x22, 0xf8,
-	0xc9, 0x01, 0xef, 0x0b, 0x2d, 0xb4, 0xfc, 0x7b, 0x7d, 0x46, 0x62, 0x26, 0x90, 0xb7, 0xfa, 0x58,
-	0x68, 0x4a, 0x3b, 0x92, 0xe4, 0xb4, 0x91, 0xa7, 0xc2, 0x98, 0x90, 0x5c, 0xab, 0x4c, 0x4c, 0x24,
-	0x76, 0x82, 0x95, 0xa8, 0x49, 0x61, 0x13, 0xe5, 0xcf, 0x66, 0x5c, 0x86, 0x6a, 0x29, 0xb3, 0xd5,
-	0x85, 0x8a, 0x53, 0x4d, 0x82, 0xb9, 0xfc, 0x1a, 0x1f, 0xfc, 0xe6, 0xc0, 0x8e, 0x2d, 0xf9, 0x8b,
-	0x4c, 0xa9, 0x31, 0xc6, 0x9c, 0xa3, 0xcf, 0x1b, 0x31, 0x53, 0x1c, 0xdc, 0x6c, 0x61, 0x52, 0xe3,
-	0x34, 0x9c, 0x2d, 0xf2, 0x58, 0xa5, 0xe4, 0x7a, 0x97, 0xd7, 0x04, 0x26, 0xf5, 0xa9, 0x5c, 0x59,
-	0xbf, 0x71, 0x89, 0xe1, 0xcc, 0xf1, 0x72, 0xec, 0x47, 0xe3, 0x6f, 0x85, 0xab, 0xbd, 0x2b, 0x31,
-	0xb3, 0x55, 0x55, 0x61, 0x2c, 0xc4, 0x51, 0xac, 0x13, 0x31, 0xb7, 0xa3, 0xc2, 0x22, 0xe4, 0xa7,
-	0x32, 0x9e, 0x4c, 0x35, 0x15, 0xd4, 0x2e, 0xb7, 0x08, 0xfd, 0x12, 0x8b, 0x28, 0xd6, 0x17, 0x42,
-	0x4f, 0xfd, 0xee, 0xa0, 0x8d, 0x62, 0x57, 0x44, 0xf0, 0x8b, 0x03, 0xfd, 0x87, 0x2a, 0xd5, 0x99,
-	0x08, 0xf5, 0x95, 0xc8, 0x4c, 0xb8, 0xb7, 0xc1, 0x5b, 0x8a, 0xd9, 0x42, 0xda, 0xda, 0x30, 0xe0,
-	0x5f, 0x02, 0xfc, 0x5f, 0x84, 0x53, 0xa6, 0xb9, 0x57, 0xa5, 0xf9, 0xb1, 0xdb, 0x6d, 0xf7, 0xdd,
-	0xe0, 0x7b, 0x07, 0xf6, 0x49, 0xad, 0xcf, 0x17, 0xa8, 0x32, 0x45, 0xf9, 0x11, 0xec, 0x86, 0x36,
-	0x72, 0x22, 0xac, 0xb8, 0x2f, 0x5a, 0x71, 0x9b, 0x05, 0xc0, 0xd7, 0x2d, 0xd9, 0xfb, 0xd0, 0x5b,
-	0xda, 0x64, 0xe5, 0x7e, 0x8b, 0xa6, 0xd8, 0xcb, 0xf6, 0xd8, 0x66, 0x32, 0x79, 0x6d, 0x19, 0xfc,
-	0xd1, 0x82, 0x2d, 0x6e, 0x26, 0xb6, 0x19, 0xba, 0xc6, 0xf4, 0x28, 0x8a, 0x32, 0x99, 0xe7, 0x36,
-	0xdb, 0x9b, 0x34, 0x66, 0x02, 0x2b, 0x6c, 0x91, 0x53, 0xd2, 0x7b, 0xdc, 0x22, 0x8c, 0x35, 0x93,
-	0x66, 0x52, 0xf5, 0x38, 0x2e, 0xd1, 0x52, 0x17, 0xd4, 0x1f, 0x76, 0x46, 0x19, 0x84, 0x3d, 0x35,
-	0x96, 0xf2, 0xcb, 0x5c, 0x56, 0x33, 0xca, 0x42, 0xf6, 0x36, 0xdc, 0x0a, 0x17, 0xc9, 0x62, 0x26,
-	0x74, 0xbc, 0x94, 0x27, 0xd6, 0xc6, 0x08, 0x71, 0x7d, 0x03, 0xeb, 0x62, 0x34, 0x53, 0x2a, 0xb1,
-	0x23, 0xcb, 0x00, 0x76, 0x17, 0x3a, 0x72, 0x29, 0x53, 0x9d, 0x93, 0x1c, 0x75, 0x77, 0x1c, 0x23,
-	0xc9, 0xed, 0x5e, 0xf3, 0x19, 0xed, 0x5d, 0x7b, 0x46, 0xeb, 0x69, 0x04, 0x9b, 0xd3, 0xc8, 0x87,
-	0x2d, 0x5d, 0x0c, 0xd3, 0x48, 0x16, 0xf4, 0xea, 0x78, 0xbc, 0x84, 0x38, 0xe2, 0xc6, 0x99, 0x4a,
-	0xfc, 0x1d, 0x33, 0xe2, 0x70, 0xcd, 0xf6, 0xa0, 0xa5, 0x95, 0xbf, 0x4b, 0x4c, 0x4b, 0xab, 0xe0,
-	0x4f, 0x07, 0x3c, 0xf2, 0xe3, 0x39, 0xf2, 0x7d, 0x07, 0x7a, 0xe4, 0xf3, 0x99, 0x48, 0xa4, 0x4d,
-	0x79, 0x4d, 0x60, 0x2d, 0x7f, 0x93, 0xab, 0xf4, 0x28, 0x9b, 0xe4, 0x36, 0xf5, 0x15, 0xc6, 0x3d,
-	0x32, 0xc4, 0xe9, 0xe8, 0x92, 0xb3, 0x15, 0x6e, 0x68, 0xe3, 0xad, 0x69, 0xb3, 0x16, 0x7d, 0xe7,
-	0x86, 0xe8, 0xcb, 0xac, 0x6d, 0xad, 0x67, 0xad, 0x91, 0x97, 0xee, 0x5a, 0x5e, 0x82, 0x01, 0xc0,
-	0x09, 0xfa, 0xb3, 0x48, 0xa4, 0x79, 0xb2, 0x53, 0x0c, 0xc4, 0x21, 0x5f, 0x69, 0x1d, 0x7c, 0x07,
-	0xdd, 0x93, 0x45, 0x1a, 0x6a, 0xec, 0xdb, 0x1b, 0xf6, 0xd9, 0xbb, 0xd0, 0x13, 0xf6, 0x7c, 0x59,
-	0xde, 0xb7, 0xac, 0xa8, 0xf5, 0xcd, 0xbc, 0xb6, 0xb1, 0x8f, 0xa0, 0x18, 0xcd, 0x24, 0xe5, 0xa4,
-	0xcb, 0x4b, 0x88, 0xd7, 0x2f, 0x63, 0xf9, 0x2d, 0xa5, 0xa3, 0xcb, 0x69, 0x1d, 0x3c, 0x82, 0x2e,
-	0xf5, 0xe2, 0x95, 0xc8, 0x6e, 0xfc, 0x79, 0x66, 0x1f, 0x40, 0x93, 0x7b, 0xf3, 0xe2, 0xf5, 0xa1,
-	0x3d, 0x93, 0x29, 0xdd, 0xee, 0x71, 0x5c, 0x06, 0x3f, 0x3b, 0xd0, 0x3e, 0x7a, 0x30, 0xc4, 0xdf,
-	0x5e, 0xca, 0x8c, 0x86, 0x92, 0xb9, 0xa4, 0x84, 0x28, 0xc7, 0x4c, 0xa4, 0x93, 0x85, 0x98, 0x94,
-	0x77, 0x55, 0x98, 0xbd, 0x03, 0xbd, 0xb1, 0x4d, 0x01, 0xea, 0x88, 0x21, 0xee, 0x97, 0x21, 0x5a,
-	0x9e, 0xd7, 0x16, 0xec, 0x43, 0xd8, 0xa7, 0x29, 0xff, 0xf5, 0x52, 0x64, 0x31, 0x06, 0x96, 0xfb,
-	0xee, 0xda, 0xa1, 0x32, 0x20, 0xbe, 0x97, 0xdb, 0x95, 0x31, 0x0b, 0xce, 0xc1, 0xa3, 0x99, 0xf3,
-	0x7c, 0x05, 0xf8, 0x0c, 0x8f, 0xc4, 0xe9, 0x58, 0xd9, 0x47, 0xb0, 0x26, 0x82, 0x1f, 0x1c, 0x80,
-	0x7a, 0x94, 0x3d, 0xc7, 0xb5, 0x0c, 0xdc, 0x0c, 0x1f, 0x47, 0xf3, 0x06, 0xd1, 0x9a, 0xbd, 0x0e,
-	0x10, 0xaa, 0x64, 0x8e, 0xfb, 0x32, 0xb2, 0x22, 0x35, 0x98, 0xc6, 0xbb, 0xfa, 0x44, 0xae, 0x72,
-	0xdf, 0xa3, 0x79, 0xdb, 0xa4, 0x1e, 0xbb, 0xdd, 0x56, 0xbf, 0x1d, 0xfc, 0xea, 0x00, 0x9c, 0xc4,
-	0x33, 0x2d, 0xb3, 0x61, 0x3a, 0x56, 0xff, 0x59, 0xb3, 0x95, 0xcd, 0x41, 0x7d, 0x6e, 0xbe, 0xbe,
-	0x6b, 0xa2, 0x6a, 0x0e, 0xad, 0xc8, 0xf3, 0xb2, 0x39, 0xb4, 0xc2, 0x50, 0x23, 0x99, 0x87, 0xd4,
-	0x6a, 0x5d, 0x4e, 0x6b, 0x7a, 0x38, 0xb2, 0x89, 0x71, 0xb2, 0x6c, 0xb4, 0x8a, 0xc0, 0xaf, 0x75,
-	0xfc, 0x96, 0x4e, 0x35, 0x7d, 0xe4, 0x3c, 0x4c, 0xcd, 0xb3, 0xe3, 0xf1, 0x0d, 0xf6, 0xde, 0x21,
-	0x7e, 0x5e, 0xe2, 0x97, 0x18, 0x03, 0xe8, 0x9c, 0x9d, 0xf3, 0xcf, 0x8e, 0x4e, 0xfb, 0x2f, 0xb0,
-	0x3d, 0x80, 0x4f, 0xce, 0xaf, 0x8e, 0xf9, 0xd9, 0xd1, 0xd9, 0xc3, 0xe3, 0xbe, 0xc3, 0x76, 0xa0,
-	0xcb, 0x8f, 0x1f, 0x1d, 0x5f, 0x9c, 0x9e, 0x7f, 0xd5, 0x6f, 0x8d, 0x3a, 0xf4, 0x77, 0xe8, 0xbd,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xbc, 0x4c, 0x2e, 0x22, 0x0d, 0x00, 0x00,
+	// 1591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
+	0x11, 0xce, 0x90, 0x33, 0x14, 0x59, 0xa2, 0x24, 0x6e, 0x67, 0x91, 0x4c, 0x92, 0x45, 0xa0, 0x0c,
+	0x36, 0x81, 0xb0, 0x49, 0x36, 0x80, 0x82, 0x20, 0x09, 0x92, 0x0b, 0x25, 0x51, 0x1b, 0xee, 0x6a,
+	0x25, 0x6d, 0xaf, 0x22, 0x20, 0xa7, 0xa0, 0x39, 0xd3, 0x22, 0x27, 0x3b, 0x9c, 0xe6, 0xce, 0x34,
+	0x19, 0xf2, 0xe0, 0x93, 0x9f, 0xc2, 0x77, 0x03, 0x7e, 0x07, 0x3f, 0x84, 0x2f, 0x7e, 0x05, 0xc3,
+	0x30, 0x0c, 0xf8, 0xe0, 0x37, 0x30, 0xaa, 0xba, 0xe7, 0x87, 0x94, 0xac, 0xc5, 0x02, 0x3e, 0xf8,
+	0xd6, 0xf5, 0x75, 0x75, 0x4d, 0x7f, 0xf5, 0x55, 0x57, 0x37, 0x09, 0xbd, 0x51, 0xa2, 0xc2, 0x37,
+	0xe1, 0x44, 0xc4, 0xe9, 0xd3, 0x59, 0xa6, 0xb4, 0x62, 0x9e, 0x5e, 0xcd, 0x64, 0x1e, 0x4c, 0xc1,
+	0x3b, 0xc2, 0x29, 0xc6, 0xc0, 0x9d, 0x88, 0x7c, 0xe2, 0x3b, 0xfb, 0xce, 0x41, 0x97, 0xd3, 0x98,
+	0x3d, 0x81, 0xd6, 0x44, 0x8a, 0x48, 0x66, 0x7e, 0x63, 0xdf, 0x39, 0xd8, 0x3e, 0x64, 0x4f, 0x69,
+	0xd1, 0x53, 0x5a, 0xf1, 0x2f, 0x9a, 0xe1, 0xd6, 0x83, 0x3d, 0x06, 0x77, 0xa4, 0xa2, 0x95, 0xdf,
+	0x24, 0xcf, 0x5e, 0xdd, 0xf3, 0x48, 0x45, 0x2b, 0x4e, 0xb3, 0xc1, 0x37, 0x0d, 0xd8, 0xae, 0xad,
+	0x66, 0x3e, 0x6c, 0xd1, 0xa6, 0x86, 0x27, 0xf6, 0xc3, 0x85, 0xc9, 0x1e, 0xc3, 0xce, 0x2c, 0x93,
+	0x0b, 0xe3, 0x8c, 0x1b, 0x6b, 0xd0, 0xfc, 0x3a, 0x88, 0xeb, 0x89, 0xd9, 0xb9, 0xa2, 0x0f, 0xbb,
+	0xbc, 0x30, 0xd9, 0x23, 0xe8, 0xe8, 0x78, 0x2a, 0x73, 0x2d, 0xa6, 0x33, 0xdf, 0xdd, 0x77, 0x0e,
+	0x9a, 0xbc, 0x02, 0xd8, 0xef, 0x60, 0x97, 0x1c, 0x73, 0xae, 0x94, 0xa6, 0xf0, 0x1e, 0x85, 0xdf,
+	0x40, 0xd9, 0x3e, 0x6c, 0xeb, 0x65, 0xe5, 0xd4, 0x22, 0xa7, 0x3a, 0xc4, 0x9e, 0x40, 0x2f, 0x93,
+	0xa1, 0x8c, 0x67, 0xba, 0x72, 0xdb, 0x22, 0xb7, 0x5b, 0x38, 0xfb, 0x25, 0xb4, 0x43, 0x95, 0xde,
+	0xc4, 0xd9, 0x34, 0xf7, 0xdb, 0xb4, 0xdd, 0xd2, 0x66, 0x3f, 0x83, 0xd6, 0x6c, 0x3e, 0x7a, 0x21,
+	0x57, 0x7e, 0x87, 0x56, 0x5b, 0x8b, 0x1d, 0xc0, 0x5e, 0xa8, 0xe2, 0x74, 0x24, 0x72, 0xd9, 0x0f,
+	0x43, 0x35, 0x4f, 0xb5, 0x0f, 0xe4, 0xb0, 0x09, 0xa3, 0x82, 0x79, 0x3c, 0x4e, 0xfd, 0x6d, 0xa3,
+	0x20, 0x8e, 0x83, 0x03, 0xe8, 0x94, 0x12, 0xb0, 0x5f, 0x41, 0x53, 0x2f, 0x73, 0xdf, 0xd9, 0x6f,
+	0x1e, 0x6c, 0x1f, 0x76, 0xac, 0x42, 0x57, 0x4b, 0x8e, 0x68, 0xf0, 0x5b, 0x68, 0x5d, 0x2d, 0xcf,
+	0xe2, 0x5c, 0xdf, 0xef, 0xf6, 0x0f, 0x68, 0x5c, 0x2d, 0xef, 0x2c, 0x96, 0xdf, 0xd8, 0x02, 0x30,
+	0xa5, 0xb2, 0x53, 0xae, 0xab, 0xa9, 0xff, 0x51, 0x03, 0x5a, 0x06, 0x60, 0x0f, 0xc1, 0x4b, 0x55,
+	0x1a, 0x4a, 0x0a, 0xe1, 0x72, 0x63, 0xa0, 0x9c, 0xc2, 0x92, 0x34, 0x72, 0x17, 0x26, 0xca, 0x99,
+	0xc9, 0x30, 0x9e, 0xc5, 0x32, 0xd5, 0x24, 0x75, 0x97, 0x57, 0x00, 0x26, 0x4f, 0x4c, 0x69, 0x99,
+	0x6b, 0x92, 0x67, 0x2c, 0x8c, 0x37, 0x13, 0xab, 0x44, 0x89, 0xc8, 0xea, 0x5b, 0x98, 0x28, 0xc5,
+	0x58, 0xe4, 0x67, 0xf1, 0x34, 0xd6, 0xa4, 0xaa, 0xcb, 0x4b, 0xdb, 0xce, 0x5d, 0x66, 0x71, 0x28,
+	0xad, 0x94, 0xa5, 0x8d, 0x2c, 0x91, 0x18, 0xc9, 0xb7, 0x5b, 0x63, 0x79, 0xb5, 0x9a, 0x49, 0x4e,
+	0x53, 0x58, 0x33, 0xa6, 0x88, 0x23, 0x2a, 0x06, 0x23, 0x67, 0x1d, 0x2a, 0x95, 0x82, 0x9a, 0x52,
+	0x7f, 0x05, 0xef, 0x6a, 0x39, 0x8c, 0x96, 0xc8, 0x74, 0x54, 0x16, 0xbd, 0x49, 0x70, 0x05, 0xb0,
+	0x1e, 0x34, 0xe3, 0x68, 0x49, 0xd9, 0xf1, 0x38, 0x0e, 0x83, 0xe7, 0xd0, 0xb9, 0x5a, 0x0e, 0x53,
+	0x73, 0x8a, 0x03, 0xf0, 0x34, 0x46, 0xa1, 0x85, 0xdb, 0x87, 0xdd, 0x72, 0x7f, 0xc3, 0x68, 0xc9,
+	0xcd, 0x14, 0xfb, 0x05, 0x34, 0xf4, 0xd2, 0xca, 0x54, 0x93, 0xb7, 0xa1, 0x97, 0xc1, 0x67, 0x0e,
+	0x78, 0xaf, 0xb5, 0xd0, 0xf2, 0xfb, 0xf5, 0x19, 0x89, 0x44, 0x20, 0x6e, 0xf5, 0xb1, 0xa6, 0x29,
+	0xed, 0x48, 0xd2, 0xa6, 0x8d, 0x3c, 0xa5, 0x8d, 0x09, 0xc9, 0xb5, 0xca, 0xc4, 0x58, 0xe2, 0x49,
+	0xb0, 0x12, 0xd5, 0x21, 0x3c, 0x44, 0xf9, 0xdb, 0x84, 0xcb, 0x50, 0x2d, 0x64, 0xb6, 0xba, 0x54,
+	0x71, 0xaa, 0x49, 0x30, 0x97, 0xdf, 0xc2, 0xd9, 0xef, 0xa1, 0x3d, 0x9d, 0x27, 0x3a, 0xce, 0xe3,
+	0x31, 0x29, 0xb7, 0x7d, 0xb8, 0x67, 0x49, 0xbc, 0xb4, 0x30, 0x2f, 0x1d, 0x82, 0xaf, 0x1c, 0xe8,
+	0xda, 0xf3, 0x71, 0x99, 0x29, 0x75, 0x83, 0x09, 0xca, 0x91, 0xe0, 0x46, 0x82, 0x88, 0x34, 0x37,
+	0x53, 0xa8, 0x40, 0x9c, 0x86, 0xc9, 0x3c, 0x8f, 0x55, 0x4a, 0x3c, 0xdb, 0xbc, 0x02, 0x50, 0x81,
+	0x37, 0x72, 0x65, 0x49, 0xe2, 0x10, 0xb9, 0xcf, 0x30, 0x38, 0x1e, 0x5e, 0x43, 0xae, 0xb4, 0xcb,
+	0xb9, 0x6b, 0x91, 0xd8, 0x12, 0x2c, 0x6d, 0xac, 0xda, 0x51, 0xac, 0xa7, 0x62, 0x66, 0xfb, 0x8a,
+	0xb5, 0x10, 0x9f, 0xc8, 0x78, 0x3c, 0xd1, 0x54, 0x7d, 0x3b, 0xdc, 0x5a, 0xb8, 0x2f, 0x31, 0x8f,
+	0x62, 0x7d, 0x29, 0xf4, 0xc4, 0x6f, 0xef, 0x37, 0xb1, 0x32, 0x4a, 0x20, 0xf8, 0xc2, 0x81, 0xde,
+	0xb1, 0x4a, 0x75, 0x26, 0x42, 0x7d, 0x2d, 0x32, 0x43, 0xf7, 0x21, 0x78, 0x0b, 0x91, 0xcc, 0xa5,
+	0x2d, 0x24, 0x63, 0xbc, 0x83, 0xe0, 0x8f, 0x82, 0x4e, 0x91, 0xe6, 0x4e, 0x99, 0xe6, 0xe7, 0x6e,
+	0xbb, 0xd9, 0x73, 0x83, 0x0f, 0x1d, 0xd8, 0x23, 0xb5, 0x5e, 0xcd, 0xb1, 0x24, 0x88, 0xe5, 0xdf,
+	0x61, 0x27, 0xb4, 0xcc, 0x09, 0xb0, 0xe2, 0xfe, 0xd4, 0x8a, 0x5b, 0x2f, 0x00, 0xbe, 0xee, 0xc9,
+	0xfe, 0x02, 0x9d, 0x85, 0x4d, 0x56, 0xee, 0x37, 0xa8, 0xe5, 0xfd, 0xdc, 0x2e, 0xdb, 0x4c, 0x26,
+	0xaf, 0x3c, 0x83, 0xcf, 0x9b, 0xb0, 0xc5, 0x4d, 0x7b, 0x37, 0x1d, 0xda, 0xb8, 0xf6, 0xa3, 0x28,
+	0x93, 0x79, 0x6e, 0xb3, 0xbd, 0x09, 0x63, 0x26, 0xb0, 0xc2, 0xe6, 0x39, 0x25, 0xbd, 0xc3, 0xad,
+	0x85, 0x5c, 0x33, 0x69, 0xda, 0x5a, 0x87, 0xe3, 0x10, 0x3d, 0xf5, 0x92, 0x0e, 0x93, 0x6d, 0x68,
+	0xc6, 0xc2, 0x03, 0x78, 0x23, 0xe5, 0xbf, 0x73, 0x59, 0x36, 0x34, 0x6b, 0xb2, 0x3f, 0xc0, 0x83,
+	0x70, 0x3e, 0x9d, 0x27, 0x42, 0xc7, 0x0b, 0x79, 0x6a, 0x7d, 0x8c, 0x10, 0xb7, 0x27, 0xb0, 0x2e,
+	0x46, 0x89, 0x52, 0x53, 0xdb, 0xdf, 0x8c, 0xc1, 0x1e, 0x43, 0x4b, 0x2e, 0x64, 0xaa, 0x73, 0x92,
+	0xa3, 0x3a, 0x1d, 0x03, 0x04, 0xb9, 0x9d, 0xab, 0xdf, 0xb9, 0x9d, 0x5b, 0x77, 0x6e, 0xd5, 0xba,
+	0x60, 0xb3, 0x75, 0xf9, 0xb0, 0xa5, 0x97, 0xc3, 0x34, 0x92, 0x4b, 0xba, 0xa2, 0x3c, 0x5e, 0x98,
+	0xd8, 0x0f, 0x6f, 0x32, 0x35, 0xf5, 0xbb, 0xa6, 0x1f, 0xe2, 0x98, 0xed, 0x42, 0x43, 0x2b, 0x7f,
+	0x87, 0x90, 0x86, 0x56, 0xb8, 0x7a, 0x2c, 0x72, 0x62, 0xb5, 0x6b, 0xbe, 0x6a, 0x4d, 0x7c, 0x29,
+	0xdc, 0x48, 0x79, 0x22, 0x13, 0x39, 0x16, 0x1a, 0x2b, 0x7a, 0x8f, 0x2a, 0x7a, 0x1d, 0x64, 0x01,
+	0x74, 0xf1, 0xe9, 0x70, 0x5c, 0x34, 0xa9, 0x1e, 0x45, 0x5e, 0xc3, 0x82, 0x6f, 0x1d, 0xf0, 0x88,
+	0xeb, 0x7b, 0x68, 0xfa, 0x08, 0x3a, 0x94, 0x97, 0x73, 0x31, 0x95, 0x56, 0xd6, 0x0a, 0xc0, 0xf3,
+	0xf2, 0xbf, 0x5c, 0xa5, 0xfd, 0x6c, 0x9c, 0x5b, 0x79, 0x4b, 0x1b, 0xe7, 0xc8, 0x11, 0xdb, 0xb5,
+	0x4b, 0x09, 0x29, 0xed, 0x9a, 0xfe, 0xde, 0x9a, 0xfe, 0x6b, 0x19, 0x6e, 0xdd, 0x91, 0xe1, 0x42,
+	0x99, 0xad, 0x75, 0x65, 0x6a, 0xb9, 0x6f, 0xaf, 0xe5, 0x3e, 0xd8, 0x07, 0x38, 0xc5, 0xfd, 0xcc,
+	0xa7, 0xd2, 0xbc, 0x21, 0x52, 0x24, 0xe2, 0xd0, 0x5e, 0x69, 0x1c, 0x7c, 0x00, 0xed, 0xd3, 0x79,
+	0x1a, 0x52, 0x16, 0xef, 0x98, 0x67, 0x7f, 0x82, 0x8e, 0xb0, 0xeb, 0x8b, 0x23, 0xf4, 0xc0, 0x16,
+	0x4e, 0x15, 0x99, 0x57, 0x3e, 0xf6, 0x56, 0x16, 0xa3, 0x44, 0x52, 0x4e, 0xda, 0xbc, 0x30, 0x31,
+	0xfc, 0x22, 0x96, 0xff, 0xa7, 0x74, 0xb4, 0x39, 0x8d, 0x83, 0x13, 0x68, 0xd3, 0x79, 0xbf, 0x16,
+	0xd9, 0x9d, 0x9f, 0x67, 0xf6, 0x46, 0x36, 0xb9, 0xa7, 0x31, 0x1e, 0xa8, 0x44, 0xa6, 0x14, 0xdd,
+	0xe3, 0x38, 0x0c, 0x3e, 0x76, 0xa0, 0xd9, 0x3f, 0x1a, 0xe2, 0xb7, 0x17, 0x32, 0xa3, 0xc6, 0x67,
+	0x82, 0x14, 0x26, 0xca, 0x91, 0x88, 0x74, 0x3c, 0x17, 0xe3, 0x22, 0x56, 0x69, 0xb3, 0x3f, 0x42,
+	0xe7, 0xc6, 0xa6, 0x00, 0x75, 0x6c, 0xd6, 0x2e, 0x9d, 0x22, 0x35, 0xbc, 0xf2, 0x60, 0x7f, 0x83,
+	0x3d, 0xba, 0x49, 0xfe, 0xbb, 0x10, 0x59, 0x8c, 0xc4, 0x72, 0xdf, 0x5d, 0x5b, 0x54, 0x10, 0xe2,
+	0xbb, 0xb9, 0x1d, 0x19, 0xb7, 0xe0, 0x13, 0x07, 0x3c, 0x6a, 0x6c, 0xef, 0x57, 0x81, 0x6f, 0x71,
+	0x49, 0x9c, 0xde, 0x28, 0x7b, 0x2d, 0x57, 0xc0, 0xfd, 0x2f, 0xe4, 0xaa, 0x96, 0xdc, 0xcd, 0x5a,
+	0xfa, 0x35, 0xc0, 0x44, 0xe4, 0x47, 0x76, 0xa9, 0x47, 0x82, 0xd4, 0x90, 0xe0, 0x6b, 0x07, 0xa0,
+	0xea, 0xc3, 0xef, 0xb1, 0x5d, 0x06, 0x6e, 0xa6, 0x54, 0xf1, 0x88, 0xa3, 0x31, 0x7e, 0x2c, 0x54,
+	0xd3, 0x19, 0xce, 0xcb, 0xc8, 0xaa, 0x5f, 0x43, 0x6a, 0x2f, 0x88, 0x17, 0x72, 0x95, 0xfb, 0x1e,
+	0x5d, 0x16, 0x75, 0xa8, 0x4e, 0xb3, 0x75, 0x0f, 0xcd, 0xad, 0xfb, 0x69, 0xb6, 0x37, 0x69, 0x3e,
+	0x77, 0xdb, 0x8d, 0x5e, 0x33, 0xf8, 0xd2, 0x01, 0x38, 0x8d, 0x13, 0x2d, 0xb3, 0x21, 0xe6, 0xf4,
+	0x87, 0xea, 0x0e, 0xc5, 0xd6, 0xa8, 0xf9, 0x19, 0x75, 0x2a, 0xa0, 0xa4, 0xa4, 0x95, 0xef, 0xd6,
+	0x28, 0x69, 0x85, 0x29, 0x8c, 0x64, 0x1e, 0x5a, 0x55, 0x68, 0x4c, 0xb7, 0x69, 0x36, 0x36, 0x9b,
+	0x2c, 0x3a, 0x43, 0x09, 0xe0, 0xef, 0x1d, 0xfc, 0x35, 0x92, 0x6a, 0xe2, 0x75, 0x9c, 0x9a, 0xbb,
+	0xd8, 0xe3, 0x1b, 0x68, 0xf0, 0x4f, 0x68, 0x17, 0xaf, 0x28, 0x8c, 0xa8, 0x27, 0x99, 0xcc, 0x27,
+	0x2a, 0x89, 0x88, 0xdf, 0x0e, 0xaf, 0x00, 0xdc, 0xc3, 0x1b, 0xb9, 0x32, 0x07, 0xbe, 0xcb, 0x69,
+	0x1c, 0xbc, 0x82, 0x6e, 0xb1, 0xfa, 0x75, 0x3c, 0x4e, 0xd7, 0x9e, 0x6a, 0xce, 0x3b, 0x9e, 0x6a,
+	0x78, 0x25, 0xe1, 0x43, 0xb8, 0x88, 0x68, 0x8c, 0xe0, 0x53, 0x07, 0xba, 0xf6, 0xa2, 0x35, 0x17,
+	0xf6, 0x01, 0x6c, 0xd9, 0xdf, 0x55, 0x36, 0xe4, 0xae, 0x0d, 0x69, 0xbd, 0x78, 0x31, 0x5d, 0xdd,
+	0x71, 0x8d, 0xfa, 0x1d, 0xb7, 0x56, 0x0e, 0xcd, 0x7b, 0x3a, 0xa8, 0xbb, 0x5e, 0x46, 0x0f, 0xc1,
+	0x8b, 0xa9, 0x7f, 0x7a, 0x94, 0x09, 0x63, 0xac, 0xbf, 0x61, 0x5a, 0x9b, 0x4f, 0xb2, 0x73, 0xe8,
+	0x52, 0x66, 0xfb, 0x59, 0x38, 0x89, 0x17, 0x12, 0x1f, 0x9f, 0x14, 0x6e, 0xe3, 0xf1, 0x49, 0x3e,
+	0xdc, 0x4c, 0x61, 0x1b, 0x2a, 0x7e, 0x37, 0xda, 0x8d, 0x97, 0xf6, 0x93, 0x01, 0xb4, 0xcc, 0x2f,
+	0x0d, 0x06, 0xd0, 0x3a, 0xbf, 0xe0, 0x2f, 0xfb, 0x67, 0xbd, 0x9f, 0xb0, 0x5d, 0x80, 0x67, 0x17,
+	0xd7, 0x03, 0x7e, 0xde, 0x3f, 0x3f, 0x1e, 0xf4, 0x1c, 0xd6, 0x85, 0x36, 0x1f, 0x9c, 0x0c, 0x2e,
+	0xcf, 0x2e, 0xfe, 0xd3, 0x6b, 0xb0, 0x07, 0xb0, 0x73, 0x3a, 0x18, 0x9c, 0x0c, 0xce, 0x06, 0xcf,
+	0xfa, 0x57, 0xc3, 0x8b, 0xf3, 0x5e, 0x73, 0xd4, 0xa2, 0x7f, 0x00, 0xfe, 0xfc, 0xdd, 0x00, 0x2f,
+	0x93, 0x72, 0x4f, 0x15, 0x10, 0x00, 0x00,
 }
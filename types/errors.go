@@ -15,6 +15,15 @@ var (
 	//ErrSameNonceInMempool is returned by MemPool Service if transaction which has same nonce is already exists
 	ErrSameNonceAlreadyInMempool = errors.New("tx with same nonce is already in mempool")
 
+	//ErrTxReplacementUnderpriced is returned by MemPool Service if transaction does not raise the gas price of the pending transaction with same nonce enough to replace it
+	ErrTxReplacementUnderpriced = errors.New("replacement tx is underpriced")
+
+	//ErrTxPoolFull is returned by MemPool Service if mempool is full and transaction pays less than the others
+	ErrTxPoolFull = errors.New("mempool is full")
+
+	//ErrTxAccountPoolFull is returned by MemPool Service if the account has too many transactions in mempool
+	ErrTxAccountPoolFull = errors.New("too many txs of account in mempool")
+
 	//ErrTxFormatInvalid is returned by MemPool Service if transaction does not exists ErrTxFormatInvalid = errors.New("tx invalid format")
 	ErrTxFormatInvalid = errors.New("tx invalid format")
 
@@ -73,5 +82,20 @@ var (
 	//ErrTooSmallAmount
 	ErrExceedAmount = errors.New("request amount exceeds")
 
+	//ErrNoReward
+	ErrNoReward = errors.New("no reward to claim")
+
+	ErrVoteDelegated = errors.New("voting power is delegated to a proxy")
+
+	ErrNotDelegated = errors.New("voting power is not delegated")
+
+	ErrInvalidProxy = errors.New("invalid proxy")
+
 	ErrCreatorNotMatch = errors.New("creator not matched")
+
+	ErrInvalidMultisig = errors.New("invalid multisig")
+
+	ErrMultisigNotEnoughSign = errors.New("not enough signatures for multisig")
+
+	ErrNotAllowedFeeDelegation = errors.New("fee delegation is not allowed")
 )
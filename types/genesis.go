@@ -189,6 +189,60 @@ type EnterpriseBP struct {
 	PeerID  string `json:"peerid"`
 }
 
+// Issuance is the schedule of the coins minted in each block. The reward of a
+// block is split between the BP producing the block and the voters.
+type Issuance struct {
+	// Reward is the amount of the coins minted in a block.
+	Reward string `json:"reward"`
+	// HalvingInterval is the number of the blocks after which Reward is
+	// halved. Reward is never halved if it is 0.
+	HalvingInterval uint64 `json:"halving_interval,omitempty"`
+	// BpShare is the percentage of the reward sent to the BP producing the
+	// block. The rest goes to the reward pool of the voters.
+	BpShare uint64 `json:"bp_share"`
+}
+
+// Validate checks the issuance schedule.
+func (i *Issuance) Validate() error {
+	reward, ok := new(big.Int).SetString(i.Reward, 10)
+	if !ok || reward.Sign() < 0 {
+		return fmt.Errorf("invalid issuance reward: %s", i.Reward)
+	}
+	if i.BpShare > 100 {
+		return fmt.Errorf("invalid issuance bp share: %d", i.BpShare)
+	}
+	return nil
+}
+
+// RewardAt returns the amount of the coins minted in the block of blockNo.
+func (i *Issuance) RewardAt(blockNo BlockNo) *big.Int {
+	if i == nil || blockNo == 0 {
+		return big.NewInt(0)
+	}
+	reward, ok := new(big.Int).SetString(i.Reward, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	if i.HalvingInterval > 0 {
+		halvings := blockNo / i.HalvingInterval
+		if halvings >= uint64(reward.BitLen()) {
+			return big.NewInt(0)
+		}
+		reward.Rsh(reward, uint(halvings))
+	}
+	return reward
+}
+
+// BpRewardAt returns the share of the BP from the coins minted in the block
+// of blockNo.
+func (i *Issuance) BpRewardAt(blockNo BlockNo) *big.Int {
+	if i == nil {
+		return big.NewInt(0)
+	}
+	bpReward := new(big.Int).Mul(i.RewardAt(blockNo), new(big.Int).SetUint64(i.BpShare))
+	return bpReward.Div(bpReward, big.NewInt(100))
+}
+
 // Genesis represents genesis block
 type Genesis struct {
 	ID            ChainID           `json:"chain_id,omitempty"`
@@ -196,6 +250,8 @@ type Genesis struct {
 	Balance       map[string]string `json:"balance"`
 	BPs           []string          `json:"bps"`
 	EnterpriseBPs []EnterpriseBP    `json:"enterprise_bps,omitempty"`
+	Issuance      *Issuance         `json:"issuance,omitempty"`
+	Hardfork      *Hardfork         `json:"hardfork,omitempty"`
 
 	// followings are for internal use only
 	totalBalance *big.Int
@@ -209,6 +265,11 @@ func (g *Genesis) Validate() error {
 	if err != nil {
 		return err
 	}
+	if g.Issuance != nil {
+		if err := g.Issuance.Validate(); err != nil {
+			return err
+		}
+	}
 	//TODO check BP count
 	return nil
 }
@@ -267,6 +328,16 @@ func (g Genesis) Bytes() []byte {
 	return nil
 }
 
+// HardforkConfig returns the hardfork heights of g. The hardforks which g does
+// not specify a height for are not scheduled, so that an existing chain keeps
+// its rules until its nodes agree on a height.
+func (g *Genesis) HardforkConfig() *Hardfork {
+	if g.Hardfork != nil {
+		return g.Hardfork
+	}
+	return &Hardfork{V2: unscheduledFork}
+}
+
 // ConsensusType retruns g.ID.ConsensusType.
 func (g Genesis) ConsensusType() string {
 	return g.ID.Consensus
@@ -51,6 +51,40 @@ func TestGenesisBytes(t *testing.T) {
 	a.Nil(g2.Balance)
 }
 
+func TestGenesisHardfork(t *testing.T) {
+	a := assert.New(t)
+	a.Equal(&Hardfork{V2: unscheduledFork}, GetDefaultGenesis().HardforkConfig())
+	a.Equal(&Hardfork{V2: unscheduledFork}, GetTestNetGenesis().HardforkConfig())
+
+	var g Genesis
+	a.NoError(json.Unmarshal([]byte(`{"hardfork":{}}`), &g))
+	a.Equal(&Hardfork{V2: unscheduledFork}, g.HardforkConfig())
+	g = Genesis{}
+	a.NoError(json.Unmarshal([]byte(`{"hardfork":{"v2":0}}`), &g))
+	a.Equal(&Hardfork{V2: 0}, g.HardforkConfig())
+	g = Genesis{}
+	a.NoError(json.Unmarshal([]byte(`{"hardfork":{"v2":100}}`), &g))
+	a.Equal(&Hardfork{V2: 100}, g.HardforkConfig())
+
+	InitHardfork(g.HardforkConfig())
+	defer InitHardfork(&Hardfork{})
+	a.False(IsV2Fork(99))
+	a.True(IsV2Fork(100))
+}
+
+func TestGenesisWithoutHardfork(t *testing.T) {
+	a := assert.New(t)
+	var g Genesis
+	a.NoError(json.Unmarshal([]byte(`{"chain_id":{"magic":"private.chain","public":false,"mainnet":false,"consensus":"sbp"},"balance":{},"bps":[]}`), &g))
+	a.Nil(g.Hardfork)
+
+	// a private chain keeps the V1 rules
+	InitHardfork(g.HardforkConfig())
+	defer InitHardfork(&Hardfork{})
+	a.False(IsV2Fork(0))
+	a.False(IsV2Fork(1000000))
+}
+
 func TestCodecChainID(t *testing.T) {
 	a := assert.New(t)
 	id1 := NewChainID()
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// unscheduledFork is the height of a hardfork, which is not scheduled yet.
+const unscheduledFork = BlockNo(math.MaxUint64)
+
+// Hardfork represents the block heights from which the protocol changes of
+// each hardfork apply. The changes of a hardfork at 0 apply from the genesis
+// block.
+type Hardfork struct {
+	// V2 activates the gas metering of contracts, the contract upgrade, the
+	// voting on the chain parameters, the rewards of voters, the slashing of
+	// BPs and the extensions of the name system.
+	V2 BlockNo `json:"v2"`
+}
+
+// hardfork is the hardfork heights of the running chain.
+var hardfork = Hardfork{}
+
+// InitHardfork sets the hardfork heights of the running chain.
+func InitHardfork(h *Hardfork) {
+	hardfork = *h
+}
+
+// IsV2Fork reports whether the V2 hardfork applies to the block of blockNo.
+func IsV2Fork(blockNo BlockNo) bool {
+	return blockNo >= hardfork.V2
+}
+
+// V2ForkHeight returns the height from which the V2 hardfork applies.
+func V2ForkHeight() BlockNo {
+	return hardfork.V2
+}
+
+// UnmarshalJSON decodes the hardfork heights of a genesis. A hardfork without
+// a height is not scheduled.
+func (h *Hardfork) UnmarshalJSON(data []byte) error {
+	type heights Hardfork
+	v := heights{V2: unscheduledFork}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*h = Hardfork(v)
+	return nil
+}
+
+func (h *Hardfork) String() string {
+	if h.V2 == unscheduledFork {
+		return "v2=unscheduled"
+	}
+	return fmt.Sprintf("v2=%d", h.V2)
+}
@@ -25,16 +25,20 @@ const (
 	MetricType_NOTHING MetricType = 0
 	// Metric for p2p network transfer
 	MetricType_P2P_NETWORK MetricType = 1
+	// Metric for mempool capacity and eviction
+	MetricType_MEMPOOL MetricType = 2
 )
 
 var MetricType_name = map[int32]string{
 	0: "NOTHING",
 	1: "P2P_NETWORK",
+	2: "MEMPOOL",
 }
 
 var MetricType_value = map[string]int32{
 	"NOTHING":     0,
 	"P2P_NETWORK": 1,
+	"MEMPOOL":     2,
 }
 
 func (x MetricType) String() string {
@@ -84,10 +88,11 @@ func (m *MetricsRequest) GetTypes() []MetricType {
 }
 
 type Metrics struct {
-	Peers                []*PeerMetric `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Peers                []*PeerMetric  `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	Mempool              *MempoolMetric `protobuf:"bytes,2,opt,name=mempool,proto3" json:"mempool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *Metrics) Reset()         { *m = Metrics{} }
@@ -121,6 +126,13 @@ func (m *Metrics) GetPeers() []*PeerMetric {
 	return nil
 }
 
+func (m *Metrics) GetMempool() *MempoolMetric {
+	if m != nil {
+		return m.Mempool
+	}
+	return nil
+}
+
 type PeerMetric struct {
 	PeerID               []byte   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
 	SumIn                int64    `protobuf:"varint,2,opt,name=sumIn,proto3" json:"sumIn,omitempty"`
@@ -191,30 +203,133 @@ func (m *PeerMetric) GetAvrOut() int64 {
 	return 0
 }
 
+type MempoolMetric struct {
+	Total                int32    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Orphan               int32    `protobuf:"varint,2,opt,name=orphan,proto3" json:"orphan,omitempty"`
+	Bytes                uint64   `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Accounts             int32    `protobuf:"varint,4,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	EvictedCapacity      uint64   `protobuf:"varint,5,opt,name=evictedCapacity,proto3" json:"evictedCapacity,omitempty"`
+	EvictedAccount       uint64   `protobuf:"varint,6,opt,name=evictedAccount,proto3" json:"evictedAccount,omitempty"`
+	EvictedOrphan        uint64   `protobuf:"varint,7,opt,name=evictedOrphan,proto3" json:"evictedOrphan,omitempty"`
+	EvictedFadeout       uint64   `protobuf:"varint,8,opt,name=evictedFadeout,proto3" json:"evictedFadeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolMetric) Reset()         { *m = MempoolMetric{} }
+func (m *MempoolMetric) String() string { return proto.CompactTextString(m) }
+func (*MempoolMetric) ProtoMessage()    {}
+func (*MempoolMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da41641f55bff5df, []int{3}
+}
+func (m *MempoolMetric) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolMetric.Unmarshal(m, b)
+}
+func (m *MempoolMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolMetric.Marshal(b, m, deterministic)
+}
+func (m *MempoolMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolMetric.Merge(m, src)
+}
+func (m *MempoolMetric) XXX_Size() int {
+	return xxx_messageInfo_MempoolMetric.Size(m)
+}
+func (m *MempoolMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolMetric proto.InternalMessageInfo
+
+func (m *MempoolMetric) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *MempoolMetric) GetOrphan() int32 {
+	if m != nil {
+		return m.Orphan
+	}
+	return 0
+}
+
+func (m *MempoolMetric) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *MempoolMetric) GetAccounts() int32 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
+func (m *MempoolMetric) GetEvictedCapacity() uint64 {
+	if m != nil {
+		return m.EvictedCapacity
+	}
+	return 0
+}
+
+func (m *MempoolMetric) GetEvictedAccount() uint64 {
+	if m != nil {
+		return m.EvictedAccount
+	}
+	return 0
+}
+
+func (m *MempoolMetric) GetEvictedOrphan() uint64 {
+	if m != nil {
+		return m.EvictedOrphan
+	}
+	return 0
+}
+
+func (m *MempoolMetric) GetEvictedFadeout() uint64 {
+	if m != nil {
+		return m.EvictedFadeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MetricsRequest)(nil), "types.MetricsRequest")
 	proto.RegisterType((*Metrics)(nil), "types.Metrics")
 	proto.RegisterType((*PeerMetric)(nil), "types.PeerMetric")
+	proto.RegisterType((*MempoolMetric)(nil), "types.MempoolMetric")
 	proto.RegisterEnum("types.MetricType", MetricType_name, MetricType_value)
 }
 
 func init() { proto.RegisterFile("metric.proto", fileDescriptor_da41641f55bff5df) }
 
 var fileDescriptor_da41641f55bff5df = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcf, 0x4b, 0xc3, 0x30,
-	0x14, 0x80, 0x8d, 0xb5, 0x1b, 0xbc, 0x8e, 0x39, 0x83, 0x48, 0x8e, 0x65, 0x17, 0xcb, 0x0e, 0x3d,
-	0xd4, 0x93, 0x77, 0x45, 0x83, 0xd8, 0x96, 0x50, 0xf0, 0x28, 0x53, 0xde, 0xc1, 0x43, 0xb7, 0x98,
-	0x1f, 0x83, 0xde, 0xfc, 0xd3, 0x25, 0x79, 0x61, 0x3d, 0x7e, 0xdf, 0x7b, 0x5f, 0x20, 0x0f, 0x56,
-	0x23, 0x3a, 0xf3, 0xf3, 0x5d, 0x6b, 0x73, 0x74, 0x47, 0x9e, 0xbb, 0x49, 0xa3, 0xdd, 0x3e, 0xc2,
-	0xfa, 0x3d, 0x6a, 0xab, 0xf0, 0xd7, 0xa3, 0x75, 0xfc, 0x1e, 0x68, 0x24, 0x58, 0x99, 0x55, 0xeb,
-	0xe6, 0xa6, 0x8e, 0x54, 0xd3, 0xd6, 0x30, 0x69, 0x54, 0x29, 0x6d, 0x60, 0x99, 0xd2, 0xd0, 0x68,
-	0x44, 0x43, 0x4d, 0x71, 0x6e, 0x7a, 0x44, 0x43, 0x2b, 0x8a, 0xe6, 0xdb, 0x3f, 0x06, 0x30, 0x5b,
-	0x7e, 0x07, 0x8b, 0xe0, 0xe5, 0x93, 0x60, 0x25, 0xab, 0x56, 0x2a, 0x11, 0xbf, 0x85, 0xdc, 0xfa,
-	0x51, 0x1e, 0xc4, 0x65, 0xc9, 0xaa, 0x4c, 0x11, 0x04, 0xbb, 0x3f, 0x19, 0x79, 0x10, 0x19, 0xd9,
-	0x08, 0xe1, 0x0d, 0xeb, 0xc7, 0xce, 0x3b, 0x71, 0x15, 0x75, 0xa2, 0xe0, 0xf7, 0x27, 0x13, 0x7c,
-	0x4e, 0x9e, 0x68, 0xb7, 0x03, 0x98, 0xff, 0xc2, 0x0b, 0x58, 0xb6, 0xdd, 0xf0, 0x2a, 0xdb, 0x97,
-	0xcd, 0x05, 0xbf, 0x86, 0xa2, 0x6f, 0xfa, 0xcf, 0xf6, 0x79, 0xf8, 0xe8, 0xd4, 0xdb, 0x86, 0x7d,
-	0x2d, 0xe2, 0xad, 0x1e, 0xfe, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x5e, 0x48, 0xee, 0x3b, 0x01,
-	0x00, 0x00,
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x4b, 0xc3, 0x40,
+	0x14, 0xc4, 0x4d, 0xdb, 0x34, 0xe5, 0xa5, 0xff, 0x5c, 0x8a, 0x04, 0x4f, 0xa5, 0x88, 0x06, 0x0f,
+	0x39, 0xd4, 0x83, 0x78, 0x14, 0xad, 0x1a, 0xb4, 0x4d, 0x58, 0x0a, 0x1e, 0x65, 0x9b, 0x2e, 0x58,
+	0x68, 0xba, 0x31, 0xd9, 0x14, 0x72, 0xf3, 0x1b, 0xf8, 0x95, 0x65, 0xf7, 0xad, 0xad, 0xed, 0x71,
+	0x7e, 0x6f, 0x66, 0x98, 0xc3, 0x83, 0x76, 0xca, 0x65, 0xbe, 0x4a, 0x82, 0x2c, 0x17, 0x52, 0x10,
+	0x5b, 0x56, 0x19, 0x2f, 0x46, 0x77, 0xd0, 0x9d, 0x6a, 0x5c, 0x50, 0xfe, 0x55, 0xf2, 0x42, 0x92,
+	0x2b, 0xc0, 0x93, 0x67, 0x0d, 0xeb, 0x7e, 0x77, 0x7c, 0x1a, 0x68, 0x15, 0xa0, 0x6b, 0x5e, 0x65,
+	0x9c, 0x9a, 0xe8, 0x02, 0x1c, 0x13, 0x55, 0x99, 0x8c, 0xf3, 0x1c, 0x33, 0xee, 0x2e, 0x13, 0x73,
+	0x9e, 0xa3, 0x85, 0xe2, 0x9d, 0x04, 0xe0, 0xa4, 0x3c, 0xcd, 0x84, 0x58, 0x7b, 0xb5, 0xa1, 0xe5,
+	0xbb, 0xe3, 0xc1, 0xae, 0x5e, 0x53, 0xe3, 0xfe, 0x33, 0x8d, 0xbe, 0x2d, 0x80, 0x7d, 0x0b, 0x39,
+	0x83, 0xa6, 0xea, 0x09, 0x1f, 0x3d, 0x6b, 0x68, 0xf9, 0x6d, 0x6a, 0x14, 0x19, 0x80, 0x5d, 0x94,
+	0x69, 0xb8, 0xd1, 0xa5, 0x75, 0x8a, 0x42, 0x51, 0xb6, 0xcd, 0xc3, 0x8d, 0x57, 0x47, 0xaa, 0x85,
+	0xea, 0x28, 0xca, 0x34, 0x2a, 0xa5, 0xd7, 0xd0, 0xd8, 0x28, 0xc5, 0xd9, 0x36, 0x57, 0xdc, 0x46,
+	0x8e, 0x6a, 0xf4, 0x53, 0x83, 0xce, 0xc1, 0x3a, 0xd5, 0x2b, 0x85, 0x64, 0x6b, 0x3d, 0xc2, 0xa6,
+	0x28, 0x54, 0x5e, 0xe4, 0xd9, 0x27, 0xc3, 0x11, 0x36, 0x35, 0x4a, 0xb9, 0x17, 0x95, 0xe4, 0x85,
+	0x5e, 0xd1, 0xa0, 0x28, 0xc8, 0x39, 0xb4, 0x58, 0x92, 0x88, 0x72, 0x23, 0x0b, 0xbd, 0xc3, 0xa6,
+	0x3b, 0x4d, 0x7c, 0xe8, 0xf1, 0xed, 0x2a, 0x91, 0x7c, 0xf9, 0xc0, 0x32, 0x96, 0xac, 0x64, 0xa5,
+	0x27, 0x35, 0xe8, 0x31, 0x26, 0x97, 0xd0, 0x35, 0xe8, 0x1e, 0xc3, 0x5e, 0x53, 0x1b, 0x8f, 0x28,
+	0xb9, 0x80, 0x8e, 0x21, 0x11, 0x4e, 0x74, 0xb4, 0xed, 0x10, 0xfe, 0x6b, 0x7b, 0x62, 0x4b, 0x2e,
+	0x4a, 0xe9, 0xb5, 0x0e, 0xda, 0x0c, 0xbd, 0xbe, 0x05, 0xd8, 0x7f, 0x03, 0x71, 0xc1, 0x99, 0x45,
+	0xf3, 0x97, 0x70, 0xf6, 0xdc, 0x3f, 0x21, 0x3d, 0x70, 0xe3, 0x71, 0xfc, 0x31, 0x9b, 0xcc, 0xdf,
+	0x23, 0xfa, 0xda, 0xb7, 0xd4, 0x75, 0x3a, 0x99, 0xc6, 0x51, 0xf4, 0xd6, 0xaf, 0x2d, 0x9a, 0xfa,
+	0xf5, 0x6e, 0x7e, 0x07, 0x00, 0x96, 0x1a, 0xc7, 0x60, 0x8a, 0x02, 0x00, 0x00,
 }
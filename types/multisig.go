@@ -0,0 +1,111 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/minio/sha256-simd"
+)
+
+const (
+	// MultisigAddressPrefix is the first byte of a multisig account address.
+	// Addresses of key accounts start with 0x02 or 0x03, and those of
+	// contracts with 0x0C.
+	MultisigAddressPrefix = 0x0D
+	MaxMultisigKeys       = 16
+)
+
+// NewMultisig returns an M-of-N multisig of keys, which are the addresses of
+// the signers.
+func NewMultisig(threshold uint32, keys [][]byte) (*Multisig, error) {
+	ms := &Multisig{Threshold: threshold, Keys: keys}
+	if err := ms.Validate(); err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// Validate checks the threshold and the keys of ms.
+func (ms *Multisig) Validate() error {
+	n := len(ms.GetKeys())
+	if n == 0 || n > MaxMultisigKeys || ms.GetThreshold() == 0 || int(ms.GetThreshold()) > n {
+		return ErrInvalidMultisig
+	}
+	for i, k := range ms.Keys {
+		if len(k) != AddressLength || (k[0] != 0x02 && k[0] != 0x03) {
+			return ErrInvalidMultisig
+		}
+		for _, prev := range ms.Keys[:i] {
+			if bytes.Equal(k, prev) {
+				return ErrInvalidMultisig
+			}
+		}
+	}
+	return nil
+}
+
+// Address returns the account address of ms, which commits to the threshold
+// and the keys in order.
+func (ms *Multisig) Address() Address {
+	h := sha256.New()
+	binary.Write(h, binary.LittleEndian, ms.GetThreshold())
+	for _, k := range ms.GetKeys() {
+		h.Write(k)
+	}
+	return append([]byte{MultisigAddressPrefix}, h.Sum(nil)...)
+}
+
+// IsMultisigAddress reports whether addr is the address of a multisig account.
+func IsMultisigAddress(addr []byte) bool {
+	return len(addr) == AddressLength && addr[0] == MultisigAddressPrefix
+}
+
+// NewMultisigSign returns an empty set of signatures for ms.
+func NewMultisigSign(ms *Multisig) *MultisigSign {
+	return &MultisigSign{Multisig: ms, Signs: make([][]byte, len(ms.GetKeys()))}
+}
+
+// DecodeMultisigSign decodes the sign field of a tx sent from a multisig
+// account. The signatures are ordered as the keys, and are empty for the keys
+// which did not sign.
+func DecodeMultisigSign(sign []byte) (*MultisigSign, error) {
+	s := &MultisigSign{}
+	if err := proto.Unmarshal(sign, s); err != nil {
+		return nil, ErrInvalidMultisig
+	}
+	if err := s.GetMultisig().Validate(); err != nil {
+		return nil, err
+	}
+	if len(s.Signs) != len(s.Multisig.Keys) {
+		return nil, ErrInvalidMultisig
+	}
+	return s, nil
+}
+
+// SetSign sets sign as the signature of key.
+func (s *MultisigSign) SetSign(key, sign []byte) error {
+	for i, k := range s.GetMultisig().GetKeys() {
+		if bytes.Equal(k, key) {
+			s.Signs[i] = sign
+			return nil
+		}
+	}
+	return ErrSignNotMatch
+}
+
+// SignCount returns the number of the signatures in s.
+func (s *MultisigSign) SignCount() int {
+	var n int
+	for _, sign := range s.GetSigns() {
+		if len(sign) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// Encode returns s encoded for the sign field of a tx.
+func (s *MultisigSign) Encode() ([]byte, error) {
+	return proto.Marshal(s)
+}
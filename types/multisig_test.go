@@ -0,0 +1,57 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultisig(t *testing.T) {
+	key := func(b byte) []byte {
+		return append([]byte{0x02}, bytes.Repeat([]byte{b}, AddressLength-1)...)
+	}
+	keys := [][]byte{key(1), key(2), key(3)}
+
+	for _, tc := range []struct {
+		threshold uint32
+		keys      [][]byte
+	}{
+		{0, keys},
+		{4, keys},
+		{1, nil},
+		{1, [][]byte{key(1), key(1)}},
+		{1, [][]byte{key(1)[:10]}},
+		{1, [][]byte{append([]byte{0x0C}, key(1)[1:]...)}},
+	} {
+		_, err := NewMultisig(tc.threshold, tc.keys)
+		assert.Equal(t, ErrInvalidMultisig, err)
+	}
+
+	ms, err := NewMultisig(2, keys)
+	assert.NoError(t, err)
+	addr := ms.Address()
+	assert.True(t, IsMultisigAddress(addr))
+	assert.False(t, IsMultisigAddress(keys[0]))
+	assert.Equal(t, AddressLength, len(addr))
+
+	ms2, _ := NewMultisig(3, keys)
+	assert.NotEqual(t, addr, ms2.Address(), "the threshold is part of the address")
+	ms3, _ := NewMultisig(2, [][]byte{key(2), key(1), key(3)})
+	assert.NotEqual(t, addr, ms3.Address(), "the key order is part of the address")
+
+	s := NewMultisigSign(ms)
+	assert.NoError(t, s.SetSign(key(2), []byte{1}))
+	assert.Equal(t, ErrSignNotMatch, s.SetSign(key(4), []byte{1}))
+	assert.Equal(t, 1, s.SignCount())
+	encoded, err := s.Encode()
+	assert.NoError(t, err)
+	dec, err := DecodeMultisigSign(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, addr, dec.Multisig.Address())
+	assert.Equal(t, 1, dec.SignCount())
+	assert.Equal(t, []byte{1}, dec.Signs[1])
+
+	_, err = DecodeMultisigSign([]byte{0x30, 0x45})
+	assert.Error(t, err)
+}
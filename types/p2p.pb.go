@@ -380,7 +380,9 @@ type Status struct {
 	// version of server binary
 	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
 	// hash of genesis block
-	Genesis              []byte   `protobuf:"bytes,7,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	Genesis []byte `protobuf:"bytes,7,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	// peer serves the block headers for sync
+	SyncHeaders          bool     `protobuf:"varint,8,opt,name=syncHeaders,proto3" json:"syncHeaders,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -459,6 +461,13 @@ func (m *Status) GetGenesis() []byte {
 	return nil
 }
 
+func (m *Status) GetSyncHeaders() bool {
+	if m != nil {
+		return m.SyncHeaders
+	}
+	return false
+}
+
 // GoAwayNotice is sent before host peer is closing connection to remote peer. it contains why the host closing connection.
 type GoAwayNotice struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -1403,6 +1412,622 @@ func (m *GetHashesResponse) GetHasNext() bool {
 	return false
 }
 
+type GetLightHeadersRequest struct {
+	StartNo              uint64   `protobuf:"varint,1,opt,name=startNo,proto3" json:"startNo,omitempty"`
+	Size                 uint32   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLightHeadersRequest) Reset()         { *m = GetLightHeadersRequest{} }
+func (m *GetLightHeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLightHeadersRequest) ProtoMessage()    {}
+func (*GetLightHeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{24}
+}
+func (m *GetLightHeadersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLightHeadersRequest.Unmarshal(m, b)
+}
+func (m *GetLightHeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLightHeadersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLightHeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLightHeadersRequest.Merge(m, src)
+}
+func (m *GetLightHeadersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLightHeadersRequest.Size(m)
+}
+func (m *GetLightHeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLightHeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLightHeadersRequest proto.InternalMessageInfo
+
+func (m *GetLightHeadersRequest) GetStartNo() uint64 {
+	if m != nil {
+		return m.StartNo
+	}
+	return 0
+}
+
+func (m *GetLightHeadersRequest) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type GetLightHeadersResponse struct {
+	Status               ResultStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Headers              []*BlockHeader `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetLightHeadersResponse) Reset()         { *m = GetLightHeadersResponse{} }
+func (m *GetLightHeadersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLightHeadersResponse) ProtoMessage()    {}
+func (*GetLightHeadersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{25}
+}
+func (m *GetLightHeadersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLightHeadersResponse.Unmarshal(m, b)
+}
+func (m *GetLightHeadersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLightHeadersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLightHeadersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLightHeadersResponse.Merge(m, src)
+}
+func (m *GetLightHeadersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLightHeadersResponse.Size(m)
+}
+func (m *GetLightHeadersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLightHeadersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLightHeadersResponse proto.InternalMessageInfo
+
+func (m *GetLightHeadersResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetLightHeadersResponse) GetHeaders() []*BlockHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type GetReceiptProofRequest struct {
+	TxHash               []byte   `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReceiptProofRequest) Reset()         { *m = GetReceiptProofRequest{} }
+func (m *GetReceiptProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReceiptProofRequest) ProtoMessage()    {}
+func (*GetReceiptProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{26}
+}
+func (m *GetReceiptProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReceiptProofRequest.Unmarshal(m, b)
+}
+func (m *GetReceiptProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReceiptProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetReceiptProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReceiptProofRequest.Merge(m, src)
+}
+func (m *GetReceiptProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetReceiptProofRequest.Size(m)
+}
+func (m *GetReceiptProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReceiptProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReceiptProofRequest proto.InternalMessageInfo
+
+func (m *GetReceiptProofRequest) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *GetReceiptProofRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type GetReceiptProofResponse struct {
+	Status               ResultStatus  `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Proof                *ReceiptProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetReceiptProofResponse) Reset()         { *m = GetReceiptProofResponse{} }
+func (m *GetReceiptProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReceiptProofResponse) ProtoMessage()    {}
+func (*GetReceiptProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{27}
+}
+func (m *GetReceiptProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReceiptProofResponse.Unmarshal(m, b)
+}
+func (m *GetReceiptProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReceiptProofResponse.Marshal(b, m, deterministic)
+}
+func (m *GetReceiptProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReceiptProofResponse.Merge(m, src)
+}
+func (m *GetReceiptProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReceiptProofResponse.Size(m)
+}
+func (m *GetReceiptProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReceiptProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReceiptProofResponse proto.InternalMessageInfo
+
+func (m *GetReceiptProofResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetReceiptProofResponse) GetProof() *ReceiptProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type GetStateProofRequest struct {
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Account              []byte   `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	StorageKeys          [][]byte `protobuf:"bytes,3,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+	Compressed           bool     `protobuf:"varint,4,opt,name=compressed,proto3" json:"compressed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateProofRequest) Reset()         { *m = GetStateProofRequest{} }
+func (m *GetStateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateProofRequest) ProtoMessage()    {}
+func (*GetStateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{28}
+}
+func (m *GetStateProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateProofRequest.Unmarshal(m, b)
+}
+func (m *GetStateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateProofRequest.Merge(m, src)
+}
+func (m *GetStateProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateProofRequest.Size(m)
+}
+func (m *GetStateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateProofRequest proto.InternalMessageInfo
+
+func (m *GetStateProofRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *GetStateProofRequest) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetStateProofRequest) GetStorageKeys() [][]byte {
+	if m != nil {
+		return m.StorageKeys
+	}
+	return nil
+}
+
+func (m *GetStateProofRequest) GetCompressed() bool {
+	if m != nil {
+		return m.Compressed
+	}
+	return false
+}
+
+type GetStateProofResponse struct {
+	Status               ResultStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Proof                *StateQueryProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetStateProofResponse) Reset()         { *m = GetStateProofResponse{} }
+func (m *GetStateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateProofResponse) ProtoMessage()    {}
+func (*GetStateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{29}
+}
+func (m *GetStateProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateProofResponse.Unmarshal(m, b)
+}
+func (m *GetStateProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateProofResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStateProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateProofResponse.Merge(m, src)
+}
+func (m *GetStateProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateProofResponse.Size(m)
+}
+func (m *GetStateProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateProofResponse proto.InternalMessageInfo
+
+func (m *GetStateProofResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetStateProofResponse) GetProof() *StateQueryProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type GetStateRangeRequest struct {
+	Root                 []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Start                []byte   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateRangeRequest) Reset()         { *m = GetStateRangeRequest{} }
+func (m *GetStateRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateRangeRequest) ProtoMessage()    {}
+func (*GetStateRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{30}
+}
+func (m *GetStateRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateRangeRequest.Unmarshal(m, b)
+}
+func (m *GetStateRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStateRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateRangeRequest.Merge(m, src)
+}
+func (m *GetStateRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateRangeRequest.Size(m)
+}
+func (m *GetStateRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateRangeRequest proto.InternalMessageInfo
+
+func (m *GetStateRangeRequest) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *GetStateRangeRequest) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *GetStateRangeRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetStateRangeResponse struct {
+	Status               ResultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Keys                 [][]byte     `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	Values               [][]byte     `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Data                 [][]byte     `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	HasNext              bool         `protobuf:"varint,5,opt,name=hasNext,proto3" json:"hasNext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetStateRangeResponse) Reset()         { *m = GetStateRangeResponse{} }
+func (m *GetStateRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateRangeResponse) ProtoMessage()    {}
+func (*GetStateRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{31}
+}
+func (m *GetStateRangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateRangeResponse.Unmarshal(m, b)
+}
+func (m *GetStateRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateRangeResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStateRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateRangeResponse.Merge(m, src)
+}
+func (m *GetStateRangeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateRangeResponse.Size(m)
+}
+func (m *GetStateRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateRangeResponse proto.InternalMessageInfo
+
+func (m *GetStateRangeResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetStateRangeResponse) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetStateRangeResponse) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *GetStateRangeResponse) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetStateRangeResponse) GetHasNext() bool {
+	if m != nil {
+		return m.HasNext
+	}
+	return false
+}
+
+type GetStateDataRequest struct {
+	Hashes               [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateDataRequest) Reset()         { *m = GetStateDataRequest{} }
+func (m *GetStateDataRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateDataRequest) ProtoMessage()    {}
+func (*GetStateDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{32}
+}
+func (m *GetStateDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateDataRequest.Unmarshal(m, b)
+}
+func (m *GetStateDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateDataRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStateDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateDataRequest.Merge(m, src)
+}
+func (m *GetStateDataRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateDataRequest.Size(m)
+}
+func (m *GetStateDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateDataRequest proto.InternalMessageInfo
+
+func (m *GetStateDataRequest) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type GetStateDataResponse struct {
+	Status               ResultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Data                 [][]byte     `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetStateDataResponse) Reset()         { *m = GetStateDataResponse{} }
+func (m *GetStateDataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateDataResponse) ProtoMessage()    {}
+func (*GetStateDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{33}
+}
+func (m *GetStateDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateDataResponse.Unmarshal(m, b)
+}
+func (m *GetStateDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateDataResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStateDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateDataResponse.Merge(m, src)
+}
+func (m *GetStateDataResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateDataResponse.Size(m)
+}
+func (m *GetStateDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateDataResponse proto.InternalMessageInfo
+
+func (m *GetStateDataResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetStateDataResponse) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type GetContractDBRequest struct {
+	AccountID            []byte   `protobuf:"bytes,1,opt,name=accountID,proto3" json:"accountID,omitempty"`
+	Offset               uint64   `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Size                 uint32   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	RecoveryPoint        uint64   `protobuf:"varint,4,opt,name=recoveryPoint,proto3" json:"recoveryPoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetContractDBRequest) Reset()         { *m = GetContractDBRequest{} }
+func (m *GetContractDBRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractDBRequest) ProtoMessage()    {}
+func (*GetContractDBRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{34}
+}
+func (m *GetContractDBRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractDBRequest.Unmarshal(m, b)
+}
+func (m *GetContractDBRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractDBRequest.Marshal(b, m, deterministic)
+}
+func (m *GetContractDBRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractDBRequest.Merge(m, src)
+}
+func (m *GetContractDBRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractDBRequest.Size(m)
+}
+func (m *GetContractDBRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractDBRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractDBRequest proto.InternalMessageInfo
+
+func (m *GetContractDBRequest) GetAccountID() []byte {
+	if m != nil {
+		return m.AccountID
+	}
+	return nil
+}
+
+func (m *GetContractDBRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetContractDBRequest) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetContractDBRequest) GetRecoveryPoint() uint64 {
+	if m != nil {
+		return m.RecoveryPoint
+	}
+	return 0
+}
+
+type GetContractDBResponse struct {
+	Status               ResultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=types.ResultStatus" json:"status,omitempty"`
+	Data                 []byte       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Total                uint64       `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetContractDBResponse) Reset()         { *m = GetContractDBResponse{} }
+func (m *GetContractDBResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractDBResponse) ProtoMessage()    {}
+func (*GetContractDBResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fdddb109e6467a, []int{35}
+}
+func (m *GetContractDBResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractDBResponse.Unmarshal(m, b)
+}
+func (m *GetContractDBResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractDBResponse.Marshal(b, m, deterministic)
+}
+func (m *GetContractDBResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractDBResponse.Merge(m, src)
+}
+func (m *GetContractDBResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContractDBResponse.Size(m)
+}
+func (m *GetContractDBResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractDBResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractDBResponse proto.InternalMessageInfo
+
+func (m *GetContractDBResponse) GetStatus() ResultStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ResultStatus_OK
+}
+
+func (m *GetContractDBResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetContractDBResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgHeader)(nil), "types.MsgHeader")
 	proto.RegisterType((*P2PMessage)(nil), "types.P2PMessage")
@@ -1428,86 +2053,119 @@ func init() {
 	proto.RegisterType((*GetHashByNoResponse)(nil), "types.GetHashByNoResponse")
 	proto.RegisterType((*GetHashesRequest)(nil), "types.GetHashesRequest")
 	proto.RegisterType((*GetHashesResponse)(nil), "types.GetHashesResponse")
+	proto.RegisterType((*GetLightHeadersRequest)(nil), "types.GetLightHeadersRequest")
+	proto.RegisterType((*GetLightHeadersResponse)(nil), "types.GetLightHeadersResponse")
+	proto.RegisterType((*GetReceiptProofRequest)(nil), "types.GetReceiptProofRequest")
+	proto.RegisterType((*GetReceiptProofResponse)(nil), "types.GetReceiptProofResponse")
+	proto.RegisterType((*GetStateProofRequest)(nil), "types.GetStateProofRequest")
+	proto.RegisterType((*GetStateProofResponse)(nil), "types.GetStateProofResponse")
+	proto.RegisterType((*GetStateRangeRequest)(nil), "types.GetStateRangeRequest")
+	proto.RegisterType((*GetStateRangeResponse)(nil), "types.GetStateRangeResponse")
+	proto.RegisterType((*GetStateDataRequest)(nil), "types.GetStateDataRequest")
+	proto.RegisterType((*GetStateDataResponse)(nil), "types.GetStateDataResponse")
+	proto.RegisterType((*GetContractDBRequest)(nil), "types.GetContractDBRequest")
+	proto.RegisterType((*GetContractDBResponse)(nil), "types.GetContractDBResponse")
 	proto.RegisterEnum("types.ResultStatus", ResultStatus_name, ResultStatus_value)
 }
 
 func init() { proto.RegisterFile("p2p.proto", fileDescriptor_e7fdddb109e6467a) }
 
 var fileDescriptor_e7fdddb109e6467a = []byte{
-	// 1189 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x72, 0xda, 0xc6,
-	0x17, 0xfe, 0x09, 0x30, 0x86, 0x83, 0xb0, 0xe5, 0xf5, 0x2f, 0x89, 0xc6, 0xcd, 0xa4, 0x8c, 0x26,
-	0xd3, 0xd2, 0x34, 0x93, 0xe9, 0x38, 0x4f, 0x20, 0x5b, 0x1b, 0x50, 0x83, 0x57, 0xcc, 0x22, 0xd2,
-	0xf4, 0x8a, 0x0a, 0xd8, 0x80, 0x5a, 0x5b, 0x52, 0xb5, 0x22, 0xc1, 0xb9, 0xe9, 0x4c, 0x2f, 0xfa,
-	0x06, 0x7d, 0x85, 0x3e, 0x46, 0x9f, 0xa6, 0xaf, 0xd1, 0x99, 0xce, 0xae, 0x56, 0x20, 0xf2, 0xa7,
-	0x9e, 0x7a, 0x72, 0xb7, 0xdf, 0xd9, 0xa3, 0xef, 0xfc, 0xfb, 0xf6, 0x00, 0x34, 0x93, 0xd3, 0xe4,
-	0x49, 0x92, 0xc6, 0x59, 0x8c, 0xf6, 0xb2, 0xeb, 0x84, 0xf1, 0x13, 0x63, 0x7a, 0x19, 0xcf, 0x7e,
-	0x9a, 0x2d, 0x83, 0x30, 0xca, 0x2f, 0x4e, 0x20, 0x8a, 0xe7, 0x2c, 0x3f, 0x5b, 0x7f, 0x6b, 0xd0,
-	0xbc, 0xe0, 0x8b, 0x3e, 0x0b, 0xe6, 0x2c, 0x45, 0x0f, 0xa1, 0x3d, 0xbb, 0x0c, 0x59, 0x94, 0xbd,
-	0x60, 0x29, 0x0f, 0xe3, 0xc8, 0xd4, 0x3a, 0x5a, 0xb7, 0x49, 0x77, 0x8d, 0xe8, 0x3e, 0x34, 0xb3,
-	0xf0, 0x8a, 0xf1, 0x2c, 0xb8, 0x4a, 0xcc, 0x4a, 0x47, 0xeb, 0x56, 0xe9, 0xd6, 0x80, 0x0e, 0xa0,
-	0x12, 0xce, 0xcd, 0xaa, 0xfc, 0xb0, 0x12, 0xce, 0xd1, 0x5d, 0xa8, 0x2f, 0x62, 0xce, 0xc3, 0xc4,
-	0xac, 0x75, 0xb4, 0x6e, 0x83, 0x2a, 0x24, 0xec, 0x09, 0x63, 0xa9, 0xeb, 0x98, 0x7b, 0x1d, 0xad,
-	0xab, 0x53, 0x85, 0xd0, 0x03, 0x90, 0xf9, 0x0d, 0x57, 0xd3, 0xe7, 0xec, 0xda, 0xac, 0xcb, 0xbb,
-	0x92, 0x05, 0x21, 0xa8, 0xf1, 0x70, 0x11, 0x99, 0xfb, 0xf2, 0x46, 0x9e, 0x51, 0x07, 0x5a, 0x7c,
-	0x35, 0x95, 0x15, 0xcd, 0xe2, 0x4b, 0xb3, 0xd1, 0xd1, 0xba, 0x6d, 0x5a, 0x36, 0x89, 0x68, 0x97,
-	0x2c, 0x5a, 0x64, 0x4b, 0xb3, 0x29, 0x2f, 0x15, 0xb2, 0xbe, 0x05, 0x18, 0x9e, 0x0e, 0x2f, 0x18,
-	0xe7, 0xc1, 0x82, 0xa1, 0x2e, 0xd4, 0x97, 0xb2, 0x13, 0xb2, 0xf0, 0xd6, 0xa9, 0xf1, 0x44, 0xf6,
-	0xf0, 0xc9, 0xa6, 0x43, 0x54, 0xdd, 0x8b, 0x2c, 0xe6, 0x41, 0x16, 0xc8, 0xf2, 0x75, 0x2a, 0xcf,
-	0x96, 0x07, 0xb5, 0x61, 0x18, 0x2d, 0xd0, 0x17, 0x70, 0x38, 0x65, 0x3c, 0x9b, 0xc8, 0xc6, 0x4f,
-	0x96, 0x01, 0x5f, 0x4a, 0x3a, 0x9d, 0xb6, 0x85, 0xf9, 0x4c, 0x58, 0xfb, 0x01, 0x5f, 0xa2, 0xcf,
-	0xa1, 0x25, 0xfd, 0x96, 0x2c, 0x5c, 0x2c, 0x33, 0x49, 0x55, 0xa3, 0x20, 0x4c, 0x7d, 0x69, 0xb1,
-	0x06, 0x50, 0x1b, 0xc6, 0xd1, 0x42, 0x8c, 0x65, 0xe7, 0xcb, 0x0f, 0xd3, 0x3d, 0x80, 0xd2, 0xb7,
-	0x1f, 0x60, 0xfb, 0x4b, 0x83, 0xfa, 0x28, 0x0b, 0xb2, 0x15, 0x47, 0x8f, 0xa0, 0xce, 0x59, 0xb4,
-	0xad, 0x13, 0xa9, 0x3a, 0x87, 0x8c, 0xa5, 0xf6, 0x7c, 0x9e, 0x32, 0xce, 0xa9, 0xf2, 0x78, 0x3f,
-	0x78, 0xe5, 0xe6, 0xe0, 0xd5, 0x77, 0x83, 0x23, 0x13, 0xf6, 0xa5, 0x04, 0x5d, 0x47, 0xca, 0x40,
-	0xa7, 0x05, 0x44, 0x27, 0xd0, 0x88, 0x62, 0xbc, 0x4e, 0x62, 0xce, 0xa4, 0x12, 0x1a, 0x74, 0x83,
-	0xc5, 0x57, 0xaf, 0x95, 0x12, 0xeb, 0x52, 0x50, 0x05, 0x14, 0x37, 0x0b, 0x16, 0x31, 0x1e, 0x72,
-	0x25, 0x84, 0x02, 0x5a, 0x5d, 0xd0, 0x7b, 0xb1, 0xfd, 0x26, 0xb8, 0x26, 0x71, 0x16, 0xce, 0x24,
-	0xc7, 0x55, 0x3e, 0x5e, 0xa5, 0xe6, 0x02, 0x5a, 0x2f, 0xc1, 0x50, 0xc5, 0x32, 0x4e, 0xd9, 0xcf,
-	0x2b, 0xc6, 0xb3, 0xff, 0xd4, 0x19, 0xc1, 0x1c, 0xac, 0x47, 0xe1, 0x5b, 0x26, 0x7b, 0xd2, 0xa6,
-	0x05, 0xb4, 0x7e, 0x84, 0xa3, 0x12, 0x33, 0x4f, 0xe2, 0x88, 0x33, 0xf4, 0x35, 0xd4, 0xb9, 0x6c,
-	0xbf, 0xa4, 0x3e, 0x38, 0x3d, 0x56, 0xd4, 0x94, 0xf1, 0xd5, 0x65, 0x96, 0x4f, 0x86, 0x2a, 0x17,
-	0xd4, 0x85, 0x3d, 0xf1, 0x1e, 0xb8, 0x59, 0xe9, 0x54, 0x3f, 0x92, 0x46, 0xee, 0x60, 0xf5, 0xe1,
-	0x80, 0xb0, 0x37, 0x72, 0x12, 0xaa, 0xe2, 0xfb, 0xd0, 0x9c, 0xbe, 0x23, 0x95, 0xad, 0x41, 0x64,
-	0x3d, 0xcd, 0x9d, 0x95, 0x46, 0x0a, 0x68, 0x71, 0x38, 0x96, 0x34, 0xc3, 0x34, 0x9e, 0xaf, 0x66,
-	0x6c, 0xae, 0xe8, 0x1e, 0x00, 0x24, 0xb9, 0x45, 0x3c, 0xd6, 0x9c, 0xaf, 0x64, 0xf9, 0x38, 0x21,
-	0xb2, 0x60, 0x4f, 0x1e, 0xa5, 0x1e, 0x5a, 0xa7, 0xba, 0x2a, 0x42, 0x06, 0xa1, 0xf9, 0x95, 0xf5,
-	0xab, 0x06, 0x77, 0x7b, 0x4c, 0x29, 0x49, 0xbe, 0xad, 0xcd, 0x2c, 0x10, 0xd4, 0x4a, 0x8f, 0x47,
-	0x9e, 0xc5, 0x3b, 0xde, 0x79, 0x2e, 0x0a, 0x09, 0x7b, 0xfc, 0xea, 0x15, 0x67, 0x85, 0xf6, 0x14,
-	0xca, 0xb7, 0xc5, 0x5b, 0x26, 0x45, 0xd7, 0xa6, 0xf2, 0x8c, 0x0c, 0xa8, 0x06, 0x7c, 0xa6, 0xc4,
-	0x26, 0x8e, 0xd6, 0x1f, 0x1a, 0xdc, 0x7b, 0x2f, 0x89, 0xdb, 0x8c, 0x4d, 0xa4, 0x17, 0xf0, 0x25,
-	0xcb, 0xe7, 0xa6, 0x53, 0x85, 0xd0, 0x63, 0xd8, 0xcf, 0x17, 0x07, 0x37, 0xab, 0x3b, 0x03, 0x2d,
-	0x85, 0xa4, 0x85, 0x8b, 0xe8, 0xe8, 0x32, 0xe0, 0x84, 0xad, 0x33, 0xb5, 0x33, 0x0b, 0x68, 0x7d,
-	0x05, 0x87, 0x45, 0x9e, 0x45, 0x97, 0xb6, 0x21, 0xb5, 0x72, 0x48, 0xeb, 0x17, 0x30, 0xb6, 0xae,
-	0xb7, 0xa9, 0xe5, 0x21, 0xd4, 0xe5, 0x88, 0x0a, 0x0d, 0xee, 0x8e, 0x4f, 0xdd, 0x95, 0x73, 0xad,
-	0xee, 0xe6, 0xfa, 0x14, 0xee, 0x10, 0xf6, 0xc6, 0x4f, 0x83, 0x88, 0x07, 0xb3, 0x2c, 0x8c, 0x23,
-	0xae, 0x04, 0x75, 0x02, 0x8d, 0x6c, 0xdd, 0x2f, 0xe7, 0xbc, 0xc1, 0xd6, 0x37, 0x52, 0x0d, 0xe5,
-	0x8f, 0x6e, 0xaa, 0xf3, 0xf7, 0x7c, 0x76, 0xbb, 0x9f, 0x7c, 0xca, 0xd9, 0x7d, 0x06, 0xd5, 0x6c,
-	0x5d, 0xcc, 0xad, 0xa9, 0x18, 0xfc, 0x35, 0x15, 0xd6, 0x7f, 0x19, 0x55, 0x0f, 0x8e, 0x7a, 0x2c,
-	0xbb, 0x08, 0x39, 0x0f, 0xa3, 0xc5, 0x0d, 0x45, 0x88, 0x96, 0xf0, 0x2c, 0x4e, 0x96, 0xdb, 0xfd,
-	0xba, 0xc1, 0xd6, 0x63, 0x40, 0x3d, 0x96, 0xd9, 0xd1, 0x8c, 0xf1, 0x2c, 0x4e, 0x6f, 0x6a, 0xc7,
-	0x6f, 0x1a, 0x1c, 0xef, 0xb8, 0xdf, 0xa6, 0x15, 0x16, 0xe8, 0x81, 0x22, 0x28, 0xad, 0xfc, 0x1d,
-	0x9b, 0x58, 0x0b, 0x05, 0x26, 0x71, 0xb1, 0xf1, 0xb7, 0x16, 0xeb, 0x4b, 0x68, 0xf5, 0x58, 0x26,
-	0x5c, 0xcf, 0xae, 0x49, 0x5c, 0xde, 0x12, 0xda, 0xee, 0xda, 0xf9, 0x41, 0x26, 0x5c, 0x38, 0xde,
-	0x2e, 0xe1, 0x9d, 0x95, 0x57, 0x79, 0x67, 0xe5, 0x59, 0x53, 0xf9, 0x14, 0x72, 0x85, 0x15, 0xfd,
-	0x3b, 0x81, 0x46, 0x92, 0xb2, 0xd7, 0xa5, 0x1d, 0xb9, 0xc1, 0xf9, 0xc6, 0x63, 0xaf, 0xc9, 0xea,
-	0x6a, 0xca, 0xd2, 0xe2, 0x97, 0x74, 0x6b, 0xd9, 0x2c, 0x95, 0xbc, 0x68, 0x79, 0xb6, 0x52, 0x39,
-	0xee, 0x22, 0xc6, 0xa7, 0xd4, 0xdf, 0x47, 0x5f, 0xd8, 0xa3, 0x3f, 0x2b, 0xa0, 0x97, 0xa9, 0x50,
-	0x1d, 0x2a, 0xde, 0x73, 0xe3, 0x7f, 0x48, 0x87, 0xc6, 0xb9, 0x4d, 0xce, 0xf1, 0x00, 0x3b, 0x86,
-	0x86, 0x5a, 0xb0, 0x3f, 0x26, 0xcf, 0x89, 0xf7, 0x1d, 0x31, 0x2a, 0xe8, 0xff, 0x60, 0xb8, 0xe4,
-	0x85, 0x3d, 0x70, 0x9d, 0x89, 0x4d, 0x7b, 0xe3, 0x0b, 0x4c, 0x7c, 0xa3, 0x8a, 0xee, 0xc0, 0x91,
-	0x83, 0x6d, 0x67, 0xe0, 0x12, 0x3c, 0xc1, 0x2f, 0xcf, 0x31, 0x76, 0xb0, 0x63, 0xd4, 0x50, 0x1b,
-	0x9a, 0xc4, 0xf3, 0x27, 0xcf, 0xbc, 0x31, 0x71, 0x8c, 0x3d, 0x84, 0xe0, 0xc0, 0x1e, 0x50, 0x6c,
-	0x3b, 0xdf, 0x4f, 0xf0, 0x4b, 0x77, 0xe4, 0x8f, 0x8c, 0xba, 0xf8, 0x72, 0x88, 0xe9, 0x85, 0x3b,
-	0x1a, 0xb9, 0x1e, 0x99, 0x38, 0x98, 0xb8, 0xd8, 0x31, 0xf6, 0xd1, 0x5d, 0x40, 0x14, 0x8f, 0xbc,
-	0x31, 0x3d, 0x17, 0x84, 0x7d, 0x7b, 0x3c, 0xf2, 0xb1, 0x63, 0x34, 0xd0, 0x3d, 0x38, 0x7e, 0x66,
-	0xbb, 0x03, 0xec, 0x4c, 0x86, 0x14, 0x9f, 0x7b, 0xc4, 0x71, 0x7d, 0xd7, 0x23, 0x46, 0x53, 0x24,
-	0x69, 0x9f, 0x79, 0x54, 0x78, 0x01, 0x32, 0x40, 0xf7, 0xc6, 0xfe, 0xc4, 0x7b, 0x36, 0xa1, 0x36,
-	0xe9, 0x61, 0xa3, 0x85, 0x8e, 0xa0, 0x3d, 0x26, 0xee, 0xc5, 0x70, 0x80, 0x45, 0xc6, 0xd8, 0x31,
-	0x74, 0x51, 0xa4, 0x4b, 0x7c, 0x4c, 0x89, 0x3d, 0x30, 0xda, 0xe8, 0x10, 0x5a, 0x63, 0x62, 0xbf,
-	0xb0, 0xdd, 0x81, 0x7d, 0x36, 0xc0, 0xc6, 0x81, 0xc8, 0xdd, 0xb1, 0x7d, 0x7b, 0x32, 0xf0, 0x46,
-	0x23, 0xe3, 0x10, 0x1d, 0xc3, 0xe1, 0x98, 0xd8, 0x63, 0xbf, 0x8f, 0x89, 0xef, 0x9e, 0xdb, 0x82,
-	0xc2, 0x98, 0xd6, 0xe5, 0xff, 0xc3, 0xa7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x9d, 0x20,
-	0xb5, 0x36, 0x0b, 0x00, 0x00,
+	// 1521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x73, 0xda, 0x48,
+	0x16, 0x5f, 0xfe, 0x1a, 0x1e, 0x60, 0xcb, 0xed, 0x7f, 0x94, 0x37, 0x95, 0x75, 0xa9, 0x52, 0xbb,
+	0x24, 0x9b, 0x4d, 0x6d, 0x39, 0x9f, 0x40, 0xb6, 0x64, 0xac, 0x35, 0x16, 0x6c, 0x03, 0x4e, 0xf6,
+	0xc4, 0x0a, 0xd1, 0x01, 0x6d, 0xb0, 0x9a, 0xa8, 0x1b, 0xc7, 0xe4, 0xb2, 0x55, 0x73, 0xc8, 0x79,
+	0x2e, 0x73, 0x9d, 0xe3, 0x7c, 0x8c, 0xf9, 0x66, 0x53, 0x35, 0xd5, 0xad, 0x16, 0x48, 0x76, 0x32,
+	0xd4, 0x30, 0xb9, 0xf5, 0xef, 0xf5, 0xeb, 0xf7, 0xf7, 0xd7, 0x4f, 0x2d, 0x28, 0xcf, 0x4e, 0x67,
+	0xaf, 0x66, 0x21, 0xe5, 0x14, 0x15, 0xf8, 0x62, 0x46, 0xd8, 0xb1, 0x36, 0x9c, 0x52, 0xef, 0xbd,
+	0x37, 0x71, 0xfd, 0x20, 0xda, 0x38, 0x86, 0x80, 0x8e, 0x48, 0xb4, 0xd6, 0x7f, 0xc9, 0x40, 0xf9,
+	0x9a, 0x8d, 0x2f, 0x89, 0x3b, 0x22, 0x21, 0x7a, 0x06, 0x35, 0x6f, 0xea, 0x93, 0x80, 0xdf, 0x90,
+	0x90, 0xf9, 0x34, 0xa8, 0x67, 0x4e, 0x32, 0x8d, 0x32, 0x4e, 0x0b, 0xd1, 0x13, 0x28, 0x73, 0xff,
+	0x96, 0x30, 0xee, 0xde, 0xce, 0xea, 0xd9, 0x93, 0x4c, 0x23, 0x87, 0x57, 0x02, 0xb4, 0x0d, 0x59,
+	0x7f, 0x54, 0xcf, 0xc9, 0x83, 0x59, 0x7f, 0x84, 0x0e, 0xa1, 0x38, 0xa6, 0x8c, 0xf9, 0xb3, 0x7a,
+	0xfe, 0x24, 0xd3, 0x28, 0x61, 0x85, 0x84, 0x7c, 0x46, 0x48, 0x68, 0x9b, 0xf5, 0xc2, 0x49, 0xa6,
+	0x51, 0xc5, 0x0a, 0xa1, 0xa7, 0x20, 0xe3, 0xeb, 0xcc, 0x87, 0x57, 0x64, 0x51, 0x2f, 0xca, 0xbd,
+	0x84, 0x04, 0x21, 0xc8, 0x33, 0x7f, 0x1c, 0xd4, 0xb7, 0xe4, 0x8e, 0x5c, 0xa3, 0x13, 0xa8, 0xb0,
+	0xf9, 0x50, 0x66, 0xe4, 0xd1, 0x69, 0xbd, 0x74, 0x92, 0x69, 0xd4, 0x70, 0x52, 0x24, 0xbc, 0x4d,
+	0x49, 0x30, 0xe6, 0x93, 0x7a, 0x59, 0x6e, 0x2a, 0xa4, 0xff, 0x0b, 0xa0, 0x73, 0xda, 0xb9, 0x26,
+	0x8c, 0xb9, 0x63, 0x82, 0x1a, 0x50, 0x9c, 0xc8, 0x4a, 0xc8, 0xc4, 0x2b, 0xa7, 0xda, 0x2b, 0x59,
+	0xc3, 0x57, 0xcb, 0x0a, 0x61, 0xb5, 0x2f, 0xa2, 0x18, 0xb9, 0xdc, 0x95, 0xe9, 0x57, 0xb1, 0x5c,
+	0xeb, 0x6d, 0xc8, 0x77, 0xfc, 0x60, 0x8c, 0xfe, 0x0a, 0x3b, 0x43, 0xc2, 0xf8, 0x40, 0x16, 0x7e,
+	0x30, 0x71, 0xd9, 0x44, 0x9a, 0xab, 0xe2, 0x9a, 0x10, 0x9f, 0x09, 0xe9, 0xa5, 0xcb, 0x26, 0xe8,
+	0x2f, 0x50, 0x91, 0x7a, 0x13, 0xe2, 0x8f, 0x27, 0x5c, 0x9a, 0xca, 0x63, 0x10, 0xa2, 0x4b, 0x29,
+	0xd1, 0x5b, 0x90, 0xef, 0xd0, 0x60, 0x2c, 0xda, 0x92, 0x3a, 0xf9, 0x65, 0x73, 0x4f, 0x21, 0x71,
+	0xf6, 0x0b, 0xd6, 0x3e, 0x67, 0xa1, 0xd8, 0xe5, 0x2e, 0x9f, 0x33, 0xf4, 0x02, 0x8a, 0x8c, 0x04,
+	0xab, 0x3c, 0x91, 0xca, 0xb3, 0x43, 0x48, 0x68, 0x8c, 0x46, 0x21, 0x61, 0x0c, 0x2b, 0x8d, 0xc7,
+	0xce, 0xb3, 0xeb, 0x9d, 0xe7, 0x1e, 0x3a, 0x47, 0x75, 0xd8, 0x92, 0x14, 0xb4, 0x4d, 0x49, 0x83,
+	0x2a, 0x8e, 0x21, 0x3a, 0x86, 0x52, 0x40, 0xad, 0xfb, 0x19, 0x65, 0x44, 0x32, 0xa1, 0x84, 0x97,
+	0x58, 0x9c, 0xba, 0x53, 0x4c, 0x2c, 0x4a, 0x42, 0xc5, 0x50, 0xec, 0x8c, 0x49, 0x40, 0x98, 0xcf,
+	0x14, 0x11, 0x62, 0x28, 0xb9, 0xb0, 0x08, 0xbc, 0xa8, 0x5f, 0x4c, 0x72, 0xa1, 0x84, 0x93, 0x22,
+	0xbd, 0x01, 0xd5, 0x26, 0x35, 0x3e, 0xba, 0x0b, 0x87, 0x72, 0xdf, 0x93, 0x5e, 0x6e, 0x23, 0x02,
+	0x28, 0xbe, 0xc7, 0x50, 0x7f, 0x0b, 0x9a, 0x2a, 0x07, 0x61, 0x98, 0x7c, 0x98, 0x13, 0xc6, 0x7f,
+	0x57, 0xed, 0x84, 0x65, 0xf7, 0xbe, 0xeb, 0x7f, 0x22, 0xb2, 0x6a, 0x35, 0x1c, 0x43, 0xfd, 0x7f,
+	0xb0, 0x9b, 0xb0, 0xcc, 0x66, 0x34, 0x60, 0x04, 0xfd, 0x1d, 0x8a, 0x4c, 0x36, 0x48, 0x9a, 0xde,
+	0x3e, 0xdd, 0x53, 0xa6, 0x31, 0x61, 0xf3, 0x29, 0x8f, 0x7a, 0x87, 0x95, 0x0a, 0x6a, 0x40, 0x41,
+	0xdc, 0x18, 0x56, 0xcf, 0x9e, 0xe4, 0xbe, 0x12, 0x46, 0xa4, 0xa0, 0x5f, 0xc2, 0xb6, 0x43, 0x3e,
+	0xca, 0x5e, 0xa9, 0x8c, 0x9f, 0x40, 0x79, 0xf8, 0x80, 0x4c, 0x2b, 0x81, 0x88, 0x7a, 0x18, 0x29,
+	0x2b, 0x16, 0xc5, 0x50, 0x67, 0xb0, 0x27, 0xcd, 0x74, 0x42, 0x3a, 0x9a, 0x7b, 0x64, 0xa4, 0xcc,
+	0x3d, 0x05, 0x98, 0x45, 0x12, 0x71, 0x9d, 0x23, 0x7b, 0x09, 0xc9, 0xd7, 0x0d, 0x22, 0x1d, 0x0a,
+	0x72, 0x29, 0x19, 0x53, 0x39, 0xad, 0xaa, 0x24, 0xa4, 0x13, 0x1c, 0x6d, 0xe9, 0xdf, 0x65, 0xe0,
+	0xb0, 0x49, 0x14, 0xd7, 0xa2, 0x16, 0xc6, 0xbd, 0x40, 0x90, 0x4f, 0x5c, 0x2f, 0xb9, 0x16, 0x37,
+	0x3d, 0x75, 0xa1, 0x14, 0x12, 0x72, 0xfa, 0xee, 0x1d, 0x23, 0x31, 0x3b, 0x15, 0x8a, 0xe6, 0xc9,
+	0x27, 0x22, 0x69, 0x59, 0xc3, 0x72, 0x8d, 0x34, 0xc8, 0xb9, 0xcc, 0x53, 0x74, 0x14, 0x4b, 0xfd,
+	0xa7, 0x0c, 0x1c, 0x3d, 0x0a, 0x62, 0x93, 0xb6, 0x89, 0xf0, 0x5c, 0x36, 0x21, 0x51, 0xdf, 0xaa,
+	0x58, 0x21, 0xf4, 0x12, 0xb6, 0x26, 0x8a, 0xb2, 0xb9, 0x54, 0x43, 0x13, 0x2e, 0x71, 0xac, 0x22,
+	0x2a, 0x3a, 0x71, 0x99, 0x43, 0xee, 0xb9, 0x9a, 0xaa, 0x31, 0xd4, 0x9f, 0xc3, 0x4e, 0x1c, 0x67,
+	0x5c, 0xa5, 0x95, 0xcb, 0x4c, 0xd2, 0xa5, 0xfe, 0x7f, 0xd0, 0x56, 0xaa, 0x9b, 0xe4, 0xf2, 0x0c,
+	0x8a, 0xb2, 0x45, 0x31, 0x07, 0xd3, 0xed, 0x53, 0x7b, 0xc9, 0x58, 0x73, 0xe9, 0x58, 0x5f, 0xc3,
+	0x81, 0x43, 0x3e, 0xf6, 0x42, 0x37, 0x60, 0xae, 0xc7, 0x7d, 0x1a, 0x30, 0x45, 0xa8, 0x63, 0x28,
+	0xf1, 0xfb, 0xcb, 0x64, 0xcc, 0x4b, 0xac, 0xff, 0x53, 0xb2, 0x21, 0x79, 0x68, 0x5d, 0x9e, 0x3f,
+	0x44, 0xbd, 0x4b, 0x1f, 0xf9, 0x96, 0xbd, 0xfb, 0x33, 0xe4, 0xf8, 0x7d, 0xdc, 0xb7, 0xb2, 0xb2,
+	0xd0, 0xbb, 0xc7, 0x42, 0xfa, 0x1b, 0xad, 0x6a, 0xc2, 0x6e, 0x93, 0xf0, 0x6b, 0x9f, 0x31, 0x3f,
+	0x18, 0xaf, 0x49, 0x42, 0x94, 0x84, 0x71, 0x3a, 0x9b, 0xac, 0x26, 0xf0, 0x12, 0xeb, 0x2f, 0x01,
+	0x35, 0x09, 0x37, 0x02, 0x8f, 0x30, 0x4e, 0xc3, 0x75, 0xe5, 0xf8, 0x9c, 0x81, 0xbd, 0x94, 0xfa,
+	0x26, 0xa5, 0xd0, 0xa1, 0xea, 0x2a, 0x03, 0x89, 0x8f, 0x42, 0x4a, 0x26, 0xc6, 0x42, 0x8c, 0x1d,
+	0x1a, 0x7f, 0x13, 0x56, 0x12, 0xfd, 0x6f, 0x50, 0x69, 0x12, 0x2e, 0x54, 0xcf, 0x16, 0x0e, 0x4d,
+	0x4e, 0x89, 0x4c, 0x7a, 0xec, 0xfc, 0x17, 0xf6, 0x12, 0x8a, 0x9b, 0x05, 0x9c, 0x1a, 0x79, 0xd9,
+	0x07, 0x23, 0x4f, 0x1f, 0xca, 0xab, 0x10, 0x31, 0x2c, 0xae, 0xdf, 0x31, 0x94, 0x66, 0x21, 0xb9,
+	0x4b, 0xcc, 0xc8, 0x25, 0x8e, 0x26, 0x1e, 0xb9, 0x73, 0xe6, 0xb7, 0x43, 0x12, 0xc6, 0xdf, 0xda,
+	0x95, 0x64, 0x39, 0x54, 0xa2, 0xa4, 0xe5, 0x5a, 0x0f, 0x65, 0xbb, 0x63, 0x1f, 0xdf, 0x92, 0x7f,
+	0x5f, 0xbf, 0x61, 0x17, 0xf2, 0xb2, 0xb4, 0xc4, 0x00, 0x7c, 0x30, 0x3a, 0xeb, 0xb0, 0xc5, 0xb8,
+	0x1b, 0xf2, 0x55, 0xb5, 0x15, 0x5c, 0xc6, 0x9e, 0x5d, 0x0d, 0x44, 0x9d, 0xc3, 0xd1, 0x23, 0x3b,
+	0x9b, 0x64, 0x90, 0x98, 0x72, 0xd9, 0xb5, 0x53, 0x4e, 0x77, 0x64, 0xf4, 0x98, 0x78, 0xc4, 0x9f,
+	0xf1, 0x4e, 0x48, 0xe9, 0xbb, 0x04, 0xb7, 0xa3, 0x81, 0xa0, 0x3a, 0xa3, 0xd0, 0x9a, 0x2e, 0x7f,
+	0x80, 0xa3, 0x47, 0xf6, 0x36, 0xc9, 0xe2, 0x39, 0x14, 0x66, 0xe2, 0xb4, 0xf4, 0x50, 0x49, 0xe8,
+	0x26, 0x0c, 0x47, 0x1a, 0xfa, 0xf7, 0x19, 0xd8, 0x6f, 0x12, 0x69, 0x80, 0xa4, 0x32, 0x58, 0xfb,
+	0x09, 0x76, 0x3d, 0x8f, 0xce, 0x03, 0xae, 0xb2, 0x88, 0xa1, 0x7c, 0xde, 0x70, 0x1a, 0xba, 0x63,
+	0x72, 0x45, 0x16, 0xd1, 0xcc, 0xa9, 0xe2, 0xa4, 0x48, 0x70, 0xd3, 0xa3, 0xb7, 0x33, 0xf9, 0xb6,
+	0x18, 0xa9, 0x99, 0x93, 0x90, 0xe8, 0x21, 0x1c, 0x3c, 0x88, 0x68, 0xb3, 0x4e, 0xa6, 0x6a, 0x70,
+	0xa8, 0x74, 0xa5, 0xd9, 0x7f, 0xcf, 0x49, 0xb8, 0x48, 0x95, 0xe1, 0x66, 0x55, 0x05, 0xec, 0x06,
+	0x63, 0x92, 0xf8, 0x80, 0x87, 0x94, 0xf2, 0xf8, 0x03, 0x2e, 0xd6, 0x68, 0x1f, 0x0a, 0x92, 0x8a,
+	0x2a, 0xf3, 0x08, 0x08, 0xe9, 0xd4, 0xbf, 0xf5, 0x23, 0x86, 0xd7, 0x70, 0x04, 0xf4, 0x1f, 0x33,
+	0x70, 0xf0, 0xc0, 0xf0, 0x26, 0xc9, 0x20, 0xc8, 0xbf, 0x27, 0x8b, 0x88, 0x93, 0x55, 0x2c, 0xd7,
+	0x82, 0x62, 0x77, 0xee, 0x74, 0x4e, 0xe2, 0x1a, 0x2b, 0xb4, 0x7c, 0xf9, 0xe7, 0x23, 0x5d, 0xb1,
+	0x4e, 0x5e, 0xc0, 0x42, 0xfa, 0x02, 0xfe, 0x43, 0x8e, 0x2e, 0x19, 0x9f, 0xe9, 0x72, 0x77, 0xdd,
+	0x6c, 0x7e, 0x03, 0xfb, 0x69, 0xf5, 0x0d, 0xb3, 0x51, 0xff, 0x26, 0xcb, 0x08, 0xc5, 0xd0, 0x17,
+	0x96, 0xcf, 0x69, 0xc0, 0x43, 0xd7, 0xe3, 0xe6, 0x59, 0x82, 0x87, 0x8a, 0x5a, 0xcb, 0xa7, 0xdb,
+	0x4a, 0x90, 0x78, 0x34, 0x65, 0xbf, 0xf8, 0x68, 0xca, 0x25, 0x1e, 0x4d, 0xcf, 0xa0, 0x16, 0x12,
+	0x8f, 0xde, 0x89, 0xde, 0x53, 0x3f, 0x88, 0x3e, 0x77, 0x79, 0x9c, 0x16, 0xea, 0x01, 0x1c, 0x3c,
+	0x88, 0xe3, 0x8f, 0xa5, 0xb8, 0xfc, 0xfd, 0x12, 0x0c, 0xe1, 0x94, 0xbb, 0x53, 0x35, 0x74, 0x23,
+	0xf0, 0xe2, 0xe7, 0x2c, 0x54, 0x93, 0x26, 0x50, 0x11, 0xb2, 0xed, 0x2b, 0xed, 0x4f, 0xa8, 0x0a,
+	0xa5, 0x73, 0xc3, 0x39, 0xb7, 0x5a, 0x96, 0xa9, 0x65, 0x50, 0x05, 0xb6, 0xfa, 0xce, 0x95, 0xd3,
+	0x7e, 0xe3, 0x68, 0x59, 0xb4, 0x0f, 0x9a, 0xed, 0xdc, 0x18, 0x2d, 0xdb, 0x1c, 0x18, 0xb8, 0xd9,
+	0xbf, 0xb6, 0x9c, 0x9e, 0x96, 0x43, 0x07, 0xb0, 0x6b, 0x5a, 0x86, 0xd9, 0xb2, 0x1d, 0x6b, 0x60,
+	0xbd, 0x3d, 0xb7, 0x2c, 0xd3, 0x32, 0xb5, 0x3c, 0xaa, 0x41, 0xd9, 0x69, 0xf7, 0x06, 0x17, 0xed,
+	0xbe, 0x63, 0x6a, 0x05, 0x84, 0x60, 0xdb, 0x68, 0x61, 0xcb, 0x30, 0xff, 0x33, 0xb0, 0xde, 0xda,
+	0xdd, 0x5e, 0x57, 0x2b, 0x8a, 0x93, 0x1d, 0x0b, 0x5f, 0xdb, 0xdd, 0xae, 0xdd, 0x76, 0x06, 0xa6,
+	0xe5, 0xd8, 0x96, 0xa9, 0x6d, 0xa1, 0x43, 0x40, 0xd8, 0xea, 0xb6, 0xfb, 0xf8, 0x5c, 0x18, 0xbc,
+	0x34, 0xfa, 0xdd, 0x9e, 0x65, 0x6a, 0x25, 0x74, 0x04, 0x7b, 0x17, 0x86, 0xdd, 0xb2, 0xcc, 0x41,
+	0x07, 0x5b, 0xe7, 0x6d, 0xc7, 0xb4, 0x7b, 0x76, 0xdb, 0xd1, 0xca, 0x22, 0x48, 0xe3, 0xac, 0x8d,
+	0x85, 0x16, 0x20, 0x0d, 0xaa, 0xed, 0x7e, 0x6f, 0xd0, 0xbe, 0x18, 0x60, 0xc3, 0x69, 0x5a, 0x5a,
+	0x05, 0xed, 0x42, 0xad, 0xef, 0xd8, 0xd7, 0x9d, 0x96, 0x25, 0x22, 0xb6, 0x4c, 0xad, 0x2a, 0x92,
+	0xb4, 0x9d, 0x9e, 0x85, 0x1d, 0xa3, 0xa5, 0xd5, 0xd0, 0x0e, 0x54, 0xfa, 0x8e, 0x71, 0x63, 0xd8,
+	0x2d, 0xe3, 0xac, 0x65, 0x69, 0xdb, 0x22, 0x76, 0xd3, 0xe8, 0x19, 0x83, 0x56, 0xbb, 0xdb, 0xd5,
+	0x76, 0xd0, 0x1e, 0xec, 0xf4, 0x1d, 0xa3, 0xdf, 0xbb, 0xb4, 0x9c, 0x9e, 0x7d, 0x6e, 0x08, 0x13,
+	0xda, 0xb0, 0x28, 0xff, 0xa1, 0x5f, 0xff, 0x3a, 0x00, 0xf7, 0xeb, 0x67, 0x6c, 0x5a, 0x10, 0x00,
+	0x00,
 }
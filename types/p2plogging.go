@@ -172,6 +172,54 @@ func (m *GetAncestorResponse) MarshalZerologObject(e *zerolog.Event) {
 	e.Str(LogRespStatus, m.Status.String()).Str(LogBlkHash, enc.ToString(m.AncestorHash)).Uint64(LogBlkNo, m.AncestorNo)
 }
 
+func (m *GetLightHeadersRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Uint64(LogBlkNo, m.StartNo).Uint32("size", m.Size)
+}
+
+func (m *GetLightHeadersResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Int("headers", len(m.Headers))
+}
+
+func (m *GetReceiptProofRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Str("tx_hash", enc.ToString(m.TxHash)).Str(LogBlkHash, enc.ToString(m.BlockHash))
+}
+
+func (m *GetReceiptProofResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Str(LogBlkHash, enc.ToString(m.GetProof().GetBlockHash())).Uint64(LogBlkNo, m.GetProof().GetBlockNo())
+}
+
+func (m *GetStateProofRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogBlkHash, enc.ToString(m.BlockHash)).Str("account", enc.ToString(m.Account)).Int("keys", len(m.StorageKeys))
+}
+
+func (m *GetStateProofResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String())
+}
+
+func (m *GetStateRangeRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Str("root", enc.ToString(m.Root)).Str("start", enc.ToString(m.Start)).Uint32("limit", m.Limit)
+}
+
+func (m *GetStateRangeResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Int("keys", len(m.Keys)).Bool("has_next", m.HasNext)
+}
+
+func (m *GetStateDataRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Int("hashes", len(m.Hashes))
+}
+
+func (m *GetStateDataResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Int("data", len(m.Data))
+}
+
+func (m *GetContractDBRequest) MarshalZerologObject(e *zerolog.Event) {
+	e.Str("account_id", enc.ToString(m.AccountID)).Uint64("offset", m.Offset).Uint32("size", m.Size)
+}
+
+func (m *GetContractDBResponse) MarshalZerologObject(e *zerolog.Event) {
+	e.Str(LogRespStatus, m.Status.String()).Int("size", len(m.Data)).Uint64("total", m.Total)
+}
+
 func (m *GetClusterInfoRequest) MarshalZerologObject(e *zerolog.Event) {
 	e.Str("best_hash", enc.ToString(m.BestBlockHash))
 }
@@ -24,6 +24,19 @@ const (
 	recreatedStatus
 )
 
+const (
+	// gasUsedFlag is set in the status byte of a marshaled receipt which is
+	// followed by the gas used by the transaction.
+	gasUsedFlag = 0x80
+	// feeDelegationFlag is set in the status byte of a marshaled receipt
+	// whose fee was paid by the contract.
+	feeDelegationFlag = 0x40
+	// prevCodeHashFlag is set in the status byte of a marshaled receipt of a
+	// contract upgrade, which is followed by the hash of the replaced code.
+	prevCodeHashFlag = 0x20
+	statusFlags      = gasUsedFlag | feeDelegationFlag | prevCodeHashFlag
+)
+
 func NewReceipt(contractAddress []byte, status string, jsonRet string) *Receipt {
 	return &Receipt{
 		ContractAddress: contractAddress[:33],
@@ -48,8 +61,25 @@ func (r *Receipt) marshalBody(b *bytes.Buffer, isMerkle bool) error {
 	default:
 		return errors.New("unsupported status in receipt")
 	}
+	if r.GasUsed > 0 {
+		status |= gasUsedFlag
+	}
+	if r.FeeDelegation {
+		status |= feeDelegationFlag
+	}
+	if len(r.PrevCodeHash) > 0 {
+		if len(r.PrevCodeHash) != HashIDLength {
+			return errors.New("invalid previous code hash in receipt")
+		}
+		status |= prevCodeHashFlag
+	}
 	b.WriteByte(status)
-	if !isMerkle || status != errorStatus {
+	if r.GasUsed > 0 {
+		binary.LittleEndian.PutUint64(l, r.GasUsed)
+		b.Write(l)
+	}
+	b.Write(r.PrevCodeHash)
+	if !isMerkle || status&^statusFlags != errorStatus {
 		binary.LittleEndian.PutUint32(l[:4], uint32(len(r.Ret)))
 		b.Write(l[:4])
 		b.WriteString(r.Ret)
@@ -95,6 +125,17 @@ func (r *Receipt) marshalStoreBinary() ([]byte, error) {
 func (r *Receipt) unmarshalBody(data []byte) ([]byte, uint32) {
 	r.ContractAddress = data[:33]
 	status := data[33]
+	pos := uint32(34)
+	if status&gasUsedFlag != 0 {
+		r.GasUsed = binary.LittleEndian.Uint64(data[pos:])
+		pos += 8
+	}
+	r.FeeDelegation = status&feeDelegationFlag != 0
+	if status&prevCodeHashFlag != 0 {
+		r.PrevCodeHash = data[pos : pos+HashIDLength]
+		pos += HashIDLength
+	}
+	status &^= statusFlags
 	switch status {
 	case successStatus:
 		r.Status = "SUCCESS"
@@ -105,7 +146,6 @@ func (r *Receipt) unmarshalBody(data []byte) ([]byte, uint32) {
 	case recreatedStatus:
 		r.Status = "RECREATED"
 	}
-	pos := uint32(34)
 	l := binary.LittleEndian.Uint32(data[pos:])
 	pos += 4
 	r.Ret = string(data[pos : pos+l])
@@ -240,6 +280,20 @@ func (r *Receipt) MarshalJSON() ([]byte, error) {
 	b.WriteString(EncodeAddress(r.To))
 	b.WriteString(`","usedFee":`)
 	b.WriteString(new(big.Int).SetBytes(r.FeeUsed).String())
+	if r.GasUsed > 0 {
+		b.WriteString(`,"gasUsed":`)
+		b.WriteString(strconv.FormatUint(r.GasUsed, 10))
+	}
+	if r.FeeDelegation {
+		b.WriteString(`,"feeDelegation":true,"feePayer":"`)
+		b.WriteString(EncodeAddress(r.ContractAddress))
+		b.WriteString(`"`)
+	}
+	if len(r.PrevCodeHash) > 0 {
+		b.WriteString(`,"prevCodeHash":"`)
+		b.WriteString(enc.ToString(r.PrevCodeHash))
+		b.WriteString(`"`)
+	}
 	b.WriteString(`,"events":[`)
 	for i, ev := range r.Events {
 		if i != 0 {
@@ -351,6 +405,20 @@ func (rs *Receipts) MerkleRoot() []byte {
 	if rs == nil {
 		return merkle.CalculateMerkleRoot(nil)
 	}
+	return merkle.CalculateMerkleRoot(rs.merkleEntries())
+}
+
+// MerkleProof returns the audit path of the idx-th receipt against the
+// receipts root hash. The index next to the last receipt addresses the block
+// bloom filter.
+func (rs *Receipts) MerkleProof(idx int) [][]byte {
+	if rs == nil {
+		return nil
+	}
+	return merkle.CalculateMerkleProof(rs.merkleEntries(), idx)
+}
+
+func (rs *Receipts) merkleEntries() []merkle.MerkleEntry {
 	rsSize := len(rs.receipts)
 	if rs.bloom != nil {
 		rsSize++
@@ -362,7 +430,43 @@ func (rs *Receipts) MerkleRoot() []byte {
 	if rs.bloom != nil {
 		mes[rsSize-1] = rs.bloom
 	}
-	return merkle.CalculateMerkleRoot(mes)
+	return mes
+}
+
+// BloomBytes returns the raw bits of the block bloom filter, or nil if no
+// receipt has merged its bloom filter.
+func (rs *Receipts) BloomBytes() []byte {
+	if rs == nil || rs.bloom == nil {
+		return nil
+	}
+	b, err := (*bloom.BloomFilter)(rs.bloom).GobEncode()
+	if err != nil {
+		return nil
+	}
+	return b[24:]
+}
+
+// NewBloomFromBytes restores a bloom filter from the raw bits returned by
+// BloomBytes.
+func NewBloomFromBytes(bits []byte) (*bloom.BloomFilter, error) {
+	if len(bits) != BloomBitByte {
+		return nil, errors.New("invalid bloom filter size")
+	}
+	var buffer bytes.Buffer
+	l := make([]byte, 8)
+	binary.BigEndian.PutUint64(l, BloomBitBits)
+	buffer.Write(l)
+	binary.BigEndian.PutUint64(l, BloomHashKNum)
+	buffer.Write(l)
+	binary.BigEndian.PutUint64(l, BloomBitBits)
+	buffer.Write(l)
+	buffer.Write(bits)
+
+	var bf bloom.BloomFilter
+	if _, err := bf.ReadFrom(&buffer); err != nil {
+		return nil, err
+	}
+	return &bf, nil
 }
 
 func (rs *Receipts) MarshalBinary() ([]byte, error) {
@@ -658,11 +762,42 @@ func (ev *Event) Filter(filter *FilterInfo, argFilter []ArgFilter) bool {
 	return true
 }
 
+// FirstArg returns the canonical JSON encoding of the first event argument.
+// It returns nil if the event has no arguments.
+func (ev *Event) FirstArg() []byte {
+	var args []interface{}
+	if err := json.Unmarshal([]byte(ev.JsonArgs), &args); err != nil || len(args) == 0 {
+		return nil
+	}
+	arg, err := json.Marshal(args[0])
+	if err != nil {
+		return nil
+	}
+	return arg
+}
+
 type ArgFilter struct {
 	argNo int
 	value interface{}
 }
 
+// FirstArgFilter returns the canonical JSON encoding of the value required for
+// the first event argument. It returns nil if the first argument is not
+// constrained.
+func FirstArgFilter(argFilter []ArgFilter) []byte {
+	for _, filter := range argFilter {
+		if filter.argNo != 0 {
+			continue
+		}
+		arg, err := json.Marshal(filter.value)
+		if err != nil {
+			return nil
+		}
+		return arg
+	}
+	return nil
+}
+
 const MAXBLOCKRANGE = 10000
 const padprefix = 0x80
 
@@ -730,3 +865,23 @@ func (fi *FilterInfo) GetExArgFilter() ([]ArgFilter, error) {
 	}
 	return nil, nil
 }
+
+// Verify checks that the receipt, or the block bloom filter if the proof has
+// no receipt, is included in the receipts of a block whose receipts root hash
+// is receiptsRoot.
+func (p *ReceiptProof) Verify(receiptsRoot []byte) bool {
+	var leaf []byte
+	switch {
+	case p.GetReceipt() != nil:
+		leaf = p.Receipt.GetHash()
+	case len(p.GetBloom()) > 0:
+		bf, err := NewBloomFromBytes(p.Bloom)
+		if err != nil {
+			return false
+		}
+		leaf = (*bloomFilter)(bf).GetHash()
+	default:
+		return false
+	}
+	return merkle.VerifyMerkleProof(receiptsRoot, leaf, int(p.GetIndex()), p.GetAuditPath())
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/willf/bloom"
+)
+
+func TestReceiptGasUsed(t *testing.T) {
+	const testContract = "AmNhXiU3s2BN26v5B5hT2bbEjvSjqyrBY7DGnD9UqVcwkTrDYyJN"
+	contract, err := DecodeAddress(testContract)
+	assert.NoError(t, err, "should success to decode test address")
+	txHash := make([]byte, 32)
+
+	for _, gasUsed := range []uint64{0, 1, 123456789} {
+		for _, status := range []string{"SUCCESS", "CREATED", "ERROR", "RECREATED"} {
+			r := NewReceipt(contract, status, `"ret"`)
+			r.GasUsed = gasUsed
+			r.TxHash = txHash
+			r.Events = []*Event{{ContractAddress: contract, EventName: "ev", JsonArgs: `[1]`, TxHash: txHash, BlockHash: txHash}}
+
+			data, err := r.MarshalBinary()
+			assert.NoError(t, err)
+			var dec Receipt
+			assert.NoError(t, dec.UnmarshalBinary(data))
+			assert.Equal(t, status, dec.Status)
+			assert.Equal(t, gasUsed, dec.GasUsed)
+			assert.Equal(t, r.Ret, dec.Ret)
+			assert.Len(t, dec.Events, 1)
+
+			_, err = r.MarshalMerkleBinary()
+			assert.NoError(t, err)
+		}
+	}
+
+	// receipts without gas used are marshaled as before
+	r := NewReceipt(contract, "SUCCESS", `"ret"`)
+	r.TxHash = txHash
+	legacy, err := r.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(successStatus), legacy[33])
+
+	r.GasUsed = 10
+	data, err := r.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Equal(t, len(legacy)+8, len(data))
+
+	js, err := r.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Contains(t, string(js), `"gasUsed":10`)
+}
+
+func TestReceiptFeeDelegation(t *testing.T) {
+	const testContract = "AmNhXiU3s2BN26v5B5hT2bbEjvSjqyrBY7DGnD9UqVcwkTrDYyJN"
+	contract, err := DecodeAddress(testContract)
+	assert.NoError(t, err, "should success to decode test address")
+
+	for _, gasUsed := range []uint64{0, 42} {
+		r := NewReceipt(contract, "SUCCESS", `"ret"`)
+		r.TxHash = make([]byte, 32)
+		r.GasUsed = gasUsed
+		r.FeeDelegation = true
+
+		data, err := r.MarshalBinary()
+		assert.NoError(t, err)
+		var dec Receipt
+		assert.NoError(t, dec.UnmarshalBinary(data))
+		assert.Equal(t, "SUCCESS", dec.Status)
+		assert.Equal(t, gasUsed, dec.GasUsed)
+		assert.True(t, dec.FeeDelegation)
+
+		js, err := r.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Contains(t, string(js), `"feePayer":"`+testContract+`"`)
+	}
+}
+
+func TestReceiptPrevCodeHash(t *testing.T) {
+	const testContract = "AmNhXiU3s2BN26v5B5hT2bbEjvSjqyrBY7DGnD9UqVcwkTrDYyJN"
+	contract, err := DecodeAddress(testContract)
+	assert.NoError(t, err, "should success to decode test address")
+
+	for _, gasUsed := range []uint64{0, 42} {
+		r := NewReceipt(contract, "RECREATED", `{}`)
+		r.TxHash = make([]byte, 32)
+		r.GasUsed = gasUsed
+		r.PrevCodeHash = make([]byte, HashIDLength)
+		r.PrevCodeHash[0] = 1
+
+		data, err := r.MarshalBinary()
+		assert.NoError(t, err)
+		var dec Receipt
+		assert.NoError(t, dec.UnmarshalBinary(data))
+		assert.Equal(t, "RECREATED", dec.Status)
+		assert.Equal(t, gasUsed, dec.GasUsed)
+		assert.Equal(t, r.PrevCodeHash, dec.PrevCodeHash)
+
+		js, err := r.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Contains(t, string(js), `"prevCodeHash":"`)
+	}
+
+	r := NewReceipt(contract, "RECREATED", `{}`)
+	r.PrevCodeHash = []byte{1}
+	_, err = r.MarshalBinary()
+	assert.Error(t, err, "invalid hash length")
+}
+
+func TestReceiptProof(t *testing.T) {
+	const testContract = "AmNhXiU3s2BN26v5B5hT2bbEjvSjqyrBY7DGnD9UqVcwkTrDYyJN"
+	contract, err := DecodeAddress(testContract)
+	assert.NoError(t, err, "should success to decode test address")
+
+	var rs Receipts
+	list := make([]*Receipt, 5)
+	for i := range list {
+		r := NewReceipt(contract, "SUCCESS", `"ret"`)
+		r.TxHash = make([]byte, 32)
+		r.TxHash[0] = byte(i)
+		list[i] = r
+	}
+	rs.Set(list)
+	bf := bloom.New(BloomBitBits, BloomHashKNum)
+	bf.Add(contract)
+	assert.NoError(t, rs.MergeBloom(bf))
+	root := rs.MerkleRoot()
+
+	for i, r := range list {
+		p := &ReceiptProof{Receipt: r, Index: uint32(i), AuditPath: rs.MerkleProof(i)}
+		assert.True(t, p.Verify(root))
+		p.Index = uint32((i + 1) % len(list))
+		assert.False(t, p.Verify(root))
+	}
+
+	p := &ReceiptProof{Bloom: rs.BloomBytes(), Index: uint32(len(list)), AuditPath: rs.MerkleProof(len(list))}
+	assert.True(t, p.Verify(root))
+	restored, err := NewBloomFromBytes(p.Bloom)
+	assert.NoError(t, err)
+	assert.True(t, restored.Test(contract))
+
+	assert.False(t, (&ReceiptProof{}).Verify(root))
+}
+
+func TestGasLimitMaxFee(t *testing.T) {
+	const testSender = "AmPNYHyzyh9zweLwDyuoiUuTVCdrdksxkRWDjVJS76WQLExa2Jr4"
+	account, err := DecodeAddress(testSender)
+	assert.NoError(t, err, "should success to decode test address")
+	tx := NewTransaction(&Tx{
+		Body: &TxBody{
+			Account:  account,
+			Payload:  []byte(`{"Name":"inc"}`),
+			GasLimit: 100000,
+			GasPrice: []byte{0x02},
+		},
+	})
+	assert.Equal(t, uint64(200000), tx.GetMaxFee().Uint64())
+
+	tx.GetBody().GasPrice = nil
+	tx.GetTx().Hash = tx.CalculateTxHash()
+	assert.Equal(t, ErrTxInvalidPrice, tx.Validate(nil, false))
+}
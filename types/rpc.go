@@ -6,6 +6,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"strconv"
 )
@@ -38,3 +39,23 @@ func (ci *ConfigItem) Add(key, value string) *ConfigItem {
 	ci.Props[key] = value
 	return ci
 }
+
+// Match reports whether tx passes the filter. A filter with both account and
+// recipient matches a tx which is sent by the account or sent to the
+// recipient, and an empty filter matches every tx.
+func (f *PendingTxFilter) Match(tx *Tx) bool {
+	account, recipient := f.GetAccount(), f.GetRecipient()
+	if len(account) == 0 && len(recipient) == 0 {
+		return true
+	}
+	return (len(account) > 0 && bytes.Equal(account, tx.GetBody().GetAccount())) ||
+		(len(recipient) > 0 && bytes.Equal(recipient, tx.GetBody().GetRecipient()))
+}
+
+// Apply returns tx, or only its hash unless the filter asks for the full tx
+func (f *PendingTxFilter) Apply(tx *Tx) *Tx {
+	if f.GetFullTx() {
+		return tx
+	}
+	return &Tx{Hash: tx.GetHash()}
+}
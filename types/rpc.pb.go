@@ -64,7 +64,7 @@ func (x CommitStatus) String() string {
 	return proto.EnumName(CommitStatus_name, int32(x))
 }
 func (CommitStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{0}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{0}
 }
 
 type VerifyStatus int32
@@ -90,7 +90,7 @@ func (x VerifyStatus) String() string {
 	return proto.EnumName(VerifyStatus_name, int32(x))
 }
 func (VerifyStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{1}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{1}
 }
 
 // BlockchainStatus is current status of blockchain
@@ -109,7 +109,7 @@ func (m *BlockchainStatus) Reset()         { *m = BlockchainStatus{} }
 func (m *BlockchainStatus) String() string { return proto.CompactTextString(m) }
 func (*BlockchainStatus) ProtoMessage()    {}
 func (*BlockchainStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{0}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{0}
 }
 func (m *BlockchainStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockchainStatus.Unmarshal(m, b)
@@ -178,7 +178,7 @@ func (m *ChainId) Reset()         { *m = ChainId{} }
 func (m *ChainId) String() string { return proto.CompactTextString(m) }
 func (*ChainId) ProtoMessage()    {}
 func (*ChainId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{1}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{1}
 }
 func (m *ChainId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainId.Unmarshal(m, b)
@@ -245,7 +245,7 @@ func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
 func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
 func (*ChainInfo) ProtoMessage()    {}
 func (*ChainInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{2}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{2}
 }
 func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainInfo.Unmarshal(m, b)
@@ -333,7 +333,7 @@ func (m *ChainStats) Reset()         { *m = ChainStats{} }
 func (m *ChainStats) String() string { return proto.CompactTextString(m) }
 func (*ChainStats) ProtoMessage()    {}
 func (*ChainStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{3}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{3}
 }
 func (m *ChainStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChainStats.Unmarshal(m, b)
@@ -374,7 +374,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{4}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{4}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Input.Unmarshal(m, b)
@@ -436,7 +436,7 @@ func (m *Output) Reset()         { *m = Output{} }
 func (m *Output) String() string { return proto.CompactTextString(m) }
 func (*Output) ProtoMessage()    {}
 func (*Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{5}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{5}
 }
 func (m *Output) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Output.Unmarshal(m, b)
@@ -494,7 +494,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{6}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{6}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -525,7 +525,7 @@ func (m *SingleBytes) Reset()         { *m = SingleBytes{} }
 func (m *SingleBytes) String() string { return proto.CompactTextString(m) }
 func (*SingleBytes) ProtoMessage()    {}
 func (*SingleBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{7}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{7}
 }
 func (m *SingleBytes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SingleBytes.Unmarshal(m, b)
@@ -563,7 +563,7 @@ func (m *SingleString) Reset()         { *m = SingleString{} }
 func (m *SingleString) String() string { return proto.CompactTextString(m) }
 func (*SingleString) ProtoMessage()    {}
 func (*SingleString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{8}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{8}
 }
 func (m *SingleString) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SingleString.Unmarshal(m, b)
@@ -601,7 +601,7 @@ func (m *AccountAddress) Reset()         { *m = AccountAddress{} }
 func (m *AccountAddress) String() string { return proto.CompactTextString(m) }
 func (*AccountAddress) ProtoMessage()    {}
 func (*AccountAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{9}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{9}
 }
 func (m *AccountAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountAddress.Unmarshal(m, b)
@@ -632,6 +632,9 @@ type AccountAndRoot struct {
 	Account              []byte   `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
 	Root                 []byte   `protobuf:"bytes,2,opt,name=Root,proto3" json:"Root,omitempty"`
 	Compressed           bool     `protobuf:"varint,3,opt,name=Compressed,proto3" json:"Compressed,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,4,opt,name=BlockNo,proto3" json:"BlockNo,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,5,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	HasBlockNo           bool     `protobuf:"varint,6,opt,name=HasBlockNo,proto3" json:"HasBlockNo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -641,7 +644,7 @@ func (m *AccountAndRoot) Reset()         { *m = AccountAndRoot{} }
 func (m *AccountAndRoot) String() string { return proto.CompactTextString(m) }
 func (*AccountAndRoot) ProtoMessage()    {}
 func (*AccountAndRoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{10}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{10}
 }
 func (m *AccountAndRoot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountAndRoot.Unmarshal(m, b)
@@ -682,6 +685,27 @@ func (m *AccountAndRoot) GetCompressed() bool {
 	return false
 }
 
+func (m *AccountAndRoot) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *AccountAndRoot) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountAndRoot) GetHasBlockNo() bool {
+	if m != nil {
+		return m.HasBlockNo
+	}
+	return false
+}
+
 type Peer struct {
 	Address              *PeerAddress    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Bestblock            *NewBlockNotice `protobuf:"bytes,2,opt,name=bestblock,proto3" json:"bestblock,omitempty"`
@@ -699,7 +723,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{11}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{11}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -779,7 +803,7 @@ func (m *PeerList) Reset()         { *m = PeerList{} }
 func (m *PeerList) String() string { return proto.CompactTextString(m) }
 func (*PeerList) ProtoMessage()    {}
 func (*PeerList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{12}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{12}
 }
 func (m *PeerList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerList.Unmarshal(m, b)
@@ -821,7 +845,7 @@ func (m *ListParams) Reset()         { *m = ListParams{} }
 func (m *ListParams) String() string { return proto.CompactTextString(m) }
 func (*ListParams) ProtoMessage()    {}
 func (*ListParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{13}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{13}
 }
 func (m *ListParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListParams.Unmarshal(m, b)
@@ -888,7 +912,7 @@ func (m *PageParams) Reset()         { *m = PageParams{} }
 func (m *PageParams) String() string { return proto.CompactTextString(m) }
 func (*PageParams) ProtoMessage()    {}
 func (*PageParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{14}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{14}
 }
 func (m *PageParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PageParams.Unmarshal(m, b)
@@ -936,7 +960,7 @@ func (m *BlockBodyPaged) Reset()         { *m = BlockBodyPaged{} }
 func (m *BlockBodyPaged) String() string { return proto.CompactTextString(m) }
 func (*BlockBodyPaged) ProtoMessage()    {}
 func (*BlockBodyPaged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{15}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{15}
 }
 func (m *BlockBodyPaged) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBodyPaged.Unmarshal(m, b)
@@ -996,7 +1020,7 @@ func (m *BlockBodyParams) Reset()         { *m = BlockBodyParams{} }
 func (m *BlockBodyParams) String() string { return proto.CompactTextString(m) }
 func (*BlockBodyParams) ProtoMessage()    {}
 func (*BlockBodyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{16}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{16}
 }
 func (m *BlockBodyParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockBodyParams.Unmarshal(m, b)
@@ -1041,7 +1065,7 @@ func (m *BlockHeaderList) Reset()         { *m = BlockHeaderList{} }
 func (m *BlockHeaderList) String() string { return proto.CompactTextString(m) }
 func (*BlockHeaderList) ProtoMessage()    {}
 func (*BlockHeaderList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{17}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{17}
 }
 func (m *BlockHeaderList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockHeaderList.Unmarshal(m, b)
@@ -1082,7 +1106,7 @@ func (m *BlockMetadata) Reset()         { *m = BlockMetadata{} }
 func (m *BlockMetadata) String() string { return proto.CompactTextString(m) }
 func (*BlockMetadata) ProtoMessage()    {}
 func (*BlockMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{18}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{18}
 }
 func (m *BlockMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMetadata.Unmarshal(m, b)
@@ -1141,7 +1165,7 @@ func (m *BlockMetadataList) Reset()         { *m = BlockMetadataList{} }
 func (m *BlockMetadataList) String() string { return proto.CompactTextString(m) }
 func (*BlockMetadataList) ProtoMessage()    {}
 func (*BlockMetadataList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{19}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{19}
 }
 func (m *BlockMetadataList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockMetadataList.Unmarshal(m, b)
@@ -1181,7 +1205,7 @@ func (m *CommitResult) Reset()         { *m = CommitResult{} }
 func (m *CommitResult) String() string { return proto.CompactTextString(m) }
 func (*CommitResult) ProtoMessage()    {}
 func (*CommitResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{20}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{20}
 }
 func (m *CommitResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitResult.Unmarshal(m, b)
@@ -1233,7 +1257,7 @@ func (m *CommitResultList) Reset()         { *m = CommitResultList{} }
 func (m *CommitResultList) String() string { return proto.CompactTextString(m) }
 func (*CommitResultList) ProtoMessage()    {}
 func (*CommitResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{21}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{21}
 }
 func (m *CommitResultList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommitResultList.Unmarshal(m, b)
@@ -1272,7 +1296,7 @@ func (m *VerifyResult) Reset()         { *m = VerifyResult{} }
 func (m *VerifyResult) String() string { return proto.CompactTextString(m) }
 func (*VerifyResult) ProtoMessage()    {}
 func (*VerifyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{22}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{22}
 }
 func (m *VerifyResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyResult.Unmarshal(m, b)
@@ -1318,7 +1342,7 @@ func (m *Personal) Reset()         { *m = Personal{} }
 func (m *Personal) String() string { return proto.CompactTextString(m) }
 func (*Personal) ProtoMessage()    {}
 func (*Personal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{23}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{23}
 }
 func (m *Personal) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Personal.Unmarshal(m, b)
@@ -1365,7 +1389,7 @@ func (m *ImportFormat) Reset()         { *m = ImportFormat{} }
 func (m *ImportFormat) String() string { return proto.CompactTextString(m) }
 func (*ImportFormat) ProtoMessage()    {}
 func (*ImportFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{24}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{24}
 }
 func (m *ImportFormat) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImportFormat.Unmarshal(m, b)
@@ -1409,6 +1433,7 @@ func (m *ImportFormat) GetNewpass() string {
 type Staking struct {
 	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	When                 uint64   `protobuf:"varint,2,opt,name=when,proto3" json:"when,omitempty"`
+	Reward               []byte   `protobuf:"bytes,3,opt,name=reward,proto3" json:"reward,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1418,7 +1443,7 @@ func (m *Staking) Reset()         { *m = Staking{} }
 func (m *Staking) String() string { return proto.CompactTextString(m) }
 func (*Staking) ProtoMessage()    {}
 func (*Staking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{25}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{25}
 }
 func (m *Staking) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Staking.Unmarshal(m, b)
@@ -1452,6 +1477,13 @@ func (m *Staking) GetWhen() uint64 {
 	return 0
 }
 
+func (m *Staking) GetReward() []byte {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
+}
+
 type Vote struct {
 	Candidate            []byte   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
 	Amount               []byte   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -1464,7 +1496,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{26}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{26}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Vote.Unmarshal(m, b)
@@ -1510,7 +1542,7 @@ func (m *VoteParams) Reset()         { *m = VoteParams{} }
 func (m *VoteParams) String() string { return proto.CompactTextString(m) }
 func (*VoteParams) ProtoMessage()    {}
 func (*VoteParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{27}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{27}
 }
 func (m *VoteParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteParams.Unmarshal(m, b)
@@ -1547,6 +1579,9 @@ func (m *VoteParams) GetCount() uint32 {
 type AccountVoteInfo struct {
 	Staking              *Staking    `protobuf:"bytes,1,opt,name=staking,proto3" json:"staking,omitempty"`
 	Voting               []*VoteInfo `protobuf:"bytes,2,rep,name=voting,proto3" json:"voting,omitempty"`
+	DirectPower          string      `protobuf:"bytes,3,opt,name=directPower,proto3" json:"directPower,omitempty"`
+	DelegatedPower       string      `protobuf:"bytes,4,opt,name=delegatedPower,proto3" json:"delegatedPower,omitempty"`
+	Proxy                string      `protobuf:"bytes,5,opt,name=proxy,proto3" json:"proxy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1556,7 +1591,7 @@ func (m *AccountVoteInfo) Reset()         { *m = AccountVoteInfo{} }
 func (m *AccountVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*AccountVoteInfo) ProtoMessage()    {}
 func (*AccountVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{28}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{28}
 }
 func (m *AccountVoteInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountVoteInfo.Unmarshal(m, b)
@@ -1590,6 +1625,27 @@ func (m *AccountVoteInfo) GetVoting() []*VoteInfo {
 	return nil
 }
 
+func (m *AccountVoteInfo) GetDirectPower() string {
+	if m != nil {
+		return m.DirectPower
+	}
+	return ""
+}
+
+func (m *AccountVoteInfo) GetDelegatedPower() string {
+	if m != nil {
+		return m.DelegatedPower
+	}
+	return ""
+}
+
+func (m *AccountVoteInfo) GetProxy() string {
+	if m != nil {
+		return m.Proxy
+	}
+	return ""
+}
+
 type VoteInfo struct {
 	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	Candidates           []string `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
@@ -1602,7 +1658,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{29}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{29}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteInfo.Unmarshal(m, b)
@@ -1648,7 +1704,7 @@ func (m *VoteList) Reset()         { *m = VoteList{} }
 func (m *VoteList) String() string { return proto.CompactTextString(m) }
 func (*VoteList) ProtoMessage()    {}
 func (*VoteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{30}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{30}
 }
 func (m *VoteList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VoteList.Unmarshal(m, b)
@@ -1694,7 +1750,7 @@ func (m *NodeReq) Reset()         { *m = NodeReq{} }
 func (m *NodeReq) String() string { return proto.CompactTextString(m) }
 func (*NodeReq) ProtoMessage()    {}
 func (*NodeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{31}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{31}
 }
 func (m *NodeReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeReq.Unmarshal(m, b)
@@ -1731,6 +1787,7 @@ func (m *NodeReq) GetComponent() []byte {
 type Name struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	BlockNo              uint64   `protobuf:"varint,2,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1740,7 +1797,7 @@ func (m *Name) Reset()         { *m = Name{} }
 func (m *Name) String() string { return proto.CompactTextString(m) }
 func (*Name) ProtoMessage()    {}
 func (*Name) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{32}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{32}
 }
 func (m *Name) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Name.Unmarshal(m, b)
@@ -1774,10 +1831,18 @@ func (m *Name) GetBlockNo() uint64 {
 	return 0
 }
 
+func (m *Name) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
 type NameInfo struct {
 	Name                 *Name    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Owner                []byte   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 	Destination          []byte   `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	ExpireAt             uint64   `protobuf:"varint,4,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1787,7 +1852,7 @@ func (m *NameInfo) Reset()         { *m = NameInfo{} }
 func (m *NameInfo) String() string { return proto.CompactTextString(m) }
 func (*NameInfo) ProtoMessage()    {}
 func (*NameInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{33}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{33}
 }
 func (m *NameInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NameInfo.Unmarshal(m, b)
@@ -1828,6 +1893,13 @@ func (m *NameInfo) GetDestination() []byte {
 	return nil
 }
 
+func (m *NameInfo) GetExpireAt() uint64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
 type PeersParams struct {
 	NoHidden             bool     `protobuf:"varint,1,opt,name=noHidden,proto3" json:"noHidden,omitempty"`
 	ShowSelf             bool     `protobuf:"varint,2,opt,name=showSelf,proto3" json:"showSelf,omitempty"`
@@ -1840,7 +1912,7 @@ func (m *PeersParams) Reset()         { *m = PeersParams{} }
 func (m *PeersParams) String() string { return proto.CompactTextString(m) }
 func (*PeersParams) ProtoMessage()    {}
 func (*PeersParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{34}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{34}
 }
 func (m *PeersParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeersParams.Unmarshal(m, b)
@@ -1885,7 +1957,7 @@ func (m *KeyParams) Reset()         { *m = KeyParams{} }
 func (m *KeyParams) String() string { return proto.CompactTextString(m) }
 func (*KeyParams) ProtoMessage()    {}
 func (*KeyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{35}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{35}
 }
 func (m *KeyParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyParams.Unmarshal(m, b)
@@ -1924,7 +1996,7 @@ func (m *ServerInfo) Reset()         { *m = ServerInfo{} }
 func (m *ServerInfo) String() string { return proto.CompactTextString(m) }
 func (*ServerInfo) ProtoMessage()    {}
 func (*ServerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{36}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{36}
 }
 func (m *ServerInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerInfo.Unmarshal(m, b)
@@ -1969,7 +2041,7 @@ func (m *ConfigItem) Reset()         { *m = ConfigItem{} }
 func (m *ConfigItem) String() string { return proto.CompactTextString(m) }
 func (*ConfigItem) ProtoMessage()    {}
 func (*ConfigItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{37}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{37}
 }
 func (m *ConfigItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigItem.Unmarshal(m, b)
@@ -2007,7 +2079,7 @@ func (m *EventList) Reset()         { *m = EventList{} }
 func (m *EventList) String() string { return proto.CompactTextString(m) }
 func (*EventList) ProtoMessage()    {}
 func (*EventList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{38}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{38}
 }
 func (m *EventList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventList.Unmarshal(m, b)
@@ -2048,7 +2120,7 @@ func (m *ConsensusInfo) Reset()         { *m = ConsensusInfo{} }
 func (m *ConsensusInfo) String() string { return proto.CompactTextString(m) }
 func (*ConsensusInfo) ProtoMessage()    {}
 func (*ConsensusInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{39}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{39}
 }
 func (m *ConsensusInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsensusInfo.Unmarshal(m, b)
@@ -2100,7 +2172,7 @@ func (m *EnterpriseConfigKey) Reset()         { *m = EnterpriseConfigKey{} }
 func (m *EnterpriseConfigKey) String() string { return proto.CompactTextString(m) }
 func (*EnterpriseConfigKey) ProtoMessage()    {}
 func (*EnterpriseConfigKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{40}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{40}
 }
 func (m *EnterpriseConfigKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnterpriseConfigKey.Unmarshal(m, b)
@@ -2140,7 +2212,7 @@ func (m *EnterpriseConfig) Reset()         { *m = EnterpriseConfig{} }
 func (m *EnterpriseConfig) String() string { return proto.CompactTextString(m) }
 func (*EnterpriseConfig) ProtoMessage()    {}
 func (*EnterpriseConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_ad055011a3c10f82, []int{41}
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{41}
 }
 func (m *EnterpriseConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EnterpriseConfig.Unmarshal(m, b)
@@ -2181,6 +2253,524 @@ func (m *EnterpriseConfig) GetValues() []string {
 	return nil
 }
 
+type AccountChange struct {
+	Account              []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Before               *State   `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After                *State   `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	StorageKeys          [][]byte `protobuf:"bytes,4,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountChange) Reset()         { *m = AccountChange{} }
+func (m *AccountChange) String() string { return proto.CompactTextString(m) }
+func (*AccountChange) ProtoMessage()    {}
+func (*AccountChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{42}
+}
+func (m *AccountChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountChange.Unmarshal(m, b)
+}
+func (m *AccountChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountChange.Marshal(b, m, deterministic)
+}
+func (dst *AccountChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountChange.Merge(dst, src)
+}
+func (m *AccountChange) XXX_Size() int {
+	return xxx_messageInfo_AccountChange.Size(m)
+}
+func (m *AccountChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountChange proto.InternalMessageInfo
+
+func (m *AccountChange) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AccountChange) GetBefore() *State {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *AccountChange) GetAfter() *State {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *AccountChange) GetStorageKeys() [][]byte {
+	if m != nil {
+		return m.StorageKeys
+	}
+	return nil
+}
+
+type SimulateResult struct {
+	Receipt              *Receipt         `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Changes              []*AccountChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SimulateResult) Reset()         { *m = SimulateResult{} }
+func (m *SimulateResult) String() string { return proto.CompactTextString(m) }
+func (*SimulateResult) ProtoMessage()    {}
+func (*SimulateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{43}
+}
+func (m *SimulateResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SimulateResult.Unmarshal(m, b)
+}
+func (m *SimulateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SimulateResult.Marshal(b, m, deterministic)
+}
+func (dst *SimulateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateResult.Merge(dst, src)
+}
+func (m *SimulateResult) XXX_Size() int {
+	return xxx_messageInfo_SimulateResult.Size(m)
+}
+func (m *SimulateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateResult proto.InternalMessageInfo
+
+func (m *SimulateResult) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *SimulateResult) GetChanges() []*AccountChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type AccountTxsParams struct {
+	Account              []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Size                 uint32   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Offset               uint32   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Asc                  bool     `protobuf:"varint,4,opt,name=asc,proto3" json:"asc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountTxsParams) Reset()         { *m = AccountTxsParams{} }
+func (m *AccountTxsParams) String() string { return proto.CompactTextString(m) }
+func (*AccountTxsParams) ProtoMessage()    {}
+func (*AccountTxsParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{44}
+}
+func (m *AccountTxsParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTxsParams.Unmarshal(m, b)
+}
+func (m *AccountTxsParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTxsParams.Marshal(b, m, deterministic)
+}
+func (dst *AccountTxsParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxsParams.Merge(dst, src)
+}
+func (m *AccountTxsParams) XXX_Size() int {
+	return xxx_messageInfo_AccountTxsParams.Size(m)
+}
+func (m *AccountTxsParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxsParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxsParams proto.InternalMessageInfo
+
+func (m *AccountTxsParams) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AccountTxsParams) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *AccountTxsParams) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *AccountTxsParams) GetAsc() bool {
+	if m != nil {
+		return m.Asc
+	}
+	return false
+}
+
+type AccountTx struct {
+	TxHash               []byte   `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	BlockNo              uint64   `protobuf:"varint,2,opt,name=blockNo,proto3" json:"blockNo,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	TxIdx                int32    `protobuf:"varint,4,opt,name=txIdx,proto3" json:"txIdx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountTx) Reset()         { *m = AccountTx{} }
+func (m *AccountTx) String() string { return proto.CompactTextString(m) }
+func (*AccountTx) ProtoMessage()    {}
+func (*AccountTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{45}
+}
+func (m *AccountTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTx.Unmarshal(m, b)
+}
+func (m *AccountTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTx.Marshal(b, m, deterministic)
+}
+func (dst *AccountTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTx.Merge(dst, src)
+}
+func (m *AccountTx) XXX_Size() int {
+	return xxx_messageInfo_AccountTx.Size(m)
+}
+func (m *AccountTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTx proto.InternalMessageInfo
+
+func (m *AccountTx) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *AccountTx) GetBlockNo() uint64 {
+	if m != nil {
+		return m.BlockNo
+	}
+	return 0
+}
+
+func (m *AccountTx) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountTx) GetTxIdx() int32 {
+	if m != nil {
+		return m.TxIdx
+	}
+	return 0
+}
+
+type AccountTxList struct {
+	Account              []byte       `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Txs                  []*AccountTx `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AccountTxList) Reset()         { *m = AccountTxList{} }
+func (m *AccountTxList) String() string { return proto.CompactTextString(m) }
+func (*AccountTxList) ProtoMessage()    {}
+func (*AccountTxList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{46}
+}
+func (m *AccountTxList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountTxList.Unmarshal(m, b)
+}
+func (m *AccountTxList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountTxList.Marshal(b, m, deterministic)
+}
+func (dst *AccountTxList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountTxList.Merge(dst, src)
+}
+func (m *AccountTxList) XXX_Size() int {
+	return xxx_messageInfo_AccountTxList.Size(m)
+}
+func (m *AccountTxList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountTxList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountTxList proto.InternalMessageInfo
+
+func (m *AccountTxList) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AccountTxList) GetTxs() []*AccountTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type MempoolAccount struct {
+	Account              []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	NextNonce            uint64   `protobuf:"varint,2,opt,name=nextNonce,proto3" json:"nextNonce,omitempty"`
+	Pending              []*Tx    `protobuf:"bytes,3,rep,name=pending,proto3" json:"pending,omitempty"`
+	Queued               []*Tx    `protobuf:"bytes,4,rep,name=queued,proto3" json:"queued,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolAccount) Reset()         { *m = MempoolAccount{} }
+func (m *MempoolAccount) String() string { return proto.CompactTextString(m) }
+func (*MempoolAccount) ProtoMessage()    {}
+func (*MempoolAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{47}
+}
+func (m *MempoolAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolAccount.Unmarshal(m, b)
+}
+func (m *MempoolAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolAccount.Marshal(b, m, deterministic)
+}
+func (dst *MempoolAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolAccount.Merge(dst, src)
+}
+func (m *MempoolAccount) XXX_Size() int {
+	return xxx_messageInfo_MempoolAccount.Size(m)
+}
+func (m *MempoolAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolAccount proto.InternalMessageInfo
+
+func (m *MempoolAccount) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *MempoolAccount) GetNextNonce() uint64 {
+	if m != nil {
+		return m.NextNonce
+	}
+	return 0
+}
+
+func (m *MempoolAccount) GetPending() []*Tx {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *MempoolAccount) GetQueued() []*Tx {
+	if m != nil {
+		return m.Queued
+	}
+	return nil
+}
+
+type MempoolAccountSummary struct {
+	Account              []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	NextNonce            uint64   `protobuf:"varint,2,opt,name=nextNonce,proto3" json:"nextNonce,omitempty"`
+	Pending              int32    `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	Queued               int32    `protobuf:"varint,4,opt,name=queued,proto3" json:"queued,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolAccountSummary) Reset()         { *m = MempoolAccountSummary{} }
+func (m *MempoolAccountSummary) String() string { return proto.CompactTextString(m) }
+func (*MempoolAccountSummary) ProtoMessage()    {}
+func (*MempoolAccountSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{48}
+}
+func (m *MempoolAccountSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolAccountSummary.Unmarshal(m, b)
+}
+func (m *MempoolAccountSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolAccountSummary.Marshal(b, m, deterministic)
+}
+func (dst *MempoolAccountSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolAccountSummary.Merge(dst, src)
+}
+func (m *MempoolAccountSummary) XXX_Size() int {
+	return xxx_messageInfo_MempoolAccountSummary.Size(m)
+}
+func (m *MempoolAccountSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolAccountSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolAccountSummary proto.InternalMessageInfo
+
+func (m *MempoolAccountSummary) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *MempoolAccountSummary) GetNextNonce() uint64 {
+	if m != nil {
+		return m.NextNonce
+	}
+	return 0
+}
+
+func (m *MempoolAccountSummary) GetPending() int32 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *MempoolAccountSummary) GetQueued() int32 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+type MempoolSummary struct {
+	Total                int32                    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Orphan               int32                    `protobuf:"varint,2,opt,name=orphan,proto3" json:"orphan,omitempty"`
+	Bytes                uint64                   `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Accounts             []*MempoolAccountSummary `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *MempoolSummary) Reset()         { *m = MempoolSummary{} }
+func (m *MempoolSummary) String() string { return proto.CompactTextString(m) }
+func (*MempoolSummary) ProtoMessage()    {}
+func (*MempoolSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{49}
+}
+func (m *MempoolSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolSummary.Unmarshal(m, b)
+}
+func (m *MempoolSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolSummary.Marshal(b, m, deterministic)
+}
+func (dst *MempoolSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolSummary.Merge(dst, src)
+}
+func (m *MempoolSummary) XXX_Size() int {
+	return xxx_messageInfo_MempoolSummary.Size(m)
+}
+func (m *MempoolSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolSummary proto.InternalMessageInfo
+
+func (m *MempoolSummary) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *MempoolSummary) GetOrphan() int32 {
+	if m != nil {
+		return m.Orphan
+	}
+	return 0
+}
+
+func (m *MempoolSummary) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *MempoolSummary) GetAccounts() []*MempoolAccountSummary {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type PendingTxFilter struct {
+	Account              []byte   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Recipient            []byte   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	FullTx               bool     `protobuf:"varint,3,opt,name=fullTx,proto3" json:"fullTx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTxFilter) Reset()         { *m = PendingTxFilter{} }
+func (m *PendingTxFilter) String() string { return proto.CompactTextString(m) }
+func (*PendingTxFilter) ProtoMessage()    {}
+func (*PendingTxFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_3b5903aa6d8a0d20, []int{50}
+}
+func (m *PendingTxFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxFilter.Unmarshal(m, b)
+}
+func (m *PendingTxFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxFilter.Marshal(b, m, deterministic)
+}
+func (dst *PendingTxFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxFilter.Merge(dst, src)
+}
+func (m *PendingTxFilter) XXX_Size() int {
+	return xxx_messageInfo_PendingTxFilter.Size(m)
+}
+func (m *PendingTxFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxFilter proto.InternalMessageInfo
+
+func (m *PendingTxFilter) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *PendingTxFilter) GetRecipient() []byte {
+	if m != nil {
+		return m.Recipient
+	}
+	return nil
+}
+
+func (m *PendingTxFilter) GetFullTx() bool {
+	if m != nil {
+		return m.FullTx
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*BlockchainStatus)(nil), "types.BlockchainStatus")
 	proto.RegisterType((*ChainId)(nil), "types.ChainId")
@@ -2227,6 +2817,15 @@ func init() {
 	proto.RegisterType((*ConsensusInfo)(nil), "types.ConsensusInfo")
 	proto.RegisterType((*EnterpriseConfigKey)(nil), "types.EnterpriseConfigKey")
 	proto.RegisterType((*EnterpriseConfig)(nil), "types.EnterpriseConfig")
+	proto.RegisterType((*AccountChange)(nil), "types.AccountChange")
+	proto.RegisterType((*SimulateResult)(nil), "types.SimulateResult")
+	proto.RegisterType((*AccountTxsParams)(nil), "types.AccountTxsParams")
+	proto.RegisterType((*AccountTx)(nil), "types.AccountTx")
+	proto.RegisterType((*AccountTxList)(nil), "types.AccountTxList")
+	proto.RegisterType((*MempoolAccount)(nil), "types.MempoolAccount")
+	proto.RegisterType((*MempoolAccountSummary)(nil), "types.MempoolAccountSummary")
+	proto.RegisterType((*MempoolSummary)(nil), "types.MempoolSummary")
+	proto.RegisterType((*PendingTxFilter)(nil), "types.PendingTxFilter")
 	proto.RegisterEnum("types.CommitStatus", CommitStatus_name, CommitStatus_value)
 	proto.RegisterEnum("types.VerifyStatus", VerifyStatus_name, VerifyStatus_value)
 }
@@ -2327,6 +2926,18 @@ type AergoRPCServiceClient interface {
 	GetEnterpriseConfig(ctx context.Context, in *EnterpriseConfigKey, opts ...grpc.CallOption) (*EnterpriseConfig, error)
 	// Return a status of changeCluster enterprise tx,  queried by requestID
 	GetConfChangeProgress(ctx context.Context, in *SingleBytes, opts ...grpc.CallOption) (*ConfChangeProgress, error)
+	// Return state of account at the block specified by number or hash
+	GetStateAt(ctx context.Context, in *AccountAndRoot, opts ...grpc.CallOption) (*State, error)
+	// Execute a transaction on top of the best block without committing and return its receipt and state changes
+	SimulateTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*SimulateResult, error)
+	// Returns transactions sent from or to an account, newest first by default
+	ListAccountTxs(ctx context.Context, in *AccountTxsParams, opts ...grpc.CallOption) (*AccountTxList, error)
+	// Returns the pending and queued transactions of an account in mempool
+	GetMempoolContent(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*MempoolAccount, error)
+	// Returns the number of pending and queued transactions of each account in mempool
+	GetMempoolSummary(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MempoolSummary, error)
+	// Returns a stream of transactions accepted by mempool, optionally filtered by sender or recipient
+	ListPendingTxStream(ctx context.Context, in *PendingTxFilter, opts ...grpc.CallOption) (AergoRPCService_ListPendingTxStreamClient, error)
 }
 
 type aergoRPCServiceClient struct {
@@ -2784,6 +3395,83 @@ func (c *aergoRPCServiceClient) GetConfChangeProgress(ctx context.Context, in *S
 	return out, nil
 }
 
+func (c *aergoRPCServiceClient) GetStateAt(ctx context.Context, in *AccountAndRoot, opts ...grpc.CallOption) (*State, error) {
+	out := new(State)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetStateAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) SimulateTX(ctx context.Context, in *Tx, opts ...grpc.CallOption) (*SimulateResult, error) {
+	out := new(SimulateResult)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/SimulateTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListAccountTxs(ctx context.Context, in *AccountTxsParams, opts ...grpc.CallOption) (*AccountTxList, error) {
+	out := new(AccountTxList)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/ListAccountTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetMempoolContent(ctx context.Context, in *AccountAddress, opts ...grpc.CallOption) (*MempoolAccount, error) {
+	out := new(MempoolAccount)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetMempoolContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) GetMempoolSummary(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MempoolSummary, error) {
+	out := new(MempoolSummary)
+	err := c.cc.Invoke(ctx, "/types.AergoRPCService/GetMempoolSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aergoRPCServiceClient) ListPendingTxStream(ctx context.Context, in *PendingTxFilter, opts ...grpc.CallOption) (AergoRPCService_ListPendingTxStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AergoRPCService_serviceDesc.Streams[3], "/types.AergoRPCService/ListPendingTxStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aergoRPCServiceListPendingTxStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AergoRPCService_ListPendingTxStreamClient interface {
+	Recv() (*Tx, error)
+	grpc.ClientStream
+}
+
+type aergoRPCServiceListPendingTxStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *aergoRPCServiceListPendingTxStreamClient) Recv() (*Tx, error) {
+	m := new(Tx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AergoRPCServiceServer is the server API for AergoRPCService service.
 type AergoRPCServiceServer interface {
 	// Returns the current state of this node
@@ -2870,6 +3558,18 @@ type AergoRPCServiceServer interface {
 	GetEnterpriseConfig(context.Context, *EnterpriseConfigKey) (*EnterpriseConfig, error)
 	// Return a status of changeCluster enterprise tx,  queried by requestID
 	GetConfChangeProgress(context.Context, *SingleBytes) (*ConfChangeProgress, error)
+	// Return state of account at the block specified by number or hash
+	GetStateAt(context.Context, *AccountAndRoot) (*State, error)
+	// Execute a transaction on top of the best block without committing and return its receipt and state changes
+	SimulateTX(context.Context, *Tx) (*SimulateResult, error)
+	// Returns transactions sent from or to an account, newest first by default
+	ListAccountTxs(context.Context, *AccountTxsParams) (*AccountTxList, error)
+	// Returns the pending and queued transactions of an account in mempool
+	GetMempoolContent(context.Context, *AccountAddress) (*MempoolAccount, error)
+	// Returns the number of pending and queued transactions of each account in mempool
+	GetMempoolSummary(context.Context, *Empty) (*MempoolSummary, error)
+	// Returns a stream of transactions accepted by mempool, optionally filtered by sender or recipient
+	ListPendingTxStream(*PendingTxFilter, AergoRPCService_ListPendingTxStreamServer) error
 }
 
 func RegisterAergoRPCServiceServer(s *grpc.Server, srv AergoRPCServiceServer) {
@@ -3641,6 +4341,117 @@ func _AergoRPCService_GetConfChangeProgress_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AergoRPCService_GetStateAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAndRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetStateAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetStateAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetStateAt(ctx, req.(*AccountAndRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_SimulateTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).SimulateTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/SimulateTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).SimulateTX(ctx, req.(*Tx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListAccountTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountTxsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).ListAccountTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/ListAccountTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).ListAccountTxs(ctx, req.(*AccountTxsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetMempoolContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetMempoolContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetMempoolContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetMempoolContent(ctx, req.(*AccountAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_GetMempoolSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AergoRPCServiceServer).GetMempoolSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.AergoRPCService/GetMempoolSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AergoRPCServiceServer).GetMempoolSummary(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AergoRPCService_ListPendingTxStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PendingTxFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AergoRPCServiceServer).ListPendingTxStream(m, &aergoRPCServiceListPendingTxStreamServer{stream})
+}
+
+type AergoRPCService_ListPendingTxStreamServer interface {
+	Send(*Tx) error
+	grpc.ServerStream
+}
+
+type aergoRPCServiceListPendingTxStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *aergoRPCServiceListPendingTxStreamServer) Send(m *Tx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.AergoRPCService",
 	HandlerType: (*AergoRPCServiceServer)(nil),
@@ -3801,6 +4612,26 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfChangeProgress",
 			Handler:    _AergoRPCService_GetConfChangeProgress_Handler,
 		},
+		{
+			MethodName: "GetStateAt",
+			Handler:    _AergoRPCService_GetStateAt_Handler,
+		},
+		{
+			MethodName: "SimulateTX",
+			Handler:    _AergoRPCService_SimulateTX_Handler,
+		},
+		{
+			MethodName: "ListAccountTxs",
+			Handler:    _AergoRPCService_ListAccountTxs_Handler,
+		},
+		{
+			MethodName: "GetMempoolContent",
+			Handler:    _AergoRPCService_GetMempoolContent_Handler,
+		},
+		{
+			MethodName: "GetMempoolSummary",
+			Handler:    _AergoRPCService_GetMempoolSummary_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3818,169 +4649,208 @@ var _AergoRPCService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AergoRPCService_ListEventStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListPendingTxStream",
+			Handler:       _AergoRPCService_ListPendingTxStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_ad055011a3c10f82) }
-
-var fileDescriptor_rpc_ad055011a3c10f82 = []byte{
-	// 2511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x19, 0xdb, 0x72, 0x1b, 0xb7,
-	0x95, 0xa4, 0x44, 0x89, 0x3c, 0x24, 0xa5, 0x15, 0x2c, 0xdb, 0x0a, 0xeb, 0x38, 0x2a, 0xea, 0x26,
-	0x4a, 0x9a, 0xa8, 0xb1, 0x9c, 0xa4, 0x69, 0xa6, 0x6d, 0x4a, 0x31, 0xb4, 0xc5, 0xb1, 0x4c, 0xa9,
-	0x20, 0xe3, 0x2a, 0x2f, 0x65, 0x57, 0x5c, 0x90, 0xdc, 0x31, 0xf7, 0x92, 0x5d, 0x50, 0x96, 0x32,
-	0xd3, 0xa7, 0x7e, 0x40, 0x7f, 0xa3, 0xdf, 0xd3, 0x2f, 0xe8, 0x7b, 0x7f, 0xa2, 0x83, 0x03, 0x60,
-	0x2f, 0xd4, 0xaa, 0x33, 0xee, 0xdb, 0x9e, 0xfb, 0x15, 0x07, 0x07, 0x24, 0xd4, 0xa3, 0x70, 0x72,
-	0x18, 0x46, 0x81, 0x08, 0x48, 0x55, 0xdc, 0x84, 0x3c, 0x6e, 0x5b, 0x97, 0x8b, 0x60, 0xf2, 0x66,
-	0x32, 0xb7, 0x5d, 0x5f, 0x11, 0xda, 0x2d, 0x7b, 0x32, 0x09, 0x96, 0xbe, 0xd0, 0x20, 0xf8, 0x81,
-	0xc3, 0xf5, 0x77, 0x3d, 0x3c, 0x0a, 0xf5, 0x67, 0xd3, 0xe3, 0x22, 0x72, 0x27, 0x86, 0x29, 0xb2,
-	0xa7, 0x5a, 0x80, 0xfe, 0xbb, 0x0c, 0xd6, 0x71, 0xa2, 0x74, 0x28, 0x6c, 0xb1, 0x8c, 0xc9, 0x87,
-	0xb0, 0x7d, 0xc9, 0x63, 0x31, 0x46, 0x6b, 0xe3, 0xb9, 0x1d, 0xcf, 0xf7, 0xca, 0xfb, 0xe5, 0x83,
-	0x26, 0x6b, 0x49, 0x34, 0xb2, 0x9f, 0xd8, 0xf1, 0x9c, 0x7c, 0x00, 0x0d, 0xe4, 0x9b, 0x73, 0x77,
-	0x36, 0x17, 0x7b, 0x95, 0xfd, 0xf2, 0xc1, 0x3a, 0x03, 0x89, 0x3a, 0x41, 0x0c, 0xf9, 0x25, 0x6c,
-	0x4d, 0x02, 0x3f, 0xe6, 0x7e, 0xbc, 0x8c, 0xc7, 0xae, 0x3f, 0x0d, 0xf6, 0xd6, 0xf6, 0xcb, 0x07,
-	0x75, 0xd6, 0x4a, 0xb0, 0x7d, 0x7f, 0x1a, 0x90, 0x5f, 0x01, 0x41, 0x3d, 0xe8, 0xc3, 0xd8, 0x75,
-	0x94, 0xc9, 0x75, 0x34, 0x89, 0x9e, 0x74, 0x25, 0xa1, 0xef, 0xa0, 0xd1, 0x5f, 0x03, 0x68, 0x3e,
-	0xa9, 0xaf, 0xba, 0x5f, 0x3e, 0x68, 0x1c, 0x59, 0x87, 0x98, 0x9f, 0x43, 0xc5, 0xe7, 0x4f, 0x03,
-	0x56, 0x9f, 0x98, 0x4f, 0x1a, 0xc0, 0xa6, 0x96, 0x27, 0xbb, 0x50, 0xf5, 0xec, 0x99, 0x3b, 0xc1,
-	0x70, 0xea, 0x4c, 0x01, 0xe4, 0x01, 0x6c, 0x84, 0xcb, 0xcb, 0x85, 0x3b, 0xc1, 0x08, 0x6a, 0x4c,
-	0x43, 0x64, 0x0f, 0x36, 0x3d, 0xdb, 0xf5, 0x7d, 0x2e, 0xd0, 0xed, 0x1a, 0x33, 0x20, 0x79, 0x04,
-	0xf5, 0x24, 0x02, 0xf4, 0xb3, 0xce, 0x52, 0x04, 0xfd, 0x47, 0x05, 0xea, 0x89, 0x27, 0xe4, 0x31,
-	0x54, 0x5c, 0x07, 0x0d, 0x36, 0x8e, 0xb6, 0x72, 0x7e, 0x3a, 0xac, 0xe2, 0x3a, 0xa4, 0x0d, 0xb5,
-	0xcb, 0x70, 0xb0, 0xf4, 0x2e, 0x79, 0x84, 0xf6, 0x5b, 0x2c, 0x81, 0x09, 0x85, 0xa6, 0x67, 0x5f,
-	0x63, 0x19, 0x62, 0xf7, 0x27, 0x8e, 0x6e, 0xac, 0xb3, 0x1c, 0x4e, 0xfa, 0xe2, 0xd9, 0xd7, 0x22,
-	0x78, 0xc3, 0xfd, 0x58, 0xe7, 0x2c, 0x45, 0x90, 0x0f, 0x61, 0x2b, 0x16, 0xf6, 0x1b, 0xd7, 0x9f,
-	0x79, 0xae, 0xef, 0x7a, 0x4b, 0x0f, 0x33, 0xd6, 0x64, 0x2b, 0x58, 0x69, 0x49, 0x04, 0xc2, 0x5e,
-	0x68, 0xf4, 0xde, 0x06, 0x72, 0xe5, 0x70, 0xd2, 0xd3, 0x99, 0x1d, 0x87, 0x91, 0x3b, 0xe1, 0x7b,
-	0x9b, 0x48, 0x4f, 0x60, 0xe9, 0x85, 0x6f, 0x7b, 0x5c, 0x11, 0x6b, 0xca, 0x8b, 0x04, 0x41, 0x9f,
-	0x00, 0x74, 0x4d, 0x7f, 0xc5, 0x32, 0xdf, 0x11, 0x0f, 0x83, 0x48, 0xe8, 0x32, 0x68, 0x88, 0x4e,
-	0xa0, 0xda, 0xf7, 0xc3, 0xa5, 0x20, 0x04, 0xd6, 0x33, 0x4d, 0x87, 0xdf, 0xb2, 0x18, 0xb6, 0xe3,
-	0x44, 0x3c, 0x8e, 0xf7, 0x2a, 0xfb, 0x6b, 0x07, 0x4d, 0x66, 0x40, 0x59, 0xd4, 0x2b, 0x7b, 0xb1,
-	0x54, 0xd9, 0x69, 0x32, 0x05, 0x48, 0x23, 0xf1, 0x24, 0x72, 0x43, 0xa1, 0x73, 0xa2, 0x21, 0x3a,
-	0x85, 0x8d, 0xb3, 0xa5, 0x90, 0x56, 0x76, 0xa1, 0xea, 0xfa, 0x0e, 0xbf, 0x46, 0x33, 0x2d, 0xa6,
-	0x80, 0xbc, 0x9d, 0xf2, 0xff, 0x6f, 0x67, 0x13, 0xaa, 0x3d, 0x2f, 0x14, 0x37, 0xf4, 0x17, 0xd0,
-	0x18, 0xba, 0xfe, 0x6c, 0xc1, 0x8f, 0x6f, 0x04, 0xcf, 0x68, 0x29, 0x67, 0xb4, 0xd0, 0x27, 0xd0,
-	0x54, 0x4c, 0x43, 0x11, 0xc9, 0x54, 0xe7, 0xb8, 0xea, 0x86, 0xeb, 0x43, 0xd8, 0xea, 0xa8, 0xe3,
-	0xde, 0x59, 0xf5, 0x29, 0xa7, 0xed, 0x2f, 0x29, 0x9f, 0xef, 0xb0, 0x20, 0x10, 0x32, 0x2a, 0x8d,
-	0xd1, 0x9c, 0x06, 0x94, 0xb9, 0x96, 0x1c, 0x3a, 0x58, 0xfc, 0x26, 0x8f, 0x01, 0xba, 0x81, 0x17,
-	0x4a, 0x0b, 0xdc, 0xd1, 0xbd, 0x9f, 0xc1, 0xd0, 0xff, 0x94, 0x61, 0xfd, 0x9c, 0xf3, 0x88, 0x7c,
-	0x9a, 0x26, 0x4b, 0x35, 0x38, 0xd1, 0x0d, 0x2e, 0xa9, 0xda, 0xc7, 0x34, 0x81, 0xcf, 0xa0, 0x2e,
-	0x0f, 0x33, 0xb6, 0x2e, 0xda, 0x6b, 0x1c, 0xdd, 0xd7, 0xfc, 0x03, 0xfe, 0x16, 0xc7, 0xca, 0x20,
-	0x10, 0xee, 0x84, 0xb3, 0x94, 0x4f, 0x46, 0x18, 0x0b, 0x5b, 0xa8, 0xac, 0x57, 0x99, 0x02, 0x64,
-	0xd6, 0xe7, 0xae, 0xe3, 0x70, 0x1f, 0xb3, 0x5e, 0x63, 0x1a, 0x92, 0x6d, 0xb8, 0xb0, 0xe3, 0x79,
-	0x77, 0xce, 0x27, 0x6f, 0xb0, 0xd3, 0xd7, 0x58, 0x8a, 0x90, 0x0d, 0x1c, 0xf3, 0xc5, 0x34, 0xe4,
-	0x3c, 0xc2, 0x06, 0xaf, 0xb1, 0x04, 0x96, 0x19, 0xba, 0xe2, 0x51, 0xec, 0x06, 0x3e, 0xf6, 0x76,
-	0x9d, 0x19, 0x90, 0x7e, 0x06, 0x35, 0x19, 0xce, 0xa9, 0x1b, 0x0b, 0xf2, 0x73, 0xa8, 0x4a, 0x6e,
-	0x19, 0xee, 0xda, 0x41, 0xe3, 0xa8, 0x91, 0x09, 0x97, 0x29, 0x0a, 0xbd, 0x02, 0x90, 0xac, 0xe7,
-	0x76, 0x64, 0x7b, 0x71, 0x61, 0x2b, 0x4b, 0xe7, 0xb3, 0x13, 0x53, 0x43, 0x92, 0x37, 0x39, 0xe5,
-	0x2d, 0x86, 0xdf, 0x92, 0x37, 0x98, 0x4e, 0x63, 0xae, 0xda, 0xab, 0xc5, 0x34, 0x44, 0x2c, 0x58,
-	0xb3, 0xe3, 0x09, 0x86, 0x58, 0x63, 0xf2, 0x93, 0x7e, 0x0d, 0x70, 0x6e, 0xcf, 0xb8, 0xb6, 0x9b,
-	0xca, 0x95, 0x73, 0x72, 0xc6, 0x46, 0x25, 0xb5, 0x41, 0xaf, 0x61, 0x0b, 0x93, 0x7f, 0x1c, 0x38,
-	0x37, 0x52, 0x05, 0xce, 0x49, 0x3c, 0xf9, 0xe6, 0x68, 0x20, 0x90, 0xd1, 0x59, 0x29, 0xd4, 0x99,
-	0xf5, 0xfb, 0x09, 0xac, 0x5f, 0x06, 0xce, 0x0d, 0x7a, 0x9d, 0xce, 0xe7, 0xc4, 0x0c, 0x43, 0x2a,
-	0xfd, 0x2b, 0x6c, 0x67, 0x2c, 0xa3, 0xe3, 0x14, 0x9a, 0x32, 0x49, 0x41, 0xe4, 0xab, 0x91, 0xa8,
-	0x12, 0x97, 0xc3, 0x91, 0x8f, 0x61, 0x23, 0xb4, 0x67, 0x72, 0x4c, 0xa9, 0x2e, 0xda, 0x31, 0x65,
-	0x48, 0xe2, 0x67, 0x9a, 0x81, 0xfe, 0x46, 0x5b, 0x38, 0xe1, 0xb6, 0xa3, 0x6b, 0xf8, 0x04, 0x36,
-	0xd4, 0xf4, 0xd4, 0x45, 0x6c, 0x66, 0x9d, 0x63, 0x9a, 0x46, 0xff, 0x06, 0x2d, 0x44, 0xbc, 0xe2,
-	0xc2, 0x76, 0x6c, 0x61, 0x17, 0x56, 0xf2, 0x13, 0x59, 0x49, 0xa9, 0x58, 0x3b, 0x42, 0xb2, 0xaa,
-	0x94, 0x49, 0xa6, 0x39, 0x64, 0x83, 0x89, 0x6b, 0x75, 0x04, 0x55, 0x2b, 0x1b, 0x30, 0xc9, 0xdf,
-	0x3a, 0xf6, 0xab, 0xaa, 0x49, 0x07, 0x76, 0x72, 0xe6, 0xd1, 0xf3, 0x4f, 0x57, 0x3c, 0xdf, 0xcd,
-	0x9a, 0x33, 0x9c, 0x49, 0x04, 0x1c, 0x9a, 0xdd, 0xc0, 0xf3, 0x5c, 0xc1, 0x78, 0xbc, 0x5c, 0x14,
-	0x4f, 0xd5, 0x8f, 0xa1, 0xca, 0xa3, 0x28, 0x50, 0xfe, 0x6f, 0x1d, 0xdd, 0x33, 0xf7, 0x13, 0xca,
-	0xa9, 0x6d, 0x80, 0x29, 0x0e, 0x59, 0x7d, 0x87, 0x0b, 0xdb, 0x5d, 0xe8, 0x3b, 0x5c, 0x43, 0xb4,
-	0x03, 0x56, 0xd6, 0x0c, 0x3a, 0xfa, 0x19, 0x6c, 0x46, 0x08, 0x19, 0x4f, 0xf3, 0x8a, 0x15, 0x27,
-	0x33, 0x3c, 0x74, 0x04, 0xcd, 0xd7, 0x3c, 0x72, 0xa7, 0x37, 0xda, 0xd3, 0xf7, 0xa0, 0x22, 0xae,
-	0xf5, 0x44, 0xa9, 0x6b, 0xc9, 0xd1, 0x35, 0xab, 0x88, 0xeb, 0xbb, 0x1c, 0x56, 0xe2, 0x39, 0x87,
-	0xe9, 0x48, 0x9e, 0xdb, 0x28, 0x0e, 0x7c, 0x7b, 0x21, 0x27, 0x5a, 0x68, 0xc7, 0x71, 0x38, 0x8f,
-	0xec, 0xd8, 0x0c, 0xd5, 0x0c, 0x86, 0x1c, 0xc0, 0xa6, 0x5e, 0xa4, 0x74, 0x25, 0xcd, 0x4d, 0xad,
-	0xc7, 0x24, 0x33, 0x64, 0x3a, 0x87, 0x66, 0xdf, 0x93, 0xd7, 0xd5, 0xf3, 0x20, 0xf2, 0x6c, 0xd9,
-	0x4d, 0x6b, 0x6f, 0xdd, 0xe9, 0xca, 0xf8, 0xcb, 0x0c, 0x7c, 0x26, 0xc9, 0xb2, 0xf8, 0xc1, 0xc2,
-	0x91, 0x06, 0x51, 0x7f, 0x9d, 0x19, 0x50, 0x52, 0x7c, 0xfe, 0x16, 0x29, 0x2a, 0xaf, 0x06, 0xa4,
-	0x5f, 0xc2, 0xe6, 0x50, 0xdf, 0xbc, 0x0f, 0x60, 0xc3, 0xf6, 0x32, 0xd3, 0x5b, 0x43, 0xb2, 0xa4,
-	0x6f, 0xe7, 0xdc, 0xd7, 0x73, 0x04, 0xbf, 0xe9, 0xef, 0x60, 0xfd, 0x75, 0x20, 0xf0, 0x46, 0x9e,
-	0xd8, 0xbe, 0xe3, 0x3a, 0x72, 0x78, 0x2a, 0xb1, 0x14, 0x91, 0xd1, 0x58, 0xc9, 0x6a, 0xa4, 0x47,
-	0x00, 0x52, 0x5a, 0x1f, 0xc6, 0xad, 0x64, 0x77, 0xa9, 0xe3, 0xae, 0xb2, 0x0b, 0xd5, 0x34, 0x49,
-	0x2d, 0xa6, 0x00, 0xea, 0xc0, 0xb6, 0x4e, 0x93, 0x14, 0xc5, 0xa5, 0xe7, 0x00, 0x36, 0xcd, 0x26,
-	0x91, 0xdf, 0x7c, 0x74, 0x44, 0xcc, 0x90, 0xc9, 0x47, 0xb0, 0x71, 0x15, 0x08, 0x75, 0x96, 0x65,
-	0xa7, 0x6c, 0x9b, 0x8a, 0x6a, 0x55, 0x4c, 0x93, 0xe9, 0x37, 0x50, 0x4b, 0xd4, 0x2b, 0xbf, 0x2a,
-	0x89, 0x5f, 0x8f, 0x01, 0x92, 0xd0, 0x64, 0x1e, 0xd7, 0x64, 0x79, 0x53, 0x0c, 0xfd, 0xbd, 0x92,
-	0x35, 0x23, 0xfc, 0x2a, 0x90, 0x6c, 0xf9, 0x11, 0x2e, 0xe9, 0x4c, 0x51, 0x56, 0xd5, 0xd3, 0x0e,
-	0x6c, 0x0e, 0x02, 0x87, 0x33, 0xfe, 0x23, 0x9e, 0x62, 0xd7, 0xe3, 0xc1, 0x32, 0xb9, 0x48, 0x35,
-	0xa8, 0x76, 0x42, 0x2f, 0x0c, 0x7c, 0x9e, 0x24, 0x35, 0x45, 0xd0, 0x2f, 0x60, 0x7d, 0x60, 0x7b,
-	0x5c, 0x56, 0x4c, 0xae, 0x45, 0x3a, 0xa7, 0xf8, 0x2d, 0x75, 0x5e, 0xaa, 0xcb, 0x4f, 0x17, 0xd2,
-	0x80, 0x74, 0x02, 0x35, 0x29, 0x85, 0x31, 0x7f, 0x90, 0x91, 0x4c, 0xdd, 0x96, 0x64, 0xad, 0x66,
-	0x17, 0xaa, 0xc1, 0x5b, 0x5f, 0xcf, 0xa2, 0x26, 0x53, 0x00, 0xd9, 0x87, 0x86, 0xc3, 0x63, 0xe1,
-	0xfa, 0xb6, 0x90, 0x77, 0x9b, 0xda, 0x5d, 0xb2, 0x28, 0xda, 0x83, 0x86, 0xbc, 0xbf, 0x62, 0x5d,
-	0xf3, 0x36, 0xd4, 0xfc, 0xe0, 0x44, 0x5d, 0xae, 0x65, 0x75, 0x49, 0x1a, 0x18, 0x2f, 0xd0, 0x79,
-	0xf0, 0x76, 0xc8, 0x17, 0x53, 0xbd, 0x2b, 0x27, 0x30, 0x7d, 0x1f, 0xea, 0x2f, 0xb9, 0x99, 0xe2,
-	0x16, 0xac, 0xbd, 0xe1, 0x37, 0x98, 0xe2, 0x3a, 0x93, 0x9f, 0xf4, 0xef, 0x15, 0x80, 0x21, 0x8f,
-	0xae, 0x78, 0x84, 0xd1, 0x7c, 0x09, 0x1b, 0x31, 0x9e, 0x56, 0x5d, 0x86, 0xf7, 0x4d, 0x7f, 0x24,
-	0x2c, 0x87, 0xea, 0x34, 0xf7, 0x7c, 0x11, 0xdd, 0x30, 0xcd, 0x2c, 0xc5, 0x26, 0x81, 0x3f, 0x75,
-	0x4d, 0xb7, 0x14, 0x88, 0x75, 0x91, 0xae, 0xc5, 0x14, 0x73, 0xfb, 0xb7, 0xd0, 0xc8, 0x68, 0x4b,
-	0xbd, 0x2b, 0x6b, 0xef, 0xd2, 0x3d, 0xaa, 0x92, 0xd9, 0xb7, 0xbe, 0xa9, 0x7c, 0x5d, 0x6e, 0x9f,
-	0x42, 0x23, 0xa3, 0xb1, 0x40, 0xf4, 0xa3, 0xac, 0x68, 0x7a, 0x17, 0x29, 0xa1, 0xbe, 0xe0, 0x5e,
-	0x46, 0x1b, 0xfd, 0x49, 0x6e, 0x56, 0x86, 0x40, 0x8e, 0xa0, 0x1a, 0x46, 0x41, 0x18, 0xeb, 0x60,
-	0x1e, 0xdd, 0x12, 0x3d, 0x3c, 0x97, 0x64, 0x15, 0x8b, 0x62, 0x6d, 0xcb, 0x6b, 0x3e, 0x41, 0xbe,
-	0x4b, 0x24, 0xf4, 0x29, 0xd4, 0x7b, 0x57, 0xdc, 0x17, 0xe6, 0x12, 0xe4, 0x12, 0x58, 0xbd, 0x04,
-	0x91, 0x83, 0x69, 0x1a, 0xed, 0x43, 0xab, 0x9b, 0x7b, 0xa9, 0x11, 0x58, 0x97, 0x7c, 0xa6, 0x7d,
-	0xe5, 0xb7, 0xc4, 0xe1, 0x53, 0x4c, 0x19, 0xc4, 0x6f, 0xe9, 0xd7, 0x65, 0x68, 0x4e, 0xa2, 0xfc,
-	0xa4, 0x1f, 0xc1, 0xbd, 0x9e, 0x2f, 0x78, 0x14, 0x46, 0x6e, 0xcc, 0x55, 0x84, 0x2f, 0x79, 0x41,
-	0x00, 0xf4, 0x14, 0xac, 0x55, 0xc6, 0x82, 0x30, 0xb7, 0xa0, 0x12, 0xf8, 0xba, 0x07, 0x2b, 0x81,
-	0x2f, 0xe7, 0x19, 0x46, 0x6a, 0x6c, 0x6a, 0xe8, 0x93, 0x7f, 0x95, 0xcd, 0x2d, 0xa8, 0xdf, 0xb6,
-	0x75, 0xa8, 0x8e, 0x2e, 0xc6, 0x67, 0x2f, 0xad, 0x12, 0xd9, 0x05, 0x6b, 0x74, 0x31, 0x1e, 0x9c,
-	0x0d, 0xba, 0xbd, 0xf1, 0xe8, 0xec, 0x6c, 0x7c, 0x7a, 0xf6, 0x67, 0xab, 0x4c, 0xee, 0xc3, 0xce,
-	0xe8, 0x62, 0xdc, 0x39, 0x65, 0xbd, 0xce, 0x77, 0x3f, 0x8c, 0x7b, 0x17, 0xfd, 0xe1, 0x68, 0x68,
-	0x55, 0xc8, 0x3d, 0xd8, 0x1e, 0x5d, 0x8c, 0xfb, 0x83, 0xd7, 0x9d, 0xd3, 0xfe, 0x77, 0xe3, 0x93,
-	0xce, 0xf0, 0xc4, 0x5a, 0x5b, 0x41, 0x0e, 0xfb, 0x2f, 0x06, 0xd6, 0xba, 0x56, 0x60, 0x90, 0xcf,
-	0xcf, 0xd8, 0xab, 0xce, 0xc8, 0xaa, 0x92, 0x9f, 0xc1, 0x43, 0x44, 0x0f, 0xbf, 0x7f, 0xfe, 0xbc,
-	0xdf, 0xed, 0xf7, 0x06, 0xa3, 0xf1, 0x71, 0xe7, 0xb4, 0x33, 0xe8, 0xf6, 0xac, 0x0d, 0x2d, 0x73,
-	0xd2, 0x19, 0x8e, 0x87, 0x9d, 0x57, 0x3d, 0xe5, 0x93, 0xb5, 0x99, 0xa8, 0x1a, 0xf5, 0xd8, 0xa0,
-	0x73, 0x3a, 0xee, 0x31, 0x76, 0xc6, 0xac, 0xfa, 0x27, 0x53, 0x73, 0x5f, 0xea, 0x98, 0x76, 0xc1,
-	0x7a, 0xdd, 0x63, 0xfd, 0xe7, 0x3f, 0x8c, 0x87, 0xa3, 0xce, 0xe8, 0xfb, 0xa1, 0x0a, 0x6f, 0x1f,
-	0x1e, 0xe5, 0xb1, 0xd2, 0xbf, 0xf1, 0xe0, 0x6c, 0x34, 0x7e, 0xd5, 0x19, 0x75, 0x4f, 0xac, 0x32,
-	0x79, 0x0c, 0xed, 0x3c, 0x47, 0x2e, 0xbc, 0xca, 0xd1, 0x3f, 0x09, 0x6c, 0x77, 0x78, 0x34, 0x0b,
-	0xd8, 0x79, 0x57, 0x9e, 0x30, 0xf9, 0xd0, 0x7b, 0x0a, 0x75, 0x39, 0x0b, 0x87, 0xb8, 0x86, 0x9b,
-	0xa9, 0xae, 0xa7, 0x63, 0xbb, 0xe0, 0xfe, 0xa3, 0x25, 0xf2, 0x14, 0x36, 0x5e, 0xe1, 0xef, 0x0f,
-	0xc4, 0xac, 0xfb, 0x0a, 0x8c, 0x19, 0xff, 0x71, 0xc9, 0x63, 0xd1, 0xde, 0xca, 0xa3, 0x69, 0x89,
-	0x7c, 0x09, 0x90, 0xfe, 0x2a, 0x41, 0x92, 0xe6, 0x94, 0x0f, 0xaa, 0xf6, 0xc3, 0xec, 0xd6, 0x93,
-	0xf9, 0xd9, 0x82, 0x96, 0xc8, 0xe7, 0xd0, 0x7c, 0xc1, 0x45, 0xfa, 0xf6, 0xce, 0x0b, 0xde, 0xfa,
-	0x95, 0x80, 0x96, 0xc8, 0xa1, 0x7e, 0xaa, 0x4b, 0x15, 0x2b, 0xec, 0x3b, 0x59, 0x76, 0x7c, 0xb9,
-	0xd2, 0x12, 0xf9, 0x16, 0x2c, 0x79, 0x7e, 0x32, 0x0b, 0x5e, 0x4c, 0x0c, 0x63, 0xba, 0xf6, 0xb7,
-	0x1f, 0xdc, 0x5e, 0x04, 0x25, 0x95, 0x96, 0xc8, 0x31, 0xec, 0x24, 0x0a, 0x92, 0xdd, 0xb2, 0x40,
-	0xc3, 0x5e, 0xd1, 0x6e, 0xa7, 0x75, 0x3c, 0x85, 0xed, 0x44, 0xc7, 0x50, 0x44, 0xdc, 0xf6, 0x56,
-	0x5c, 0xcf, 0xad, 0xb4, 0xb4, 0xf4, 0x79, 0x99, 0x74, 0xe0, 0xe1, 0x2d, 0xb3, 0x85, 0xa2, 0x85,
-	0x3b, 0x25, 0xaa, 0x38, 0x84, 0xda, 0x0b, 0xae, 0x34, 0x90, 0x82, 0x42, 0xaf, 0x1a, 0x25, 0x7f,
-	0x00, 0xcb, 0xf0, 0xa7, 0x4b, 0x74, 0x81, 0xdc, 0x1d, 0x16, 0xc9, 0xb7, 0x58, 0xcc, 0xe4, 0x7d,
-	0x40, 0x1e, 0xac, 0x3e, 0x22, 0x74, 0xa6, 0xee, 0xdf, 0xc6, 0xcf, 0xb8, 0x43, 0x4b, 0xe4, 0x00,
-	0xaa, 0x2f, 0xb8, 0x18, 0x5d, 0x14, 0x5a, 0x4d, 0xf7, 0x4a, 0x5a, 0x22, 0x5f, 0x00, 0x18, 0x53,
-	0x77, 0xb0, 0x5b, 0x09, 0x7b, 0xdf, 0x37, 0x01, 0x1e, 0xa1, 0x14, 0xe3, 0x13, 0xee, 0x86, 0xa2,
-	0x50, 0xca, 0x34, 0xb6, 0xe6, 0xa1, 0x25, 0xf9, 0x62, 0x78, 0xc1, 0x45, 0xe7, 0xb8, 0x5f, 0xc8,
-	0x0f, 0x66, 0xeb, 0x3c, 0xee, 0x2b, 0xde, 0x21, 0xf7, 0x9d, 0xd1, 0x05, 0x49, 0x9d, 0x6d, 0x17,
-	0x6d, 0xd2, 0x54, 0x1e, 0xf6, 0x8d, 0xa1, 0x3b, 0xf3, 0xf3, 0xbc, 0xb9, 0x18, 0x3f, 0x85, 0x9a,
-	0x1a, 0x1a, 0xc5, 0xfa, 0xb2, 0x0b, 0x38, 0x66, 0xa4, 0xa6, 0x2c, 0x8c, 0x2e, 0x48, 0x2b, 0xe1,
-	0x96, 0x2d, 0x94, 0x9c, 0xbf, 0xd5, 0xad, 0x1f, 0x4f, 0x93, 0x6c, 0x11, 0x35, 0x1b, 0xfe, 0x57,
-	0x8b, 0x20, 0x07, 0x2d, 0x91, 0x3f, 0x62, 0x8b, 0x20, 0xd4, 0xf1, 0x9d, 0xf3, 0x28, 0x08, 0xa6,
-	0xc9, 0x8c, 0xc8, 0xff, 0x82, 0x91, 0xf8, 0xa9, 0xd1, 0xc8, 0x8b, 0x35, 0x68, 0x75, 0x23, 0x2e,
-	0xe5, 0xf5, 0xef, 0x19, 0xdb, 0xc9, 0x93, 0x5c, 0xad, 0xfe, 0xed, 0x95, 0x4d, 0x1e, 0x8f, 0x4f,
-	0x43, 0xd6, 0x40, 0xc1, 0xf1, 0x4a, 0xff, 0x93, 0x3c, 0xbb, 0x0e, 0xec, 0x73, 0x68, 0x9c, 0x06,
-	0x93, 0x37, 0xef, 0x60, 0xe4, 0x08, 0x5a, 0xdf, 0xfb, 0x8b, 0x77, 0x93, 0xf9, 0x0a, 0x5a, 0xea,
-	0x6d, 0x61, 0x64, 0x4c, 0xd0, 0xd9, 0x17, 0x47, 0xb1, 0x5c, 0xef, 0x3a, 0x2b, 0x77, 0xcb, 0x56,
-	0xf1, 0x60, 0x7e, 0x06, 0xad, 0x3f, 0x2d, 0x79, 0x74, 0xd3, 0x0d, 0x7c, 0x11, 0xd9, 0x93, 0x74,
-	0x00, 0x22, 0xf6, 0x0e, 0xa1, 0x0e, 0x90, 0x9c, 0x90, 0xaa, 0xf6, 0x4e, 0xb6, 0xb2, 0x4a, 0xfc,
-	0xc1, 0x2d, 0x94, 0x29, 0xda, 0x53, 0x6c, 0x13, 0x5c, 0x3a, 0x49, 0xf6, 0x17, 0x23, 0xbd, 0x82,
-	0xb6, 0xb7, 0x33, 0xb8, 0xa4, 0x00, 0x52, 0xe4, 0x35, 0xae, 0xe7, 0x3b, 0x99, 0x95, 0x7d, 0x45,
-	0xc2, 0x6c, 0xf9, 0x38, 0x68, 0xb7, 0xd3, 0x2a, 0x2b, 0xc1, 0xd5, 0xd6, 0x52, 0xbf, 0x4b, 0x25,
-	0x8e, 0xae, 0x3c, 0x62, 0xd4, 0x35, 0xa4, 0xfa, 0x13, 0x9f, 0x2a, 0x77, 0x88, 0xaf, 0x3c, 0x6d,
-	0x68, 0x89, 0x7c, 0x86, 0x0d, 0x96, 0x6c, 0xee, 0xd9, 0x5d, 0x3d, 0xf1, 0xd4, 0x50, 0xb1, 0x7c,
-	0x38, 0xce, 0x71, 0xf5, 0xd2, 0x33, 0xd9, 0x84, 0xf8, 0xdc, 0x5d, 0x08, 0xb5, 0xd7, 0xb6, 0x73,
-	0x1b, 0x1a, 0x0e, 0xe4, 0x67, 0xea, 0x97, 0x26, 0x44, 0xc4, 0x45, 0x22, 0x56, 0x56, 0x44, 0xa7,
-	0xe5, 0x2b, 0x68, 0xc9, 0x90, 0xd2, 0x4d, 0xdc, 0x30, 0x25, 0xcb, 0x7b, 0x72, 0xf1, 0xa5, 0x4c,
-	0xb4, 0x44, 0xbe, 0xc6, 0xa3, 0x9a, 0xdf, 0x06, 0x8b, 0x6f, 0x8e, 0x1c, 0x0f, 0x2d, 0x91, 0x97,
-	0x60, 0x75, 0xe7, 0xb6, 0x3f, 0xe3, 0xaf, 0xb8, 0x77, 0xc9, 0xa3, 0x78, 0xee, 0x86, 0xe4, 0x61,
-	0x72, 0xe3, 0x1b, 0x94, 0x62, 0x69, 0x3f, 0xba, 0x83, 0xc0, 0x78, 0xb8, 0xb8, 0xa1, 0x25, 0x72,
-	0x0a, 0xf7, 0x5e, 0x70, 0x71, 0x6b, 0x41, 0x6c, 0x1b, 0x4f, 0x6e, 0xaf, 0x98, 0xc9, 0xbc, 0x5a,
-	0xa5, 0xd1, 0x12, 0x39, 0x81, 0xfb, 0x2a, 0xa8, 0xa9, 0xb2, 0x72, 0x1e, 0x05, 0x33, 0xfc, 0xa9,
-	0xb2, 0x68, 0x78, 0xbd, 0x97, 0x59, 0xcf, 0xf3, 0xec, 0xb4, 0x74, 0xb9, 0x81, 0x7f, 0xa7, 0x3c,
-	0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xdc, 0x76, 0x2f, 0xb4, 0x19, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_3b5903aa6d8a0d20) }
+
+var fileDescriptor_rpc_3b5903aa6d8a0d20 = []byte{
+	// 3056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x19, 0x5b, 0x72, 0x1b, 0xc7,
+	0x11, 0x00, 0x01, 0x92, 0x68, 0x02, 0x24, 0x38, 0x22, 0x29, 0x1a, 0x91, 0x65, 0x7a, 0xac, 0xd8,
+	0xb4, 0x63, 0x33, 0x12, 0x15, 0x3b, 0x8a, 0xcb, 0x89, 0x03, 0xc1, 0x90, 0x88, 0x12, 0x09, 0x32,
+	0x03, 0x58, 0xa1, 0xbf, 0x90, 0x25, 0x76, 0x00, 0xac, 0x05, 0xec, 0xae, 0x76, 0x07, 0x22, 0xe0,
+	0xaa, 0x54, 0x2a, 0x95, 0xff, 0x3c, 0x3e, 0x72, 0x95, 0x9c, 0x20, 0x27, 0xc8, 0x09, 0xf2, 0x9f,
+	0x4b, 0xa4, 0x7a, 0x1e, 0xfb, 0x00, 0x97, 0xae, 0xb2, 0xff, 0xb6, 0xdf, 0xdd, 0xd3, 0x3d, 0x3d,
+	0x3d, 0xb3, 0x50, 0x0e, 0xfc, 0xc1, 0x91, 0x1f, 0x78, 0xc2, 0x23, 0x25, 0xb1, 0xf0, 0x79, 0x58,
+	0xaf, 0x5d, 0x4d, 0xbc, 0xc1, 0xab, 0xc1, 0xd8, 0x72, 0x5c, 0x45, 0xa8, 0x57, 0xad, 0xc1, 0xc0,
+	0x9b, 0xb9, 0x42, 0x83, 0xe0, 0x7a, 0x36, 0xd7, 0xdf, 0x65, 0xff, 0xd8, 0xd7, 0x9f, 0x95, 0x29,
+	0x17, 0x81, 0x33, 0x30, 0x4c, 0x81, 0x35, 0xd4, 0x02, 0xf4, 0xbf, 0x79, 0xa8, 0x3d, 0x8d, 0x94,
+	0x76, 0x85, 0x25, 0x66, 0x21, 0x79, 0x1f, 0xb6, 0xae, 0x78, 0x28, 0xfa, 0xd2, 0x5a, 0x7f, 0x6c,
+	0x85, 0xe3, 0xfd, 0xfc, 0x41, 0xfe, 0xb0, 0xc2, 0xaa, 0x88, 0x96, 0xec, 0x27, 0x56, 0x38, 0x26,
+	0xef, 0xc0, 0x86, 0xe4, 0x1b, 0x73, 0x67, 0x34, 0x16, 0xfb, 0x85, 0x83, 0xfc, 0x61, 0x91, 0x01,
+	0xa2, 0x4e, 0x24, 0x86, 0xfc, 0x14, 0x36, 0x07, 0x9e, 0x1b, 0x72, 0x37, 0x9c, 0x85, 0x7d, 0xc7,
+	0x1d, 0x7a, 0xfb, 0x2b, 0x07, 0xf9, 0xc3, 0x32, 0xab, 0x46, 0xd8, 0xb6, 0x3b, 0xf4, 0xc8, 0xcf,
+	0x80, 0x48, 0x3d, 0xd2, 0x87, 0xbe, 0x63, 0x2b, 0x93, 0x45, 0x69, 0x52, 0x7a, 0xd2, 0x44, 0x42,
+	0xdb, 0x96, 0x46, 0x7f, 0x0e, 0xa0, 0xf9, 0x50, 0x5f, 0xe9, 0x20, 0x7f, 0xb8, 0x71, 0x5c, 0x3b,
+	0x92, 0xeb, 0x73, 0xa4, 0xf8, 0xdc, 0xa1, 0xc7, 0xca, 0x03, 0xf3, 0x49, 0x3d, 0x58, 0xd3, 0xf2,
+	0x64, 0x07, 0x4a, 0x53, 0x6b, 0xe4, 0x0c, 0x64, 0x38, 0x65, 0xa6, 0x00, 0xb2, 0x07, 0xab, 0xfe,
+	0xec, 0x6a, 0xe2, 0x0c, 0x64, 0x04, 0xeb, 0x4c, 0x43, 0x64, 0x1f, 0xd6, 0xa6, 0x96, 0xe3, 0xba,
+	0x5c, 0x48, 0xb7, 0xd7, 0x99, 0x01, 0xc9, 0x3d, 0x28, 0x47, 0x11, 0x48, 0x3f, 0xcb, 0x2c, 0x46,
+	0xd0, 0xbf, 0x15, 0xa0, 0x1c, 0x79, 0x42, 0xee, 0x43, 0xc1, 0xb1, 0xa5, 0xc1, 0x8d, 0xe3, 0xcd,
+	0x94, 0x9f, 0x36, 0x2b, 0x38, 0x36, 0xa9, 0xc3, 0xfa, 0x95, 0xdf, 0x99, 0x4d, 0xaf, 0x78, 0x20,
+	0xed, 0x57, 0x59, 0x04, 0x13, 0x0a, 0x95, 0xa9, 0x35, 0x97, 0x69, 0x08, 0x9d, 0xef, 0xb8, 0x74,
+	0xa3, 0xc8, 0x52, 0x38, 0xf4, 0x65, 0x6a, 0xcd, 0x85, 0xf7, 0x8a, 0xbb, 0xa1, 0x5e, 0xb3, 0x18,
+	0x41, 0xde, 0x87, 0xcd, 0x50, 0x58, 0xaf, 0x1c, 0x77, 0x34, 0x75, 0x5c, 0x67, 0x3a, 0x9b, 0xca,
+	0x15, 0xab, 0xb0, 0x25, 0x2c, 0x5a, 0x12, 0x9e, 0xb0, 0x26, 0x1a, 0xbd, 0xbf, 0x2a, 0xb9, 0x52,
+	0x38, 0xf4, 0x74, 0x64, 0x85, 0x7e, 0xe0, 0x0c, 0xf8, 0xfe, 0x9a, 0xa4, 0x47, 0x30, 0x7a, 0xe1,
+	0x5a, 0x53, 0xae, 0x88, 0xeb, 0xca, 0x8b, 0x08, 0x41, 0x1f, 0x00, 0x34, 0x4d, 0x7d, 0x85, 0xb8,
+	0xde, 0x01, 0xf7, 0xbd, 0x40, 0xe8, 0x34, 0x68, 0x88, 0x0e, 0xa0, 0xd4, 0x76, 0xfd, 0x99, 0x20,
+	0x04, 0x8a, 0x89, 0xa2, 0x93, 0xdf, 0x98, 0x0c, 0xcb, 0xb6, 0x03, 0x1e, 0x86, 0xfb, 0x85, 0x83,
+	0x95, 0xc3, 0x0a, 0x33, 0x20, 0x26, 0xf5, 0x8d, 0x35, 0x99, 0xa9, 0xd5, 0xa9, 0x30, 0x05, 0xa0,
+	0x91, 0x70, 0x10, 0x38, 0xbe, 0xd0, 0x6b, 0xa2, 0x21, 0x3a, 0x84, 0xd5, 0xf3, 0x99, 0x40, 0x2b,
+	0x3b, 0x50, 0x72, 0x5c, 0x9b, 0xcf, 0xa5, 0x99, 0x2a, 0x53, 0x40, 0xda, 0x4e, 0xfe, 0xc7, 0xdb,
+	0x59, 0x83, 0x52, 0x6b, 0xea, 0x8b, 0x05, 0x7d, 0x0f, 0x36, 0xba, 0x8e, 0x3b, 0x9a, 0xf0, 0xa7,
+	0x0b, 0xc1, 0x13, 0x5a, 0xf2, 0x09, 0x2d, 0xf4, 0x01, 0x54, 0x14, 0x53, 0x57, 0x04, 0xb8, 0xd4,
+	0x29, 0xae, 0xb2, 0xe1, 0x7a, 0x1f, 0x36, 0x1b, 0x6a, 0xbb, 0x37, 0x96, 0x7d, 0x4a, 0x69, 0xfb,
+	0x57, 0x3e, 0x66, 0x74, 0x6d, 0xe6, 0x79, 0x02, 0xc3, 0xd2, 0x18, 0xcd, 0x6a, 0x40, 0x5c, 0x6c,
+	0xe4, 0xd0, 0xd1, 0xca, 0x6f, 0x72, 0x1f, 0xa0, 0xe9, 0x4d, 0x7d, 0x34, 0xc1, 0x6d, 0x5d, 0xfc,
+	0x09, 0x0c, 0x6a, 0x93, 0x5d, 0xa0, 0xe3, 0xc9, 0xa8, 0x8b, 0xcc, 0x80, 0x58, 0x07, 0x51, 0x7f,
+	0xd0, 0xa5, 0x16, 0x23, 0x50, 0xef, 0x89, 0x15, 0x1a, 0xd1, 0x55, 0xa5, 0x37, 0xc6, 0xd0, 0xff,
+	0xe5, 0xa1, 0x78, 0xc1, 0x79, 0x40, 0x3e, 0x8e, 0xb3, 0xa0, 0x76, 0x0e, 0xd1, 0x3b, 0x07, 0xa9,
+	0x3a, 0xf8, 0x38, 0x33, 0x8f, 0xa1, 0x8c, 0x5d, 0x42, 0xee, 0x09, 0x19, 0xc7, 0xc6, 0xf1, 0xae,
+	0xe6, 0xef, 0xf0, 0x6b, 0xad, 0x5c, 0x38, 0x03, 0xce, 0x62, 0x3e, 0x5c, 0xba, 0x50, 0x58, 0x42,
+	0xa5, 0xb3, 0xc4, 0x14, 0x80, 0xe9, 0x1c, 0x3b, 0xb6, 0xcd, 0x5d, 0x19, 0xd8, 0x3a, 0xd3, 0x10,
+	0xc6, 0x35, 0xb1, 0xc2, 0x71, 0x73, 0xcc, 0x07, 0xaf, 0x64, 0x5c, 0x2b, 0x2c, 0x46, 0xe0, 0xce,
+	0x08, 0xf9, 0x64, 0xe8, 0x73, 0x1e, 0xe8, 0xa8, 0x22, 0x18, 0xd7, 0xea, 0x0d, 0x0f, 0x42, 0xc7,
+	0x73, 0xe5, 0xa6, 0x29, 0x33, 0x03, 0xd2, 0x4f, 0x60, 0x1d, 0xc3, 0x39, 0x75, 0x42, 0x41, 0xde,
+	0x85, 0x12, 0x72, 0x63, 0xb8, 0x2b, 0x87, 0x1b, 0xc7, 0x1b, 0x89, 0x70, 0x99, 0xa2, 0xd0, 0x37,
+	0x00, 0xc8, 0x7a, 0x61, 0x05, 0xd6, 0x34, 0xcc, 0xdc, 0x23, 0xe8, 0x7c, 0xb2, 0x15, 0x6b, 0x08,
+	0x79, 0xa3, 0xf6, 0x51, 0x65, 0xf2, 0x1b, 0x79, 0xbd, 0xe1, 0x30, 0xe4, 0xaa, 0x6e, 0xab, 0x4c,
+	0x43, 0xa4, 0x06, 0x2b, 0x56, 0x38, 0x90, 0x21, 0xae, 0x33, 0xfc, 0xa4, 0x4f, 0x00, 0x2e, 0xac,
+	0x11, 0xd7, 0x76, 0x63, 0xb9, 0x7c, 0x4a, 0xce, 0xd8, 0x28, 0xc4, 0x36, 0xe8, 0x1c, 0x36, 0xe5,
+	0xe2, 0x3f, 0xf5, 0xec, 0x05, 0xaa, 0x90, 0x0d, 0x58, 0xb6, 0x14, 0xb3, 0xe7, 0x24, 0x90, 0xd0,
+	0x59, 0xc8, 0xd4, 0x99, 0xf4, 0xfb, 0x01, 0x14, 0xaf, 0x3c, 0x7b, 0xb1, 0x5f, 0x4c, 0x35, 0xfe,
+	0xc8, 0x0c, 0x93, 0x54, 0xfa, 0x07, 0xd8, 0x4a, 0x58, 0x96, 0x8e, 0x53, 0xa8, 0xe0, 0x22, 0x79,
+	0x81, 0xab, 0x7a, 0xad, 0x5a, 0xb8, 0x14, 0x8e, 0x7c, 0x08, 0xab, 0xbe, 0x35, 0xc2, 0xfe, 0xa7,
+	0xaa, 0x68, 0xdb, 0xa4, 0x21, 0x8a, 0x9f, 0x69, 0x06, 0xfa, 0x4b, 0x6d, 0xe1, 0x84, 0x5b, 0xb6,
+	0xce, 0xe1, 0x03, 0x58, 0x55, 0x6d, 0x59, 0x27, 0xb1, 0x92, 0x74, 0x8e, 0x69, 0x1a, 0xfd, 0x23,
+	0x54, 0x25, 0xe2, 0x8c, 0x0b, 0xcb, 0xb6, 0x84, 0x95, 0x99, 0xc9, 0x8f, 0x30, 0x93, 0xa8, 0x78,
+	0xbf, 0x90, 0x2a, 0xff, 0x84, 0x49, 0xa6, 0x39, 0xb0, 0xc0, 0xc4, 0x5c, 0x6d, 0x6d, 0x55, 0xca,
+	0x06, 0x8c, 0xd6, 0xaf, 0x28, 0xeb, 0x55, 0xe5, 0xa4, 0x01, 0xdb, 0x29, 0xf3, 0xd2, 0xf3, 0x8f,
+	0x97, 0x3c, 0xdf, 0x49, 0x9a, 0x33, 0x9c, 0x51, 0x04, 0x1c, 0x2a, 0x4d, 0x6f, 0x3a, 0x75, 0x04,
+	0xe3, 0xe1, 0x6c, 0x92, 0xdd, 0xae, 0x3f, 0x84, 0x12, 0x0f, 0x02, 0x4f, 0xf9, 0xbf, 0x79, 0x7c,
+	0xc7, 0x1c, 0x7c, 0x52, 0x4e, 0x8d, 0x19, 0x4c, 0x71, 0x60, 0xf6, 0x6d, 0x2e, 0x2c, 0x67, 0xa2,
+	0x87, 0x03, 0x0d, 0xd1, 0x06, 0xd4, 0x92, 0x66, 0xa4, 0xa3, 0x9f, 0xc0, 0x5a, 0x20, 0x21, 0xe3,
+	0x69, 0x5a, 0xb1, 0xe2, 0x64, 0x86, 0x87, 0xf6, 0xa0, 0xf2, 0x92, 0x07, 0xce, 0x70, 0xa1, 0x3d,
+	0x7d, 0x0b, 0x0a, 0x62, 0xae, 0x3b, 0x4a, 0x59, 0x4b, 0xf6, 0xe6, 0xac, 0x20, 0xe6, 0xb7, 0x39,
+	0xac, 0xc4, 0x53, 0x0e, 0xd3, 0x1e, 0xee, 0xdb, 0x20, 0xf4, 0x5c, 0x6b, 0x82, 0x1d, 0xcd, 0xb7,
+	0xc2, 0xd0, 0x1f, 0x07, 0x56, 0x68, 0xba, 0x75, 0x02, 0x43, 0x0e, 0x61, 0x4d, 0x4f, 0x68, 0xfb,
+	0x85, 0xd4, 0x08, 0xa0, 0xdb, 0x2f, 0x33, 0x64, 0x3a, 0x86, 0x4a, 0x7b, 0x8a, 0xe7, 0xe0, 0x33,
+	0x2f, 0x98, 0x5a, 0x58, 0x4d, 0x2b, 0xd7, 0xce, 0x70, 0xa9, 0xfd, 0x25, 0x4e, 0x12, 0x86, 0x64,
+	0x4c, 0xbe, 0x37, 0xb1, 0xd1, 0xa0, 0xd4, 0x5f, 0x66, 0x06, 0x44, 0x8a, 0xcb, 0xaf, 0x25, 0x45,
+	0xad, 0xab, 0x01, 0xe9, 0x19, 0xac, 0x75, 0xf5, 0x91, 0xbe, 0x07, 0xab, 0xd6, 0x34, 0x71, 0x2a,
+	0x68, 0x08, 0x53, 0x7a, 0x3d, 0xe6, 0xae, 0xee, 0x23, 0xf2, 0x5b, 0x1d, 0xdb, 0xd7, 0x56, 0x60,
+	0xeb, 0x03, 0x50, 0x43, 0xf4, 0x0b, 0x28, 0xbe, 0xf4, 0x84, 0x1c, 0x01, 0x06, 0x96, 0x6b, 0x3b,
+	0x36, 0x36, 0x55, 0xa5, 0x2e, 0x46, 0x24, 0x2c, 0x15, 0x92, 0x96, 0xe8, 0x31, 0x00, 0x4a, 0xeb,
+	0x4d, 0xba, 0x19, 0x0d, 0x4b, 0x65, 0x39, 0x1c, 0xed, 0x40, 0x29, 0x5e, 0xbc, 0x2a, 0x53, 0x00,
+	0xfd, 0x77, 0x1e, 0xb6, 0xf4, 0xfa, 0xa1, 0xac, 0x1c, 0xb3, 0x0e, 0x61, 0xcd, 0xcc, 0x2e, 0xe9,
+	0x59, 0x4b, 0x87, 0xca, 0x0c, 0x99, 0x7c, 0x00, 0xab, 0x6f, 0x3c, 0xa1, 0x36, 0x39, 0x96, 0xd0,
+	0x96, 0x49, 0xb5, 0x56, 0xc5, 0x34, 0x99, 0x1c, 0xc0, 0x86, 0xed, 0x04, 0x7c, 0x20, 0x2e, 0xbc,
+	0x6b, 0x1e, 0xe8, 0x55, 0x4c, 0xa2, 0x70, 0xba, 0xb2, 0xf9, 0x84, 0x8f, 0x2c, 0xc1, 0x6d, 0xc5,
+	0xa4, 0x86, 0xc1, 0x25, 0x2c, 0x86, 0xe1, 0x07, 0xde, 0x7c, 0x21, 0xdb, 0x6a, 0x99, 0x29, 0x80,
+	0x7e, 0x0e, 0xeb, 0x91, 0xfb, 0x2a, 0xf0, 0x42, 0x14, 0xf8, 0x7d, 0x80, 0x68, 0xed, 0x30, 0x81,
+	0x2b, 0x58, 0x57, 0x31, 0x86, 0xfe, 0x5a, 0xc9, 0x9a, 0xb3, 0xe3, 0x8d, 0x27, 0xb8, 0xd9, 0x12,
+	0x1b, 0x89, 0x78, 0x98, 0xa2, 0x2c, 0xab, 0xa7, 0x0d, 0x58, 0xeb, 0x78, 0x36, 0x67, 0xfc, 0xb5,
+	0x6c, 0x1f, 0xce, 0x94, 0x7b, 0xb3, 0x68, 0x32, 0xd0, 0xa0, 0x9a, 0x72, 0xa7, 0xbe, 0xe7, 0xf2,
+	0x28, 0x6b, 0x31, 0x82, 0x76, 0xa0, 0xd8, 0xb1, 0xa6, 0x1c, 0x4b, 0x05, 0x07, 0x3d, 0x9d, 0x34,
+	0xf9, 0x8d, 0x3a, 0xaf, 0xf4, 0x21, 0xaf, 0x2a, 0xc8, 0x80, 0x48, 0x09, 0x38, 0x1e, 0x80, 0xdc,
+	0xcc, 0xd4, 0x1a, 0xa4, 0x7f, 0x82, 0x75, 0xd4, 0x27, 0x57, 0xe3, 0x9d, 0x84, 0xce, 0x38, 0x20,
+	0x24, 0x6b, 0x03, 0x3b, 0x50, 0xf2, 0xae, 0x5d, 0xdd, 0x1e, 0x2b, 0x4c, 0x01, 0x32, 0x61, 0x3c,
+	0x14, 0x8e, 0x6b, 0x09, 0x3c, 0x6e, 0x55, 0x99, 0x26, 0x51, 0x78, 0x50, 0xf3, 0xb9, 0xef, 0x04,
+	0xbc, 0x21, 0xf4, 0xe4, 0x12, 0xc1, 0xb4, 0x05, 0x1b, 0x78, 0xdc, 0x86, 0xba, 0x14, 0xeb, 0xb0,
+	0xee, 0x7a, 0x27, 0x6a, 0x16, 0xc8, 0xab, 0x33, 0xdd, 0xc0, 0x48, 0x0b, 0xc7, 0xde, 0x75, 0x97,
+	0x4f, 0x86, 0xfa, 0xce, 0x10, 0xc1, 0xf4, 0x6d, 0x28, 0xbf, 0xe0, 0xe6, 0xd0, 0xa9, 0xc1, 0xca,
+	0x2b, 0xbe, 0x90, 0x89, 0x29, 0x33, 0xfc, 0xa4, 0x7f, 0x29, 0x00, 0x74, 0x79, 0xf0, 0x86, 0x07,
+	0x32, 0xd2, 0x4f, 0x61, 0x35, 0x94, 0xcd, 0x45, 0x27, 0xef, 0x6d, 0x53, 0xb5, 0x11, 0xcb, 0x91,
+	0x6a, 0x3e, 0x2d, 0x57, 0x04, 0x0b, 0xa6, 0x99, 0x51, 0x6c, 0xe0, 0xb9, 0x43, 0xc7, 0xd4, 0x70,
+	0x86, 0x58, 0x53, 0xd2, 0xb5, 0x98, 0x62, 0xae, 0xff, 0x0a, 0x36, 0x12, 0xda, 0x62, 0xef, 0xf2,
+	0xda, 0xbb, 0x78, 0x9e, 0x2c, 0x24, 0xe6, 0xce, 0xcf, 0x0b, 0x4f, 0xf2, 0xf5, 0x53, 0xd8, 0x48,
+	0x68, 0xcc, 0x10, 0xfd, 0x20, 0x29, 0x1a, 0x1f, 0x9d, 0x4a, 0xa8, 0x2d, 0xf8, 0x34, 0xa1, 0x8d,
+	0x7e, 0x07, 0x10, 0x13, 0xc8, 0xb1, 0xdc, 0x1e, 0x7e, 0xa8, 0x83, 0xb9, 0x77, 0x43, 0xf4, 0xe8,
+	0x02, 0xc9, 0x2a, 0x16, 0xc5, 0x5a, 0xc7, 0xa9, 0x24, 0x42, 0xfe, 0x90, 0x48, 0xe8, 0x23, 0x28,
+	0xb7, 0xde, 0x70, 0x57, 0x98, 0x33, 0x9b, 0x23, 0xb0, 0x7c, 0x66, 0x4b, 0x0e, 0xa6, 0x69, 0xb4,
+	0x0d, 0xd5, 0x66, 0xea, 0xc6, 0x4a, 0xa0, 0x88, 0x7c, 0xa6, 0xe8, 0xf1, 0x1b, 0x71, 0xf2, 0x4a,
+	0xaa, 0x0c, 0xca, 0x6f, 0xf4, 0xeb, 0xca, 0x37, 0xfb, 0x17, 0x3f, 0xe9, 0x07, 0x70, 0xa7, 0xe5,
+	0x0a, 0x1e, 0xf8, 0x81, 0x13, 0x72, 0x15, 0xe1, 0x0b, 0x9e, 0x11, 0x00, 0x3d, 0x85, 0xda, 0x32,
+	0x63, 0x46, 0x98, 0x9b, 0x50, 0xf0, 0x5c, 0x5d, 0x83, 0x05, 0x4f, 0x36, 0x69, 0x19, 0xa9, 0xb1,
+	0xa9, 0x21, 0xfa, 0xcf, 0x3c, 0x54, 0x75, 0xcb, 0x6c, 0x8e, 0x2d, 0x77, 0x24, 0xf7, 0xa8, 0x95,
+	0xbe, 0x11, 0x68, 0x50, 0xce, 0x31, 0x7c, 0xe8, 0x05, 0x26, 0x95, 0x95, 0xb8, 0x93, 0x0a, 0xce,
+	0x34, 0x8d, 0x50, 0x28, 0x59, 0x43, 0xa1, 0xfb, 0xe2, 0x32, 0x93, 0x22, 0xe1, 0x86, 0x0c, 0x85,
+	0x17, 0x58, 0x23, 0xfe, 0x82, 0x2f, 0xf0, 0x76, 0x8a, 0x17, 0xb7, 0x24, 0x8a, 0x7e, 0x0b, 0x9b,
+	0x5d, 0x67, 0x3a, 0x9b, 0xa0, 0x90, 0x3a, 0xa3, 0x0f, 0xb1, 0x43, 0x0c, 0xb8, 0xe3, 0x2b, 0xbf,
+	0xe2, 0x46, 0xce, 0x14, 0x96, 0x19, 0x32, 0x39, 0x82, 0xb5, 0x81, 0x8c, 0xc5, 0x14, 0xce, 0x4e,
+	0xfa, 0x6c, 0x55, 0x81, 0x32, 0xc3, 0x44, 0xbf, 0x85, 0x9a, 0xa6, 0xf4, 0xe6, 0x66, 0x97, 0xdf,
+	0xbe, 0x0a, 0x19, 0x03, 0x6d, 0x62, 0x50, 0x5d, 0xc9, 0x1a, 0x9a, 0x8b, 0xf1, 0xd0, 0xfc, 0x1a,
+	0xca, 0x91, 0x2d, 0x14, 0x13, 0xf3, 0x93, 0x78, 0x44, 0xd2, 0xd0, 0xf7, 0xb4, 0xc9, 0x7b, 0x50,
+	0xbe, 0x8a, 0xae, 0x51, 0xaa, 0x8f, 0xc5, 0x08, 0x39, 0x45, 0xcf, 0xdb, 0xf6, 0x5c, 0x1a, 0x2c,
+	0x31, 0x05, 0xd0, 0xb3, 0x28, 0xc3, 0xbd, 0xb9, 0xac, 0xed, 0xdb, 0x63, 0xa3, 0xb0, 0x22, 0xe6,
+	0x66, 0xd5, 0x6a, 0xe9, 0x55, 0xeb, 0xcd, 0x19, 0x12, 0xe9, 0xdf, 0xf3, 0xb0, 0x79, 0xc6, 0xa7,
+	0xbe, 0xe7, 0x4d, 0x34, 0xe5, 0x7b, 0x14, 0xe2, 0xf5, 0x9f, 0xcf, 0x45, 0xc7, 0x73, 0x07, 0x5c,
+	0xc7, 0x12, 0x23, 0xc8, 0x7b, 0xb0, 0xe6, 0x73, 0xd7, 0xc6, 0x23, 0x77, 0xe5, 0x60, 0x25, 0x3d,
+	0x7b, 0x19, 0x0a, 0x79, 0x17, 0x56, 0x5f, 0xcf, 0xf8, 0x8c, 0xdb, 0xfb, 0xc5, 0x65, 0x1e, 0x4d,
+	0xa0, 0x7f, 0xce, 0xc3, 0x6e, 0xda, 0xa5, 0xee, 0x6c, 0x3a, 0xb5, 0x82, 0xc5, 0x8f, 0xf6, 0x6c,
+	0x3f, 0xe9, 0x99, 0x9c, 0x9d, 0x8d, 0x3b, 0x7b, 0x09, 0x77, 0x90, 0x60, 0x7c, 0xf8, 0x6b, 0xbc,
+	0x2c, 0xc6, 0x78, 0xea, 0x52, 0x53, 0x4a, 0x5e, 0x6a, 0x02, 0x7f, 0x6c, 0xa9, 0xdd, 0x59, 0x62,
+	0x1a, 0x42, 0xee, 0xab, 0x85, 0x3a, 0xd4, 0xd1, 0x19, 0x05, 0x90, 0x27, 0xb0, 0xae, 0x3d, 0x0e,
+	0xf7, 0x8b, 0xa9, 0x2e, 0x98, 0x19, 0x30, 0x8b, 0xb8, 0xa9, 0x05, 0x5b, 0x17, 0xca, 0xe7, 0xde,
+	0xfc, 0x99, 0x33, 0x11, 0xea, 0x46, 0x70, 0xfb, 0x6a, 0x04, 0x7c, 0xe0, 0xf8, 0x4e, 0xe2, 0x48,
+	0x8f, 0x10, 0xe8, 0xf2, 0x70, 0x36, 0x99, 0xf4, 0xe6, 0xfa, 0x6c, 0xd6, 0xd0, 0x47, 0xff, 0xc9,
+	0x9b, 0x89, 0x5f, 0x3f, 0x10, 0x96, 0xa1, 0xd4, 0xbb, 0xec, 0x9f, 0xbf, 0xa8, 0xe5, 0xc8, 0x0e,
+	0xd4, 0x7a, 0x97, 0xfd, 0xce, 0x79, 0xa7, 0xd9, 0xea, 0xf7, 0xce, 0xcf, 0xfb, 0xa7, 0xe7, 0xbf,
+	0xaf, 0xe5, 0xc9, 0x2e, 0x6c, 0xf7, 0x2e, 0xfb, 0x8d, 0x53, 0xd6, 0x6a, 0x7c, 0xf5, 0x4d, 0xbf,
+	0x75, 0xd9, 0xee, 0xf6, 0xba, 0xb5, 0x02, 0xb9, 0x03, 0x5b, 0xbd, 0xcb, 0x7e, 0xbb, 0xf3, 0xb2,
+	0x71, 0xda, 0xfe, 0xaa, 0x7f, 0xd2, 0xe8, 0x9e, 0xd4, 0x56, 0x96, 0x90, 0xdd, 0xf6, 0xf3, 0x4e,
+	0xad, 0xa8, 0x15, 0x18, 0xe4, 0xb3, 0x73, 0x76, 0xd6, 0xe8, 0xd5, 0x4a, 0xe4, 0x27, 0x70, 0x57,
+	0xa2, 0xbb, 0x5f, 0x3f, 0x7b, 0xd6, 0x6e, 0xb6, 0x5b, 0x9d, 0x5e, 0xff, 0x69, 0xe3, 0xb4, 0xd1,
+	0x69, 0xb6, 0x6a, 0xab, 0x5a, 0xe6, 0xa4, 0xd1, 0xed, 0x77, 0x1b, 0x67, 0x2d, 0xe5, 0x53, 0x6d,
+	0x2d, 0x52, 0xd5, 0x6b, 0xb1, 0x4e, 0xe3, 0xb4, 0xdf, 0x62, 0xec, 0x9c, 0xd5, 0xca, 0x1f, 0x0d,
+	0xcd, 0xdd, 0x40, 0xc7, 0xb4, 0x03, 0xb5, 0x97, 0x2d, 0xd6, 0x7e, 0xf6, 0x4d, 0xbf, 0xdb, 0x6b,
+	0xf4, 0xbe, 0xee, 0xaa, 0xf0, 0x0e, 0xe0, 0x5e, 0x1a, 0x8b, 0xfe, 0xf5, 0x3b, 0xe7, 0xbd, 0xfe,
+	0x59, 0xa3, 0xd7, 0x3c, 0xa9, 0xe5, 0xc9, 0x7d, 0xa8, 0xa7, 0x39, 0x52, 0xe1, 0x15, 0x8e, 0xff,
+	0xb1, 0x0b, 0x5b, 0x0d, 0x1e, 0x8c, 0x3c, 0x76, 0xd1, 0xc4, 0xe3, 0x19, 0x5f, 0xcb, 0x1e, 0x41,
+	0x19, 0xc7, 0xaf, 0xae, 0x7c, 0x72, 0x30, 0xfd, 0x4d, 0x0f, 0x64, 0xf5, 0x8c, 0x59, 0x9f, 0xe6,
+	0xc8, 0x23, 0x58, 0x3d, 0x93, 0x8f, 0xb8, 0x64, 0x37, 0x2a, 0x0c, 0x04, 0x43, 0xc6, 0x5f, 0xcf,
+	0x78, 0x28, 0xea, 0x9b, 0x69, 0x34, 0xcd, 0x91, 0x4f, 0x01, 0xe2, 0xa7, 0x5d, 0x12, 0x9d, 0x6c,
+	0xf8, 0x2a, 0x55, 0xbf, 0x9b, 0xbc, 0xe1, 0x25, 0xde, 0x7e, 0x69, 0x8e, 0x3c, 0x84, 0xca, 0x73,
+	0x2e, 0xe2, 0x07, 0xcc, 0xb4, 0xe0, 0x8d, 0xa7, 0x56, 0x9a, 0x23, 0x47, 0xfa, 0xbd, 0x13, 0x55,
+	0x2c, 0xb1, 0x6f, 0x27, 0xd9, 0x91, 0x8e, 0x16, 0xbe, 0x84, 0x1a, 0x36, 0xa8, 0xc4, 0x65, 0x36,
+	0x24, 0x86, 0x31, 0x7e, 0xe2, 0xa8, 0xef, 0xdd, 0xbc, 0xf4, 0x22, 0x95, 0xe6, 0xc8, 0x53, 0xd8,
+	0x8e, 0x14, 0x44, 0xf7, 0xe8, 0x0c, 0x0d, 0xfb, 0x59, 0xf7, 0x58, 0xad, 0xe3, 0x11, 0x6c, 0x45,
+	0x3a, 0xba, 0x22, 0xe0, 0xd6, 0x74, 0xc9, 0xf5, 0xd4, 0xf5, 0x9d, 0xe6, 0x1e, 0xe6, 0x49, 0x03,
+	0xee, 0xde, 0x30, 0x9b, 0x29, 0x9a, 0x79, 0x7f, 0x96, 0x2a, 0x8e, 0x60, 0xfd, 0x39, 0x57, 0x1a,
+	0x48, 0x46, 0xa2, 0x97, 0x8d, 0x92, 0xdf, 0x40, 0xcd, 0xf0, 0x47, 0x81, 0x66, 0xc9, 0xdd, 0x62,
+	0x91, 0x7c, 0x29, 0x93, 0x19, 0xbd, 0x85, 0x90, 0xbd, 0xe5, 0x07, 0x13, 0xbd, 0x52, 0xbb, 0x37,
+	0xf1, 0x23, 0x6e, 0xd3, 0x1c, 0x39, 0x84, 0xd2, 0x73, 0x2e, 0x7a, 0x97, 0x99, 0x56, 0xe3, 0x1e,
+	0x4d, 0x73, 0xe4, 0x17, 0x00, 0xc6, 0xd4, 0x2d, 0xec, 0xb5, 0x88, 0xbd, 0xed, 0x9a, 0x00, 0x8f,
+	0xa5, 0x94, 0x3e, 0xdb, 0x33, 0xa5, 0x96, 0xce, 0x7f, 0x9a, 0xc3, 0xd7, 0x91, 0xe7, 0x5c, 0x34,
+	0x9e, 0xb6, 0x33, 0xf9, 0xc1, 0x9c, 0x67, 0x4f, 0xdb, 0x8a, 0xb7, 0xcb, 0x5d, 0xbb, 0x77, 0x49,
+	0x62, 0x67, 0xeb, 0x59, 0xaf, 0x06, 0x14, 0x37, 0xfb, 0x6a, 0xd7, 0x19, 0xb9, 0x69, 0xde, 0x54,
+	0x8c, 0x1f, 0xc3, 0xba, 0x6a, 0x1a, 0xd9, 0xfa, 0x92, 0x8f, 0x0d, 0x72, 0x45, 0xd6, 0x95, 0x85,
+	0xde, 0x25, 0xa9, 0x46, 0xdc, 0x58, 0x42, 0xd1, 0xfe, 0x5b, 0x7e, 0xe1, 0xa0, 0x39, 0x5d, 0x22,
+	0xaa, 0x37, 0x7c, 0x5f, 0x89, 0x48, 0x0e, 0x9a, 0x23, 0xbf, 0x95, 0x25, 0x22, 0xa1, 0x86, 0x6b,
+	0x5f, 0x04, 0x9e, 0x37, 0x8c, 0x7a, 0x44, 0xfa, 0x15, 0xb8, 0x7e, 0x27, 0x8d, 0x96, 0xbc, 0x32,
+	0x07, 0xd5, 0x66, 0xc0, 0x51, 0x5e, 0xe1, 0xc9, 0x56, 0xf4, 0xfc, 0xa8, 0x9e, 0x39, 0xea, 0x4b,
+	0xaf, 0x16, 0x72, 0xfb, 0x6c, 0x60, 0x0e, 0x14, 0x1c, 0x2e, 0xd5, 0x3f, 0x49, 0xb3, 0xeb, 0xc0,
+	0x1e, 0xc2, 0xc6, 0xa9, 0x37, 0x78, 0xf5, 0x03, 0x8c, 0x1c, 0x43, 0xf5, 0x6b, 0x77, 0xf2, 0xc3,
+	0x64, 0x3e, 0x83, 0xaa, 0x7a, 0x47, 0x31, 0x32, 0x26, 0xe8, 0xe4, 0xeb, 0x4a, 0xb6, 0x5c, 0x6b,
+	0x9e, 0x94, 0xbb, 0x61, 0x2b, 0xbb, 0x31, 0x3f, 0x86, 0xea, 0xef, 0x66, 0x3c, 0x58, 0x34, 0x3d,
+	0x57, 0x04, 0xd6, 0x20, 0x6e, 0x80, 0x12, 0x7b, 0x8b, 0x50, 0x03, 0x48, 0x4a, 0x48, 0x65, 0x7b,
+	0x3b, 0x99, 0x59, 0x25, 0xbe, 0x77, 0x03, 0x65, 0x92, 0xf6, 0x48, 0x96, 0x89, 0xbc, 0xb1, 0x92,
+	0xe4, 0xeb, 0xb8, 0x9e, 0x6c, 0xeb, 0x5b, 0x09, 0x5c, 0x94, 0x00, 0x14, 0x79, 0x29, 0x5f, 0x04,
+	0xb6, 0x13, 0xaf, 0x04, 0x4b, 0x12, 0xe6, 0x61, 0x41, 0x36, 0xda, 0xad, 0x38, 0xcb, 0x4a, 0x70,
+	0xb9, 0xb4, 0xd4, 0x1b, 0x7c, 0x7d, 0x2f, 0x8d, 0x36, 0x0f, 0x1b, 0xea, 0x18, 0x52, 0xf5, 0x29,
+	0x5f, 0x5f, 0x6e, 0x11, 0x5f, 0x7a, 0xad, 0xa1, 0x39, 0xf2, 0x89, 0x2c, 0xb0, 0xe8, 0x49, 0x20,
+	0xf9, 0x08, 0x50, 0xdf, 0x4a, 0x00, 0xda, 0xca, 0x67, 0xaa, 0x9d, 0xcb, 0x7b, 0x9b, 0xee, 0xc9,
+	0x26, 0x44, 0x35, 0x15, 0x21, 0x5f, 0x3d, 0x75, 0xbd, 0x93, 0x0d, 0xf9, 0xb1, 0x7a, 0x55, 0x97,
+	0x88, 0x30, 0x4b, 0xa4, 0x96, 0x14, 0xd1, 0xcb, 0xf2, 0x19, 0x54, 0x31, 0xa4, 0xf8, 0x1a, 0x6f,
+	0x98, 0xa2, 0x9b, 0x7f, 0x74, 0xf0, 0xc5, 0x4c, 0x34, 0x47, 0x9e, 0xc8, 0xad, 0x9a, 0xbe, 0x4a,
+	0x66, 0x9f, 0x1c, 0x29, 0x1e, 0x9a, 0x23, 0x2f, 0xa0, 0xa6, 0xae, 0x33, 0x67, 0x1c, 0x5f, 0xaa,
+	0xc3, 0xb1, 0xe3, 0x93, 0xbb, 0xf1, 0x84, 0xa8, 0x51, 0x8a, 0xa5, 0x7e, 0xef, 0x16, 0x02, 0xe3,
+	0xfe, 0x64, 0x41, 0x73, 0xe4, 0x14, 0xee, 0x3c, 0xe7, 0xe2, 0xc6, 0xed, 0xb2, 0x6e, 0x3c, 0xb9,
+	0x79, 0x3f, 0xad, 0xdf, 0xbd, 0x85, 0x46, 0x73, 0xe4, 0x04, 0x76, 0x55, 0x50, 0x43, 0x65, 0xe5,
+	0x22, 0xf0, 0x46, 0xf2, 0xb7, 0x4c, 0x56, 0xf3, 0x7a, 0x2b, 0x71, 0xb7, 0x4f, 0xb3, 0xcb, 0xad,
+	0x04, 0x51, 0x27, 0x13, 0xb7, 0xf5, 0xb0, 0xe5, 0xf6, 0xf7, 0x10, 0xc0, 0xdc, 0x20, 0xd3, 0x4d,
+	0x79, 0x37, 0x32, 0x9f, 0xbc, 0x5f, 0xca, 0xcd, 0xb7, 0x89, 0x79, 0x8c, 0xef, 0x82, 0xd1, 0x4a,
+	0x2e, 0x5f, 0x0f, 0xeb, 0x3b, 0xcb, 0x04, 0x5d, 0x00, 0x4d, 0xd8, 0x7e, 0xce, 0x85, 0x1e, 0xcd,
+	0x71, 0x13, 0xe3, 0xf8, 0x7c, 0x4b, 0x69, 0xef, 0x66, 0x0e, 0xf2, 0x34, 0x47, 0x3e, 0x4f, 0x2a,
+	0x31, 0x97, 0x89, 0x74, 0x39, 0x2c, 0xc9, 0x6a, 0x26, 0x9a, 0x23, 0x5f, 0xc0, 0x1d, 0x39, 0xe7,
+	0x98, 0xc9, 0x5f, 0x97, 0xfc, 0x5e, 0xb4, 0xe9, 0x53, 0x37, 0x82, 0xd4, 0x21, 0xf6, 0x30, 0x7f,
+	0xb5, 0x2a, 0x7f, 0xfe, 0x3f, 0xfe, 0xff, 0x00, 0x0a, 0x35, 0xa2, 0x46, 0x62, 0x20, 0x00, 0x00,
 }
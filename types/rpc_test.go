@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingTxFilter(t *testing.T) {
+	sender := []byte("sender")
+	recipient := []byte("recipient")
+	other := []byte("other")
+	tx := &Tx{Hash: []byte("hash"), Body: &TxBody{Account: sender, Recipient: recipient}}
+
+	assert.True(t, (&PendingTxFilter{}).Match(tx))
+	assert.True(t, (&PendingTxFilter{Account: sender}).Match(tx))
+	assert.True(t, (&PendingTxFilter{Recipient: recipient}).Match(tx))
+	assert.True(t, (&PendingTxFilter{Account: other, Recipient: recipient}).Match(tx))
+	assert.False(t, (&PendingTxFilter{Account: recipient}).Match(tx))
+	assert.False(t, (&PendingTxFilter{Account: other, Recipient: other}).Match(tx))
+
+	assert.Equal(t, &Tx{Hash: tx.Hash}, (&PendingTxFilter{}).Apply(tx))
+	assert.Equal(t, tx, (&PendingTxFilter{FullTx: true}).Apply(tx))
+}
@@ -16,9 +16,14 @@ import (
 
 const Stake = "v1stake"
 const Unstake = "v1unstake"
+const ClaimReward = "v1claimReward"
+const Delegate = "v1delegate"
+const SubmitEvidence = "v1submitEvidence"
 const SetContractOwner = "v1setOwner"
 const NameCreate = "v1createName"
 const NameUpdate = "v1updateName"
+const NameRenew = "v1renewName"
+const NameSetPrimary = "v1setPrimaryName"
 
 const TxMaxSize = 200 * 1024
 
@@ -119,6 +124,9 @@ func (tx *transaction) Validate(chainidhash []byte, isPublic bool) error {
 	if gasprice.Cmp(MaxAER) > 0 {
 		return ErrTxInvalidPrice
 	}
+	if tx.GetBody().GetGasLimit() > 0 && gasprice.Sign() == 0 && !fee.IsZeroFee() {
+		return ErrTxInvalidPrice
+	}
 
 	if len(tx.GetBody().GetAccount()) > AddressLength {
 		return ErrTxInvalidAccount
@@ -130,9 +138,6 @@ func (tx *transaction) Validate(chainidhash []byte, isPublic bool) error {
 
 	switch tx.GetBody().Type {
 	case TxType_REDEPLOY:
-		if isPublic {
-			return ErrTxInvalidType
-		}
 		if tx.GetBody().GetRecipient() == nil {
 			return ErrTxInvalidRecipient
 		}
@@ -142,6 +147,14 @@ func (tx *transaction) Validate(chainidhash []byte, isPublic bool) error {
 			//contract deploy
 			return ErrTxInvalidRecipient
 		}
+	case TxType_FEEDELEGATION:
+		// a call of the contract which pays the fee
+		if tx.GetBody().GetRecipient() == nil {
+			return ErrTxInvalidRecipient
+		}
+		if len(tx.GetBody().GetPayload()) == 0 {
+			return ErrTxInvalidPayload
+		}
 	case TxType_GOVERNANCE:
 		if len(tx.GetBody().GetPayload()) <= 0 {
 			return ErrTxFormatInvalid
@@ -173,6 +186,35 @@ func ValidateSystemTx(tx *TxBody) error {
 	switch ci.Name {
 	case Stake,
 		Unstake:
+	case ClaimReward:
+		if tx.GetAmountBigInt().Sign() != 0 {
+			return ErrTxInvalidAmount
+		}
+	case SubmitEvidence:
+		// the block headers are verified in system.ValidateSystemTx
+		if tx.GetAmountBigInt().Sign() != 0 {
+			return ErrTxInvalidAmount
+		}
+		if len(ci.Args) != 2 {
+			return ErrTxInvalidPayload
+		}
+	case Delegate:
+		if tx.GetAmountBigInt().Sign() != 0 {
+			return ErrTxInvalidAmount
+		}
+		// no argument revokes the delegation
+		if len(ci.Args) > 1 {
+			return ErrTxInvalidPayload
+		}
+		if len(ci.Args) == 1 {
+			proxy, ok := ci.Args[0].(string)
+			if !ok {
+				return ErrTxInvalidPayload
+			}
+			if _, err := DecodeAddress(proxy); err != nil {
+				return ErrTxInvalidPayload
+			}
+		}
 	case VoteBP:
 		unique := map[string]int{}
 		for i, v := range ci.Args {
@@ -196,24 +238,21 @@ func ValidateSystemTx(tx *TxBody) error {
 				return ErrTxInvalidPayload
 			}
 		}
-		/* TODO: will be changed
-		case VoteNumBP,
-			VoteGasPrice,
-			VoteNamePrice,
-			VoteMinStaking:
-			for i, v := range ci.Args {
-				if i > 1 {
-					return ErrTxInvalidPayload
-				}
-				vstr, ok := v.(string)
-				if !ok {
-					return ErrTxInvalidPayload
-				}
-				if _, ok := new(big.Int).SetString(vstr, 10); !ok {
-					return ErrTxInvalidPayload
-				}
-			}
-		*/
+	case VoteNumBP,
+		VoteGasPrice,
+		VoteNamePrice,
+		VoteMinStaking:
+		// the bounds of each parameter are checked in system.ValidateSystemTx
+		if len(ci.Args) != 1 {
+			return ErrTxInvalidPayload
+		}
+		vstr, ok := ci.Args[0].(string)
+		if !ok {
+			return ErrTxInvalidPayload
+		}
+		if _, ok := new(big.Int).SetString(vstr, 10); !ok {
+			return ErrTxInvalidPayload
+		}
 	default:
 		return ErrTxInvalidPayload
 	}
@@ -230,6 +269,12 @@ func validateNameTx(tx *TxBody) error {
 		if err := _validateNameTx(tx, &ci); err != nil {
 			return err
 		}
+		// the owner of the parent creates a subdomain for free
+		if name, _ := ci.Args[0].(string); !strings.Contains(name, ".") {
+			if err := validateNameAmount(tx); err != nil {
+				return err
+			}
+		}
 		if len(ci.Args) != 1 {
 			return fmt.Errorf("invalid arguments in %s", ci)
 		}
@@ -237,6 +282,9 @@ func validateNameTx(tx *TxBody) error {
 		if err := _validateNameTx(tx, &ci); err != nil {
 			return err
 		}
+		if err := validateNameAmount(tx); err != nil {
+			return err
+		}
 		if len(ci.Args) != 2 {
 			return fmt.Errorf("invalid arguments in %s", ci)
 		}
@@ -247,6 +295,20 @@ func validateNameTx(tx *TxBody) error {
 		if len(to) > AddressLength {
 			return fmt.Errorf("too long name %s", string(tx.GetPayload()))
 		}
+	case NameRenew, NameSetPrimary:
+		if err := _validateNameTx(tx, &ci); err != nil {
+			return err
+		}
+		if len(ci.Args) != 1 {
+			return fmt.Errorf("invalid arguments in %s", ci)
+		}
+		if ci.Name == NameSetPrimary {
+			if tx.GetAmountBigInt().Sign() != 0 {
+				return ErrTxInvalidAmount
+			}
+		} else if err := validateNameAmount(tx); err != nil {
+			return err
+		}
 	case SetContractOwner:
 		owner, ok := ci.Args[0].(string)
 		if !ok {
@@ -262,6 +324,16 @@ func validateNameTx(tx *TxBody) error {
 	return nil
 }
 
+// validateNameAmount checks that a name tx pays at least 1 aergo. The amount
+// is checked against the voted name price in name.ValidateNameTx as well,
+// which is not lower than this.
+func validateNameAmount(tx *TxBody) error {
+	if new(big.Int).SetUint64(1000000000000000000).Cmp(tx.GetAmountBigInt()) > 0 {
+		return ErrTooSmallAmount
+	}
+	return nil
+}
+
 func _validateNameTx(tx *TxBody, ci *CallInfo) error {
 	if len(ci.Args) < 1 {
 		return fmt.Errorf("invalid arguments in %s", ci)
@@ -271,17 +343,21 @@ func _validateNameTx(tx *TxBody, ci *CallInfo) error {
 		return fmt.Errorf("invalid arguments in %s", nameParam)
 	}
 
-	if len(nameParam) > NameLength {
+	if len(nameParam) > NameMaxLength {
 		return fmt.Errorf("too long name %s", string(tx.GetPayload()))
 	}
-	if len(nameParam) != NameLength {
+	// a subdomain is a label followed by the name of its parent
+	labels := strings.Split(nameParam, ".")
+	if len(labels[len(labels)-1]) != NameLength {
 		return fmt.Errorf("not supported yet")
 	}
-	if err := validateAllowedChar([]byte(nameParam)); err != nil {
-		return err
-	}
-	if new(big.Int).SetUint64(1000000000000000000).Cmp(tx.GetAmountBigInt()) > 0 {
-		return ErrTooSmallAmount
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > NameLength {
+			return fmt.Errorf("invalid label in %s", nameParam)
+		}
+		if err := validateAllowedChar([]byte(label)); err != nil {
+			return err
+		}
 	}
 	return nil
 
@@ -299,6 +375,11 @@ func (tx *transaction) ValidateWithSenderState(senderState *State) error {
 		if spending.Cmp(balance) > 0 {
 			return ErrInsufficientBalance
 		}
+	case TxType_FEEDELEGATION:
+		// the fee is checked against the balance of the contract
+		if amount.Cmp(balance) > 0 {
+			return ErrInsufficientBalance
+		}
 	case TxType_GOVERNANCE:
 		switch string(tx.GetBody().GetRecipient()) {
 		case AergoSystem:
@@ -372,6 +453,12 @@ func (tx *transaction) Clone() *transaction {
 }
 
 func (tx *transaction) GetMaxFee() *big.Int {
+	if gasLimit := tx.GetBody().GetGasLimit(); gasLimit > 0 {
+		if fee.IsZeroFee() {
+			return new(big.Int)
+		}
+		return new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), tx.GetBody().GetGasPriceBigInt())
+	}
 	return fee.MaxPayloadTxFee(len(tx.GetBody().GetPayload()))
 }
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"math/big"
 	"strconv"
 	"testing"
 
@@ -110,6 +111,38 @@ func TestGovernanceTypeTransaction(t *testing.T) {
 	transaction.GetTx().Hash = transaction.CalculateTxHash()
 	err = transaction.Validate(chainid, false)
 	assert.Error(t, err, "invalid name length in update")
+
+	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1createName", "Args":["pay.ab1234567890"]}`)
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid, false)
+	assert.NoError(t, err, "subdomain")
+
+	transaction.GetTx().GetBody().Amount = nil
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid, false)
+	assert.NoError(t, err, "free subdomain")
+
+	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1createName", "Args":["ab1234567890"]}`)
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid, false)
+	assert.EqualError(t, err, ErrTooSmallAmount.Error(), "priced top level name")
+	transaction.GetTx().GetBody().Amount = StakingMinimum.Bytes()
+
+	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1createName", "Args":["pay..ab1234567890"]}`)
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid, false)
+	assert.Error(t, err, "empty label in subdomain")
+
+	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1createName", "Args":["pay.ab12345"]}`)
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid, false)
+	assert.Error(t, err, "invalid top level name length in subdomain")
+
+	transaction.GetTx().GetBody().Payload = []byte(`{"Name":"v1setPrimaryName", "Args":["ab1234567890"]}`)
+	transaction.GetTx().GetBody().Amount = big.NewInt(1).Bytes()
+	transaction.GetTx().Hash = transaction.CalculateTxHash()
+	err = transaction.Validate(chainid, false)
+	assert.EqualError(t, err, ErrTxInvalidAmount.Error(), "amount in setting primary name")
 }
 
 func buildVoteBPPayloadEx(count int, err int) []byte {
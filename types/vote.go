@@ -17,8 +17,16 @@ const (
 	VoteMinStaking = "v1voteMinStaking"
 )
 
-//var AllVotes = [...]string{VoteBP, VoteGasPrice, VoteNumBP, VoteNamePrice, VoteMinStaking}
-var AllVotes = [...]string{VoteBP}
+var AllVotes = [...]string{VoteBP, VoteGasPrice, VoteNumBP, VoteNamePrice, VoteMinStaking}
+
+// GetAllVotes returns the votes accepted at blockNo. The votes on the chain
+// parameters are accepted from the V2 hardfork.
+func GetAllVotes(blockNo BlockNo) []string {
+	if IsV2Fork(blockNo) {
+		return AllVotes[:]
+	}
+	return AllVotes[:1]
+}
 
 func (vl VoteList) Len() int { return len(vl.Votes) }
 func (vl VoteList) Less(i, j int) bool {